@@ -9,6 +9,7 @@ package SimHelper
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +21,506 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// SimState is the lifecycle state of a sim: new -> active -> expired/blocked -> retired.
+type SimState int32
+
+const (
+	SimState_SIM_STATE_UNSPECIFIED SimState = 0
+	SimState_SIM_STATE_NEW         SimState = 1
+	SimState_SIM_STATE_ACTIVE      SimState = 2
+	SimState_SIM_STATE_EXPIRED     SimState = 3
+	SimState_SIM_STATE_BLOCKED     SimState = 4
+	SimState_SIM_STATE_RETIRED     SimState = 5
+)
+
+// Enum value maps for SimState.
+var (
+	SimState_name = map[int32]string{
+		0: "SIM_STATE_UNSPECIFIED",
+		1: "SIM_STATE_NEW",
+		2: "SIM_STATE_ACTIVE",
+		3: "SIM_STATE_EXPIRED",
+		4: "SIM_STATE_BLOCKED",
+		5: "SIM_STATE_RETIRED",
+	}
+	SimState_value = map[string]int32{
+		"SIM_STATE_UNSPECIFIED": 0,
+		"SIM_STATE_NEW":         1,
+		"SIM_STATE_ACTIVE":      2,
+		"SIM_STATE_EXPIRED":     3,
+		"SIM_STATE_BLOCKED":     4,
+		"SIM_STATE_RETIRED":     5,
+	}
+)
+
+func (x SimState) Enum() *SimState {
+	p := new(SimState)
+	*p = x
+	return p
+}
+
+func (x SimState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SimState) Descriptor() protoreflect.EnumDescriptor {
+	return file_sim_proto_enumTypes[0].Descriptor()
+}
+
+func (SimState) Type() protoreflect.EnumType {
+	return &file_sim_proto_enumTypes[0]
+}
+
+func (x SimState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SimState.Descriptor instead.
+func (SimState) EnumDescriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{0}
+}
+
+// EligibilityReason explains why a sim can not be used for a service.
+type EligibilityReason int32
+
+const (
+	EligibilityReason_ELIGIBILITY_REASON_UNSPECIFIED           EligibilityReason = 0
+	EligibilityReason_ELIGIBILITY_REASON_SIM_NOT_FOUND         EligibilityReason = 1
+	EligibilityReason_ELIGIBILITY_REASON_SIM_BLOCKED           EligibilityReason = 2
+	EligibilityReason_ELIGIBILITY_REASON_SIM_EXPIRED           EligibilityReason = 3
+	EligibilityReason_ELIGIBILITY_REASON_SERVICE_NOT_FOUND     EligibilityReason = 4
+	EligibilityReason_ELIGIBILITY_REASON_ALREADY_USED          EligibilityReason = 5
+	EligibilityReason_ELIGIBILITY_REASON_SIM_NOT_ACTIVATED     EligibilityReason = 6
+	EligibilityReason_ELIGIBILITY_REASON_BLOCKED_ON_SERVICE    EligibilityReason = 7 // the sim is blocked on the service, not the sim itself
+	EligibilityReason_ELIGIBILITY_REASON_COOLDOWN              EligibilityReason = 8 // the use policy of the service does not allow the use before EligibleAt
+	EligibilityReason_ELIGIBILITY_REASON_SERVICE_DISABLED      EligibilityReason = 9
+	EligibilityReason_ELIGIBILITY_REASON_COUNTRY_NOT_SUPPORTED EligibilityReason = 10 // the service does not accept numbers of the sim country
+	EligibilityReason_ELIGIBILITY_REASON_LEASED                EligibilityReason = 11 // the sim is leased for the service or another service of its group
+)
+
+// Enum value maps for EligibilityReason.
+var (
+	EligibilityReason_name = map[int32]string{
+		0:  "ELIGIBILITY_REASON_UNSPECIFIED",
+		1:  "ELIGIBILITY_REASON_SIM_NOT_FOUND",
+		2:  "ELIGIBILITY_REASON_SIM_BLOCKED",
+		3:  "ELIGIBILITY_REASON_SIM_EXPIRED",
+		4:  "ELIGIBILITY_REASON_SERVICE_NOT_FOUND",
+		5:  "ELIGIBILITY_REASON_ALREADY_USED",
+		6:  "ELIGIBILITY_REASON_SIM_NOT_ACTIVATED",
+		7:  "ELIGIBILITY_REASON_BLOCKED_ON_SERVICE",
+		8:  "ELIGIBILITY_REASON_COOLDOWN",
+		9:  "ELIGIBILITY_REASON_SERVICE_DISABLED",
+		10: "ELIGIBILITY_REASON_COUNTRY_NOT_SUPPORTED",
+		11: "ELIGIBILITY_REASON_LEASED",
+	}
+	EligibilityReason_value = map[string]int32{
+		"ELIGIBILITY_REASON_UNSPECIFIED":           0,
+		"ELIGIBILITY_REASON_SIM_NOT_FOUND":         1,
+		"ELIGIBILITY_REASON_SIM_BLOCKED":           2,
+		"ELIGIBILITY_REASON_SIM_EXPIRED":           3,
+		"ELIGIBILITY_REASON_SERVICE_NOT_FOUND":     4,
+		"ELIGIBILITY_REASON_ALREADY_USED":          5,
+		"ELIGIBILITY_REASON_SIM_NOT_ACTIVATED":     6,
+		"ELIGIBILITY_REASON_BLOCKED_ON_SERVICE":    7,
+		"ELIGIBILITY_REASON_COOLDOWN":              8,
+		"ELIGIBILITY_REASON_SERVICE_DISABLED":      9,
+		"ELIGIBILITY_REASON_COUNTRY_NOT_SUPPORTED": 10,
+		"ELIGIBILITY_REASON_LEASED":                11,
+	}
+)
+
+func (x EligibilityReason) Enum() *EligibilityReason {
+	p := new(EligibilityReason)
+	*p = x
+	return p
+}
+
+func (x EligibilityReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EligibilityReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_sim_proto_enumTypes[1].Descriptor()
+}
+
+func (EligibilityReason) Type() protoreflect.EnumType {
+	return &file_sim_proto_enumTypes[1]
+}
+
+func (x EligibilityReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EligibilityReason.Descriptor instead.
+func (EligibilityReason) EnumDescriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{1}
+}
+
+type ActivationKind int32
+
+const (
+	ActivationKind_ACTIVATION_KIND_UNSPECIFIED ActivationKind = 0
+	ActivationKind_ACTIVATION_KIND_ACTIVATE    ActivationKind = 1
+	ActivationKind_ACTIVATION_KIND_EXTEND      ActivationKind = 2
+)
+
+// Enum value maps for ActivationKind.
+var (
+	ActivationKind_name = map[int32]string{
+		0: "ACTIVATION_KIND_UNSPECIFIED",
+		1: "ACTIVATION_KIND_ACTIVATE",
+		2: "ACTIVATION_KIND_EXTEND",
+	}
+	ActivationKind_value = map[string]int32{
+		"ACTIVATION_KIND_UNSPECIFIED": 0,
+		"ACTIVATION_KIND_ACTIVATE":    1,
+		"ACTIVATION_KIND_EXTEND":      2,
+	}
+)
+
+func (x ActivationKind) Enum() *ActivationKind {
+	p := new(ActivationKind)
+	*p = x
+	return p
+}
+
+func (x ActivationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_sim_proto_enumTypes[2].Descriptor()
+}
+
+func (ActivationKind) Type() protoreflect.EnumType {
+	return &file_sim_proto_enumTypes[2]
+}
+
+func (x ActivationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivationKind.Descriptor instead.
+func (ActivationKind) EnumDescriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{2}
+}
+
+// VerificationType is the way a service verifies a number.
+type VerificationType int32
+
+const (
+	VerificationType_VERIFICATION_TYPE_UNSPECIFIED VerificationType = 0
+	VerificationType_VERIFICATION_TYPE_SMS         VerificationType = 1
+	VerificationType_VERIFICATION_TYPE_CALL        VerificationType = 2
+	VerificationType_VERIFICATION_TYPE_BOTH        VerificationType = 3
+)
+
+// Enum value maps for VerificationType.
+var (
+	VerificationType_name = map[int32]string{
+		0: "VERIFICATION_TYPE_UNSPECIFIED",
+		1: "VERIFICATION_TYPE_SMS",
+		2: "VERIFICATION_TYPE_CALL",
+		3: "VERIFICATION_TYPE_BOTH",
+	}
+	VerificationType_value = map[string]int32{
+		"VERIFICATION_TYPE_UNSPECIFIED": 0,
+		"VERIFICATION_TYPE_SMS":         1,
+		"VERIFICATION_TYPE_CALL":        2,
+		"VERIFICATION_TYPE_BOTH":        3,
+	}
+)
+
+func (x VerificationType) Enum() *VerificationType {
+	p := new(VerificationType)
+	*p = x
+	return p
+}
+
+func (x VerificationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VerificationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sim_proto_enumTypes[3].Descriptor()
+}
+
+func (VerificationType) Type() protoreflect.EnumType {
+	return &file_sim_proto_enumTypes[3]
+}
+
+func (x VerificationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VerificationType.Descriptor instead.
+func (VerificationType) EnumDescriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{3}
+}
+
+type SimSortKey int32
+
+const (
+	SimSortKey_SIM_SORT_KEY_ID             SimSortKey = 0
+	SimSortKey_SIM_SORT_KEY_NUMBER         SimSortKey = 1
+	SimSortKey_SIM_SORT_KEY_ACTIVATE_UNTIL SimSortKey = 2
+)
+
+// Enum value maps for SimSortKey.
+var (
+	SimSortKey_name = map[int32]string{
+		0: "SIM_SORT_KEY_ID",
+		1: "SIM_SORT_KEY_NUMBER",
+		2: "SIM_SORT_KEY_ACTIVATE_UNTIL",
+	}
+	SimSortKey_value = map[string]int32{
+		"SIM_SORT_KEY_ID":             0,
+		"SIM_SORT_KEY_NUMBER":         1,
+		"SIM_SORT_KEY_ACTIVATE_UNTIL": 2,
+	}
+)
+
+func (x SimSortKey) Enum() *SimSortKey {
+	p := new(SimSortKey)
+	*p = x
+	return p
+}
+
+func (x SimSortKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SimSortKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_sim_proto_enumTypes[4].Descriptor()
+}
+
+func (SimSortKey) Type() protoreflect.EnumType {
+	return &file_sim_proto_enumTypes[4]
+}
+
+func (x SimSortKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SimSortKey.Descriptor instead.
+func (SimSortKey) EnumDescriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{4}
+}
+
+type ImportRowStatus int32
+
+const (
+	ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED ImportRowStatus = 0
+	ImportRowStatus_IMPORT_ROW_STATUS_CREATED     ImportRowStatus = 1
+	ImportRowStatus_IMPORT_ROW_STATUS_DUPLICATE   ImportRowStatus = 2
+	ImportRowStatus_IMPORT_ROW_STATUS_INVALID     ImportRowStatus = 3
+	ImportRowStatus_IMPORT_ROW_STATUS_ROLLED_BACK ImportRowStatus = 4 // valid row of an all-or-nothing import that was rolled back
+)
+
+// Enum value maps for ImportRowStatus.
+var (
+	ImportRowStatus_name = map[int32]string{
+		0: "IMPORT_ROW_STATUS_UNSPECIFIED",
+		1: "IMPORT_ROW_STATUS_CREATED",
+		2: "IMPORT_ROW_STATUS_DUPLICATE",
+		3: "IMPORT_ROW_STATUS_INVALID",
+		4: "IMPORT_ROW_STATUS_ROLLED_BACK",
+	}
+	ImportRowStatus_value = map[string]int32{
+		"IMPORT_ROW_STATUS_UNSPECIFIED": 0,
+		"IMPORT_ROW_STATUS_CREATED":     1,
+		"IMPORT_ROW_STATUS_DUPLICATE":   2,
+		"IMPORT_ROW_STATUS_INVALID":     3,
+		"IMPORT_ROW_STATUS_ROLLED_BACK": 4,
+	}
+)
+
+func (x ImportRowStatus) Enum() *ImportRowStatus {
+	p := new(ImportRowStatus)
+	*p = x
+	return p
+}
+
+func (x ImportRowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportRowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_sim_proto_enumTypes[5].Descriptor()
+}
+
+func (ImportRowStatus) Type() protoreflect.EnumType {
+	return &file_sim_proto_enumTypes[5]
+}
+
+func (x ImportRowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportRowStatus.Descriptor instead.
+func (ImportRowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{5}
+}
+
+// InventoryEntity is the kind of records of an inventory export.
+type InventoryEntity int32
+
+const (
+	InventoryEntity_INVENTORY_ENTITY_UNSPECIFIED InventoryEntity = 0
+	InventoryEntity_INVENTORY_ENTITY_SIMS        InventoryEntity = 1
+	InventoryEntity_INVENTORY_ENTITY_PROVIDERS   InventoryEntity = 2
+	InventoryEntity_INVENTORY_ENTITY_SERVICES    InventoryEntity = 3
+	InventoryEntity_INVENTORY_ENTITY_USAGE       InventoryEntity = 4 // used services of the sims
+)
+
+// Enum value maps for InventoryEntity.
+var (
+	InventoryEntity_name = map[int32]string{
+		0: "INVENTORY_ENTITY_UNSPECIFIED",
+		1: "INVENTORY_ENTITY_SIMS",
+		2: "INVENTORY_ENTITY_PROVIDERS",
+		3: "INVENTORY_ENTITY_SERVICES",
+		4: "INVENTORY_ENTITY_USAGE",
+	}
+	InventoryEntity_value = map[string]int32{
+		"INVENTORY_ENTITY_UNSPECIFIED": 0,
+		"INVENTORY_ENTITY_SIMS":        1,
+		"INVENTORY_ENTITY_PROVIDERS":   2,
+		"INVENTORY_ENTITY_SERVICES":    3,
+		"INVENTORY_ENTITY_USAGE":       4,
+	}
+)
+
+func (x InventoryEntity) Enum() *InventoryEntity {
+	p := new(InventoryEntity)
+	*p = x
+	return p
+}
+
+func (x InventoryEntity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InventoryEntity) Descriptor() protoreflect.EnumDescriptor {
+	return file_sim_proto_enumTypes[6].Descriptor()
+}
+
+func (InventoryEntity) Type() protoreflect.EnumType {
+	return &file_sim_proto_enumTypes[6]
+}
+
+func (x InventoryEntity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InventoryEntity.Descriptor instead.
+func (InventoryEntity) EnumDescriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{6}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0 // csv
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1 // with a header row
+	ExportFormat_EXPORT_FORMAT_JSONL       ExportFormat = 2 // one json object per line
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_JSONL",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_JSONL":       2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_sim_proto_enumTypes[7].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_sim_proto_enumTypes[7]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{7}
+}
+
+// AuditEntity is the kind of record an audit entry is about.
+type AuditEntity int32
+
+const (
+	AuditEntity_AUDIT_ENTITY_UNSPECIFIED  AuditEntity = 0
+	AuditEntity_AUDIT_ENTITY_SIM          AuditEntity = 1
+	AuditEntity_AUDIT_ENTITY_SERVICE      AuditEntity = 2
+	AuditEntity_AUDIT_ENTITY_USED_SERVICE AuditEntity = 3
+)
+
+// Enum value maps for AuditEntity.
+var (
+	AuditEntity_name = map[int32]string{
+		0: "AUDIT_ENTITY_UNSPECIFIED",
+		1: "AUDIT_ENTITY_SIM",
+		2: "AUDIT_ENTITY_SERVICE",
+		3: "AUDIT_ENTITY_USED_SERVICE",
+	}
+	AuditEntity_value = map[string]int32{
+		"AUDIT_ENTITY_UNSPECIFIED":  0,
+		"AUDIT_ENTITY_SIM":          1,
+		"AUDIT_ENTITY_SERVICE":      2,
+		"AUDIT_ENTITY_USED_SERVICE": 3,
+	}
+)
+
+func (x AuditEntity) Enum() *AuditEntity {
+	p := new(AuditEntity)
+	*p = x
+	return p
+}
+
+func (x AuditEntity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditEntity) Descriptor() protoreflect.EnumDescriptor {
+	return file_sim_proto_enumTypes[8].Descriptor()
+}
+
+func (AuditEntity) Type() protoreflect.EnumType {
+	return &file_sim_proto_enumTypes[8]
+}
+
+func (x AuditEntity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditEntity.Descriptor instead.
+func (AuditEntity) EnumDescriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{8}
+}
+
 type Empty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -63,7 +564,8 @@ type SSBRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id     int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (x *SSBRequest) Reset() {
@@ -105,6 +607,13 @@ func (x *SSBRequest) GetId() int32 {
 	return 0
 }
 
+func (x *SSBRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type SSBResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -152,18 +661,17 @@ func (x *SSBResponse) GetIsBlocked() bool {
 	return false
 }
 
-type UsedService struct {
+type SimTransitionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceId   int32  `protobuf:"varint,1,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
-	IsBlocked   bool   `protobuf:"varint,2,opt,name=isBlocked,proto3" json:"isBlocked,omitempty"`
-	BlockedInfo string `protobuf:"bytes,3,opt,name=blockedInfo,proto3" json:"blockedInfo,omitempty"`
+	Id     int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *UsedService) Reset() {
-	*x = UsedService{}
+func (x *SimTransitionRequest) Reset() {
+	*x = SimTransitionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sim_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -171,13 +679,13 @@ func (x *UsedService) Reset() {
 	}
 }
 
-func (x *UsedService) String() string {
+func (x *SimTransitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsedService) ProtoMessage() {}
+func (*SimTransitionRequest) ProtoMessage() {}
 
-func (x *UsedService) ProtoReflect() protoreflect.Message {
+func (x *SimTransitionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sim_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -189,42 +697,35 @@ func (x *UsedService) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsedService.ProtoReflect.Descriptor instead.
-func (*UsedService) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimTransitionRequest.ProtoReflect.Descriptor instead.
+func (*SimTransitionRequest) Descriptor() ([]byte, []int) {
 	return file_sim_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UsedService) GetServiceId() int32 {
+func (x *SimTransitionRequest) GetId() int32 {
 	if x != nil {
-		return x.ServiceId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UsedService) GetIsBlocked() bool {
-	if x != nil {
-		return x.IsBlocked
-	}
-	return false
-}
-
-func (x *UsedService) GetBlockedInfo() string {
+func (x *SimTransitionRequest) GetReason() string {
 	if x != nil {
-		return x.BlockedInfo
+		return x.Reason
 	}
 	return ""
 }
 
-type GetUsedServResponse struct {
+type SimTransitionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UsedServices []*UsedService `protobuf:"bytes,1,rep,name=UsedServices,proto3" json:"UsedServices,omitempty"`
+	Sim *SimData `protobuf:"bytes,1,opt,name=Sim,proto3" json:"Sim,omitempty"`
 }
 
-func (x *GetUsedServResponse) Reset() {
-	*x = GetUsedServResponse{}
+func (x *SimTransitionResponse) Reset() {
+	*x = SimTransitionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sim_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -232,13 +733,13 @@ func (x *GetUsedServResponse) Reset() {
 	}
 }
 
-func (x *GetUsedServResponse) String() string {
+func (x *SimTransitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsedServResponse) ProtoMessage() {}
+func (*SimTransitionResponse) ProtoMessage() {}
 
-func (x *GetUsedServResponse) ProtoReflect() protoreflect.Message {
+func (x *SimTransitionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sim_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -250,28 +751,34 @@ func (x *GetUsedServResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsedServResponse.ProtoReflect.Descriptor instead.
-func (*GetUsedServResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimTransitionResponse.ProtoReflect.Descriptor instead.
+func (*SimTransitionResponse) Descriptor() ([]byte, []int) {
 	return file_sim_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetUsedServResponse) GetUsedServices() []*UsedService {
+func (x *SimTransitionResponse) GetSim() *SimData {
 	if x != nil {
-		return x.UsedServices
+		return x.Sim
 	}
 	return nil
 }
 
-type GetUsedServRequest struct {
+type UsedService struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SimId int32 `protobuf:"varint,1,opt,name=simId,proto3" json:"simId,omitempty"`
+	ServiceId   int32  `protobuf:"varint,1,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+	IsBlocked   bool   `protobuf:"varint,2,opt,name=isBlocked,proto3" json:"isBlocked,omitempty"`
+	BlockedInfo string `protobuf:"bytes,3,opt,name=blockedInfo,proto3" json:"blockedInfo,omitempty"`
+	ServiceName string `protobuf:"bytes,4,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	BlockedAt   int64  `protobuf:"varint,5,opt,name=blockedAt,proto3" json:"blockedAt,omitempty"`   // unix timestamp of the block, 0 if not blocked
+	UsedAt      int64  `protobuf:"varint,6,opt,name=usedAt,proto3" json:"usedAt,omitempty"`         // unix timestamp of the registration with the service, 0 if unknown
+	ReleasedAt  int64  `protobuf:"varint,7,opt,name=releasedAt,proto3" json:"releasedAt,omitempty"` // unix timestamp of the release from the service, 0 if still used
 }
 
-func (x *GetUsedServRequest) Reset() {
-	*x = GetUsedServRequest{}
+func (x *UsedService) Reset() {
+	*x = UsedService{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sim_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -279,13 +786,13 @@ func (x *GetUsedServRequest) Reset() {
 	}
 }
 
-func (x *GetUsedServRequest) String() string {
+func (x *UsedService) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsedServRequest) ProtoMessage() {}
+func (*UsedService) ProtoMessage() {}
 
-func (x *GetUsedServRequest) ProtoReflect() protoreflect.Message {
+func (x *UsedService) ProtoReflect() protoreflect.Message {
 	mi := &file_sim_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -297,11 +804,147 @@ func (x *GetUsedServRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsedServRequest.ProtoReflect.Descriptor instead.
-func (*GetUsedServRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UsedService.ProtoReflect.Descriptor instead.
+func (*UsedService) Descriptor() ([]byte, []int) {
 	return file_sim_proto_rawDescGZIP(), []int{5}
 }
 
+func (x *UsedService) GetServiceId() int32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *UsedService) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+func (x *UsedService) GetBlockedInfo() string {
+	if x != nil {
+		return x.BlockedInfo
+	}
+	return ""
+}
+
+func (x *UsedService) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *UsedService) GetBlockedAt() int64 {
+	if x != nil {
+		return x.BlockedAt
+	}
+	return 0
+}
+
+func (x *UsedService) GetUsedAt() int64 {
+	if x != nil {
+		return x.UsedAt
+	}
+	return 0
+}
+
+func (x *UsedService) GetReleasedAt() int64 {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return 0
+}
+
+type GetUsedServResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UsedServices []*UsedService `protobuf:"bytes,1,rep,name=UsedServices,proto3" json:"UsedServices,omitempty"`
+}
+
+func (x *GetUsedServResponse) Reset() {
+	*x = GetUsedServResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsedServResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsedServResponse) ProtoMessage() {}
+
+func (x *GetUsedServResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsedServResponse.ProtoReflect.Descriptor instead.
+func (*GetUsedServResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUsedServResponse) GetUsedServices() []*UsedService {
+	if x != nil {
+		return x.UsedServices
+	}
+	return nil
+}
+
+type GetUsedServRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SimId int32 `protobuf:"varint,1,opt,name=simId,proto3" json:"simId,omitempty"`
+}
+
+func (x *GetUsedServRequest) Reset() {
+	*x = GetUsedServRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsedServRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsedServRequest) ProtoMessage() {}
+
+func (x *GetUsedServRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsedServRequest.ProtoReflect.Descriptor instead.
+func (*GetUsedServRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{7}
+}
+
 func (x *GetUsedServRequest) GetSimId() int32 {
 	if x != nil {
 		return x.SimId
@@ -314,13 +957,14 @@ type GetFreeServResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FreeServiceIds []int32 `protobuf:"varint,1,rep,packed,name=FreeServiceIds,proto3" json:"FreeServiceIds,omitempty"`
+	FreeServiceIds []int32        `protobuf:"varint,1,rep,packed,name=FreeServiceIds,proto3" json:"FreeServiceIds,omitempty"`
+	FreeServices   []*ServiceData `protobuf:"bytes,2,rep,name=FreeServices,proto3" json:"FreeServices,omitempty"`
 }
 
 func (x *GetFreeServResponse) Reset() {
 	*x = GetFreeServResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[6]
+		mi := &file_sim_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -333,7 +977,7 @@ func (x *GetFreeServResponse) String() string {
 func (*GetFreeServResponse) ProtoMessage() {}
 
 func (x *GetFreeServResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[6]
+	mi := &file_sim_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -346,7 +990,7 @@ func (x *GetFreeServResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFreeServResponse.ProtoReflect.Descriptor instead.
 func (*GetFreeServResponse) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{6}
+	return file_sim_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetFreeServResponse) GetFreeServiceIds() []int32 {
@@ -356,6 +1000,13 @@ func (x *GetFreeServResponse) GetFreeServiceIds() []int32 {
 	return nil
 }
 
+func (x *GetFreeServResponse) GetFreeServices() []*ServiceData {
+	if x != nil {
+		return x.FreeServices
+	}
+	return nil
+}
+
 type GetFreeServRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -367,7 +1018,7 @@ type GetFreeServRequest struct {
 func (x *GetFreeServRequest) Reset() {
 	*x = GetFreeServRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[7]
+		mi := &file_sim_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -380,7 +1031,7 @@ func (x *GetFreeServRequest) String() string {
 func (*GetFreeServRequest) ProtoMessage() {}
 
 func (x *GetFreeServRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[7]
+	mi := &file_sim_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -393,7 +1044,7 @@ func (x *GetFreeServRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFreeServRequest.ProtoReflect.Descriptor instead.
 func (*GetFreeServRequest) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{7}
+	return file_sim_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetFreeServRequest) GetNumber() string {
@@ -408,14 +1059,20 @@ type ProviderData struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id                      int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DefaultActivationPeriod int64  `protobuf:"varint,3,opt,name=defaultActivationPeriod,proto3" json:"defaultActivationPeriod,omitempty"` // seconds, 0 if the provider has no default period
+	Country                 string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`                                  // ISO 3166-1 alpha-2, empty if unknown
+	Mcc                     string `protobuf:"bytes,5,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	Mnc                     string `protobuf:"bytes,6,opt,name=mnc,proto3" json:"mnc,omitempty"`
+	MonthlyCost             int64  `protobuf:"varint,7,opt,name=monthlyCost,proto3" json:"monthlyCost,omitempty"` // monthly tariff of a sim in minor units of currency, e.g. cents
+	Currency                string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`        // ISO 4217
 }
 
 func (x *ProviderData) Reset() {
 	*x = ProviderData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[8]
+		mi := &file_sim_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -428,7 +1085,7 @@ func (x *ProviderData) String() string {
 func (*ProviderData) ProtoMessage() {}
 
 func (x *ProviderData) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[8]
+	mi := &file_sim_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +1098,7 @@ func (x *ProviderData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderData.ProtoReflect.Descriptor instead.
 func (*ProviderData) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{8}
+	return file_sim_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ProviderData) GetId() int32 {
@@ -458,6 +1115,48 @@ func (x *ProviderData) GetName() string {
 	return ""
 }
 
+func (x *ProviderData) GetDefaultActivationPeriod() int64 {
+	if x != nil {
+		return x.DefaultActivationPeriod
+	}
+	return 0
+}
+
+func (x *ProviderData) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ProviderData) GetMcc() string {
+	if x != nil {
+		return x.Mcc
+	}
+	return ""
+}
+
+func (x *ProviderData) GetMnc() string {
+	if x != nil {
+		return x.Mnc
+	}
+	return ""
+}
+
+func (x *ProviderData) GetMonthlyCost() int64 {
+	if x != nil {
+		return x.MonthlyCost
+	}
+	return 0
+}
+
+func (x *ProviderData) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type ProviderList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -469,7 +1168,7 @@ type ProviderList struct {
 func (x *ProviderList) Reset() {
 	*x = ProviderList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[9]
+		mi := &file_sim_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -482,7 +1181,7 @@ func (x *ProviderList) String() string {
 func (*ProviderList) ProtoMessage() {}
 
 func (x *ProviderList) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[9]
+	mi := &file_sim_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -495,7 +1194,7 @@ func (x *ProviderList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderList.ProtoReflect.Descriptor instead.
 func (*ProviderList) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{9}
+	return file_sim_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ProviderList) GetProviders() []*ProviderData {
@@ -505,31 +1204,37 @@ func (x *ProviderList) GetProviders() []*ProviderData {
 	return nil
 }
 
-type SimList struct {
+// ProviderMetadata describes a provider. Empty values and 0 mean unknown.
+type ProviderMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SimList []*SimData `protobuf:"bytes,1,rep,name=SimList,proto3" json:"SimList,omitempty"`
+	Country                 string `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`                                                                   // ISO 3166-1 alpha-2, inferred from mcc if empty
+	Mcc                     string `protobuf:"bytes,2,opt,name=mcc,proto3" json:"mcc,omitempty"`                                                                           // mobile country code, e.g. 250
+	Mnc                     string `protobuf:"bytes,3,opt,name=mnc,proto3" json:"mnc,omitempty"`                                                                           // mobile network code, 2 or 3 digits
+	DefaultActivationPeriod int64  `protobuf:"varint,4,opt,name=default_activation_period,json=defaultActivationPeriod,proto3" json:"default_activation_period,omitempty"` // seconds
+	MonthlyCost             int64  `protobuf:"varint,5,opt,name=monthly_cost,json=monthlyCost,proto3" json:"monthly_cost,omitempty"`                                       // monthly tariff of a sim in minor units of currency, e.g. cents
+	Currency                string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`                                                                 // ISO 4217, e.g. RUB
 }
 
-func (x *SimList) Reset() {
-	*x = SimList{}
+func (x *ProviderMetadata) Reset() {
+	*x = ProviderMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[10]
+		mi := &file_sim_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SimList) String() string {
+func (x *ProviderMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimList) ProtoMessage() {}
+func (*ProviderMetadata) ProtoMessage() {}
 
-func (x *SimList) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[10]
+func (x *ProviderMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,48 +1245,79 @@ func (x *SimList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimList.ProtoReflect.Descriptor instead.
-func (*SimList) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ProviderMetadata.ProtoReflect.Descriptor instead.
+func (*ProviderMetadata) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SimList) GetSimList() []*SimData {
+func (x *ProviderMetadata) GetCountry() string {
 	if x != nil {
-		return x.SimList
+		return x.Country
 	}
-	return nil
+	return ""
 }
 
-type SimData struct {
+func (x *ProviderMetadata) GetMcc() string {
+	if x != nil {
+		return x.Mcc
+	}
+	return ""
+}
+
+func (x *ProviderMetadata) GetMnc() string {
+	if x != nil {
+		return x.Mnc
+	}
+	return ""
+}
+
+func (x *ProviderMetadata) GetDefaultActivationPeriod() int64 {
+	if x != nil {
+		return x.DefaultActivationPeriod
+	}
+	return 0
+}
+
+func (x *ProviderMetadata) GetMonthlyCost() int64 {
+	if x != nil {
+		return x.MonthlyCost
+	}
+	return 0
+}
+
+func (x *ProviderMetadata) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type AddProviderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID            int32         `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Number        string        `protobuf:"bytes,2,opt,name=Number,proto3" json:"Number,omitempty"`
-	Provider      *ProviderData `protobuf:"bytes,3,opt,name=Provider,proto3" json:"Provider,omitempty"`
-	IsActivated   bool          `protobuf:"varint,4,opt,name=IsActivated,proto3" json:"IsActivated,omitempty"`
-	ActivateUntil int64         `protobuf:"varint,5,opt,name=ActivateUntil,proto3" json:"ActivateUntil,omitempty"`
-	IsBlocked     bool          `protobuf:"varint,6,opt,name=IsBlocked,proto3" json:"IsBlocked,omitempty"`
+	Name     string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Metadata *ProviderMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *SimData) Reset() {
-	*x = SimData{}
+func (x *AddProviderRequest) Reset() {
+	*x = AddProviderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[11]
+		mi := &file_sim_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SimData) String() string {
+func (x *AddProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimData) ProtoMessage() {}
+func (*AddProviderRequest) ProtoMessage() {}
 
-func (x *SimData) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[11]
+func (x *AddProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,79 +1328,52 @@ func (x *SimData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimData.ProtoReflect.Descriptor instead.
-func (*SimData) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AddProviderRequest.ProtoReflect.Descriptor instead.
+func (*AddProviderRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SimData) GetID() int32 {
+func (x *AddProviderRequest) GetName() string {
 	if x != nil {
-		return x.ID
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *SimData) GetNumber() string {
-	if x != nil {
-		return x.Number
-	}
-	return ""
-}
-
-func (x *SimData) GetProvider() *ProviderData {
+func (x *AddProviderRequest) GetMetadata() *ProviderMetadata {
 	if x != nil {
-		return x.Provider
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *SimData) GetIsActivated() bool {
-	if x != nil {
-		return x.IsActivated
-	}
-	return false
-}
-
-func (x *SimData) GetActivateUntil() int64 {
-	if x != nil {
-		return x.ActivateUntil
-	}
-	return 0
-}
-
-func (x *SimData) GetIsBlocked() bool {
-	if x != nil {
-		return x.IsBlocked
-	}
-	return false
-}
-
-type USFSRequest struct {
+type UpdateProviderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SimID     int32 `protobuf:"varint,1,opt,name=SimID,proto3" json:"SimID,omitempty"`
-	ServiceID int32 `protobuf:"varint,2,opt,name=ServiceID,proto3" json:"ServiceID,omitempty"`
+	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Metadata   *ProviderMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 }
 
-func (x *USFSRequest) Reset() {
-	*x = USFSRequest{}
+func (x *UpdateProviderRequest) Reset() {
+	*x = UpdateProviderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[12]
+		mi := &file_sim_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *USFSRequest) String() string {
+func (x *UpdateProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*USFSRequest) ProtoMessage() {}
+func (*UpdateProviderRequest) ProtoMessage() {}
 
-func (x *USFSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[12]
+func (x *UpdateProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,50 +1384,58 @@ func (x *USFSRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use USFSRequest.ProtoReflect.Descriptor instead.
-func (*USFSRequest) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use UpdateProviderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProviderRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *USFSRequest) GetSimID() int32 {
+func (x *UpdateProviderRequest) GetId() int32 {
 	if x != nil {
-		return x.SimID
+		return x.Id
 	}
 	return 0
 }
 
-func (x *USFSRequest) GetServiceID() int32 {
+func (x *UpdateProviderRequest) GetMetadata() *ProviderMetadata {
 	if x != nil {
-		return x.ServiceID
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-type USFSResponse struct {
+func (x *UpdateProviderRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type RenameProviderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsUsed bool `protobuf:"varint,1,opt,name=IsUsed,proto3" json:"IsUsed,omitempty"`
+	ID   int32  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 }
 
-func (x *USFSResponse) Reset() {
-	*x = USFSResponse{}
+func (x *RenameProviderRequest) Reset() {
+	*x = RenameProviderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[13]
+		mi := &file_sim_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *USFSResponse) String() string {
+func (x *RenameProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*USFSResponse) ProtoMessage() {}
+func (*RenameProviderRequest) ProtoMessage() {}
 
-func (x *USFSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[13]
+func (x *RenameProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -729,43 +1446,50 @@ func (x *USFSResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use USFSResponse.ProtoReflect.Descriptor instead.
-func (*USFSResponse) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RenameProviderRequest.ProtoReflect.Descriptor instead.
+func (*RenameProviderRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *USFSResponse) GetIsUsed() bool {
+func (x *RenameProviderRequest) GetID() int32 {
 	if x != nil {
-		return x.IsUsed
+		return x.ID
 	}
-	return false
+	return 0
 }
 
-type ActivateSimRequest struct {
+func (x *RenameProviderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ProviderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider *ProviderData `protobuf:"bytes,1,opt,name=Provider,proto3" json:"Provider,omitempty"`
 }
 
-func (x *ActivateSimRequest) Reset() {
-	*x = ActivateSimRequest{}
+func (x *ProviderResponse) Reset() {
+	*x = ProviderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[14]
+		mi := &file_sim_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActivateSimRequest) String() string {
+func (x *ProviderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateSimRequest) ProtoMessage() {}
+func (*ProviderResponse) ProtoMessage() {}
 
-func (x *ActivateSimRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[14]
+func (x *ProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,43 +1500,45 @@ func (x *ActivateSimRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateSimRequest.ProtoReflect.Descriptor instead.
-func (*ActivateSimRequest) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ProviderResponse.ProtoReflect.Descriptor instead.
+func (*ProviderResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ActivateSimRequest) GetId() int32 {
+func (x *ProviderResponse) GetProvider() *ProviderData {
 	if x != nil {
-		return x.Id
+		return x.Provider
 	}
-	return 0
+	return nil
 }
 
-type ActivateSimResponse struct {
+// DeleteProviderRequest deletes a provider, a provider with sims is only deleted if ReassignToID is set.
+type DeleteProviderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsActivated bool `protobuf:"varint,1,opt,name=IsActivated,proto3" json:"IsActivated,omitempty"`
+	ID           int32 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ReassignToID int32 `protobuf:"varint,2,opt,name=ReassignToID,proto3" json:"ReassignToID,omitempty"` // the provider the sims of the deleted provider are moved to
 }
 
-func (x *ActivateSimResponse) Reset() {
-	*x = ActivateSimResponse{}
+func (x *DeleteProviderRequest) Reset() {
+	*x = DeleteProviderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[15]
+		mi := &file_sim_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActivateSimResponse) String() string {
+func (x *DeleteProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateSimResponse) ProtoMessage() {}
+func (*DeleteProviderRequest) ProtoMessage() {}
 
-func (x *ActivateSimResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[15]
+func (x *DeleteProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,44 +1549,51 @@ func (x *ActivateSimResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateSimResponse.ProtoReflect.Descriptor instead.
-func (*ActivateSimResponse) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DeleteProviderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProviderRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ActivateSimResponse) GetIsActivated() bool {
+func (x *DeleteProviderRequest) GetID() int32 {
 	if x != nil {
-		return x.IsActivated
+		return x.ID
 	}
-	return false
+	return 0
 }
 
-type ServiceData struct {
+func (x *DeleteProviderRequest) GetReassignToID() int32 {
+	if x != nil {
+		return x.ReassignToID
+	}
+	return 0
+}
+
+type DeleteProviderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int32  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Id             int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReassignedSims int32 `protobuf:"varint,2,opt,name=ReassignedSims,proto3" json:"ReassignedSims,omitempty"`
 }
 
-func (x *ServiceData) Reset() {
-	*x = ServiceData{}
+func (x *DeleteProviderResponse) Reset() {
+	*x = DeleteProviderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[16]
+		mi := &file_sim_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServiceData) String() string {
+func (x *DeleteProviderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceData) ProtoMessage() {}
+func (*DeleteProviderResponse) ProtoMessage() {}
 
-func (x *ServiceData) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[16]
+func (x *DeleteProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,50 +1604,53 @@ func (x *ServiceData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceData.ProtoReflect.Descriptor instead.
-func (*ServiceData) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DeleteProviderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProviderResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ServiceData) GetId() int32 {
+func (x *DeleteProviderResponse) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ServiceData) GetName() string {
+func (x *DeleteProviderResponse) GetReassignedSims() int32 {
 	if x != nil {
-		return x.Name
+		return x.ReassignedSims
 	}
-	return ""
+	return 0
 }
 
-type GSLResponse struct {
+// MergeProvidersRequest moves the sims of the source providers to the target provider and deletes the sources.
+// The names of the sources become aliases of the target.
+type MergeProvidersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Services []*ServiceData `protobuf:"bytes,1,rep,name=Services,proto3" json:"Services,omitempty"`
+	TargetID  int32   `protobuf:"varint,1,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
+	SourceIDs []int32 `protobuf:"varint,2,rep,packed,name=SourceIDs,proto3" json:"SourceIDs,omitempty"`
 }
 
-func (x *GSLResponse) Reset() {
-	*x = GSLResponse{}
+func (x *MergeProvidersRequest) Reset() {
+	*x = MergeProvidersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[17]
+		mi := &file_sim_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GSLResponse) String() string {
+func (x *MergeProvidersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GSLResponse) ProtoMessage() {}
+func (*MergeProvidersRequest) ProtoMessage() {}
 
-func (x *GSLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[17]
+func (x *MergeProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,43 +1661,52 @@ func (x *GSLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GSLResponse.ProtoReflect.Descriptor instead.
-func (*GSLResponse) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use MergeProvidersRequest.ProtoReflect.Descriptor instead.
+func (*MergeProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GSLResponse) GetServices() []*ServiceData {
+func (x *MergeProvidersRequest) GetTargetID() int32 {
 	if x != nil {
-		return x.Services
+		return x.TargetID
+	}
+	return 0
+}
+
+func (x *MergeProvidersRequest) GetSourceIDs() []int32 {
+	if x != nil {
+		return x.SourceIDs
 	}
 	return nil
 }
 
-type AddServiceRequest struct {
+type MergeProvidersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Provider  *ProviderData `protobuf:"bytes,1,opt,name=Provider,proto3" json:"Provider,omitempty"`
+	MovedSims int32         `protobuf:"varint,2,opt,name=MovedSims,proto3" json:"MovedSims,omitempty"`
+	Aliases   []string      `protobuf:"bytes,3,rep,name=Aliases,proto3" json:"Aliases,omitempty"`
 }
 
-func (x *AddServiceRequest) Reset() {
-	*x = AddServiceRequest{}
+func (x *MergeProvidersResponse) Reset() {
+	*x = MergeProvidersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[18]
+		mi := &file_sim_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddServiceRequest) String() string {
+func (x *MergeProvidersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddServiceRequest) ProtoMessage() {}
+func (*MergeProvidersResponse) ProtoMessage() {}
 
-func (x *AddServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[18]
+func (x *MergeProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,43 +1717,57 @@ func (x *AddServiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddServiceRequest.ProtoReflect.Descriptor instead.
-func (*AddServiceRequest) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use MergeProvidersResponse.ProtoReflect.Descriptor instead.
+func (*MergeProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *AddServiceRequest) GetName() string {
+func (x *MergeProvidersResponse) GetProvider() *ProviderData {
 	if x != nil {
-		return x.Name
+		return x.Provider
 	}
-	return ""
+	return nil
 }
 
-type AddServiceResponse struct {
+func (x *MergeProvidersResponse) GetMovedSims() int32 {
+	if x != nil {
+		return x.MovedSims
+	}
+	return 0
+}
+
+func (x *MergeProvidersResponse) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type GetProviderAliasesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProviderID int32 `protobuf:"varint,1,opt,name=ProviderID,proto3" json:"ProviderID,omitempty"`
 }
 
-func (x *AddServiceResponse) Reset() {
-	*x = AddServiceResponse{}
+func (x *GetProviderAliasesRequest) Reset() {
+	*x = GetProviderAliasesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[19]
+		mi := &file_sim_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddServiceResponse) String() string {
+func (x *GetProviderAliasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddServiceResponse) ProtoMessage() {}
+func (*GetProviderAliasesRequest) ProtoMessage() {}
 
-func (x *AddServiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[19]
+func (x *GetProviderAliasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,43 +1778,46 @@ func (x *AddServiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddServiceResponse.ProtoReflect.Descriptor instead.
-func (*AddServiceResponse) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetProviderAliasesRequest.ProtoReflect.Descriptor instead.
+func (*GetProviderAliasesRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *AddServiceResponse) GetId() int32 {
+func (x *GetProviderAliasesRequest) GetProviderID() int32 {
 	if x != nil {
-		return x.Id
+		return x.ProviderID
 	}
 	return 0
 }
 
-type DeleteServiceRequest struct {
+// ProviderAliasRequest names an alias of a provider. Aliases are matched case-insensitively,
+// ignoring everything but letters and digits, so "TELE-2" is the alias "tele2".
+type ProviderAliasRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID int32 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ProviderID int32  `protobuf:"varint,1,opt,name=ProviderID,proto3" json:"ProviderID,omitempty"`
+	Alias      string `protobuf:"bytes,2,opt,name=Alias,proto3" json:"Alias,omitempty"`
 }
 
-func (x *DeleteServiceRequest) Reset() {
-	*x = DeleteServiceRequest{}
+func (x *ProviderAliasRequest) Reset() {
+	*x = ProviderAliasRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[20]
+		mi := &file_sim_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteServiceRequest) String() string {
+func (x *ProviderAliasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteServiceRequest) ProtoMessage() {}
+func (*ProviderAliasRequest) ProtoMessage() {}
 
-func (x *DeleteServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[20]
+func (x *ProviderAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,43 +1828,51 @@ func (x *DeleteServiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteServiceRequest.ProtoReflect.Descriptor instead.
-func (*DeleteServiceRequest) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ProviderAliasRequest.ProtoReflect.Descriptor instead.
+func (*ProviderAliasRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DeleteServiceRequest) GetID() int32 {
+func (x *ProviderAliasRequest) GetProviderID() int32 {
 	if x != nil {
-		return x.ID
+		return x.ProviderID
 	}
 	return 0
 }
 
-type DeleteServiceResponse struct {
+func (x *ProviderAliasRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type ProviderAliasesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProviderID int32    `protobuf:"varint,1,opt,name=ProviderID,proto3" json:"ProviderID,omitempty"`
+	Aliases    []string `protobuf:"bytes,2,rep,name=Aliases,proto3" json:"Aliases,omitempty"`
 }
 
-func (x *DeleteServiceResponse) Reset() {
-	*x = DeleteServiceResponse{}
+func (x *ProviderAliasesResponse) Reset() {
+	*x = ProviderAliasesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[21]
+		mi := &file_sim_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteServiceResponse) String() string {
+func (x *ProviderAliasesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteServiceResponse) ProtoMessage() {}
+func (*ProviderAliasesResponse) ProtoMessage() {}
 
-func (x *DeleteServiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[21]
+func (x *ProviderAliasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1113,47 +1883,50 @@ func (x *DeleteServiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteServiceResponse.ProtoReflect.Descriptor instead.
-func (*DeleteServiceResponse) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ProviderAliasesResponse.ProtoReflect.Descriptor instead.
+func (*ProviderAliasesResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *DeleteServiceResponse) GetId() int32 {
+func (x *ProviderAliasesResponse) GetProviderID() int32 {
 	if x != nil {
-		return x.Id
+		return x.ProviderID
 	}
 	return 0
 }
 
-type AddSimData struct {
+func (x *ProviderAliasesResponse) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type SimList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Number        string `protobuf:"bytes,1,opt,name=Number,proto3" json:"Number,omitempty"`
-	ProviderName  string `protobuf:"bytes,2,opt,name=ProviderName,proto3" json:"ProviderName,omitempty"`
-	IsActivated   bool   `protobuf:"varint,3,opt,name=IsActivated,proto3" json:"IsActivated,omitempty"`
-	ActivateUntil int64  `protobuf:"varint,4,opt,name=ActivateUntil,proto3" json:"ActivateUntil,omitempty"`
-	IsBlocked     bool   `protobuf:"varint,5,opt,name=IsBlocked,proto3" json:"IsBlocked,omitempty"`
+	SimList []*SimData `protobuf:"bytes,1,rep,name=SimList,proto3" json:"SimList,omitempty"`
 }
 
-func (x *AddSimData) Reset() {
-	*x = AddSimData{}
+func (x *SimList) Reset() {
+	*x = SimList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[22]
+		mi := &file_sim_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddSimData) String() string {
+func (x *SimList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSimData) ProtoMessage() {}
+func (*SimList) ProtoMessage() {}
 
-func (x *AddSimData) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[22]
+func (x *SimList) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1164,71 +1937,155 @@ func (x *AddSimData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSimData.ProtoReflect.Descriptor instead.
-func (*AddSimData) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use SimList.ProtoReflect.Descriptor instead.
+func (*SimList) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *AddSimData) GetNumber() string {
+func (x *SimList) GetSimList() []*SimData {
+	if x != nil {
+		return x.SimList
+	}
+	return nil
+}
+
+type SimData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID            int32         `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Number        string        `protobuf:"bytes,2,opt,name=Number,proto3" json:"Number,omitempty"`
+	Provider      *ProviderData `protobuf:"bytes,3,opt,name=Provider,proto3" json:"Provider,omitempty"`
+	IsActivated   bool          `protobuf:"varint,4,opt,name=IsActivated,proto3" json:"IsActivated,omitempty"` // same as State == SIM_STATE_ACTIVE
+	ActivateUntil int64         `protobuf:"varint,5,opt,name=ActivateUntil,proto3" json:"ActivateUntil,omitempty"`
+	IsBlocked     bool          `protobuf:"varint,6,opt,name=IsBlocked,proto3" json:"IsBlocked,omitempty"` // same as State == SIM_STATE_BLOCKED
+	State         SimState      `protobuf:"varint,7,opt,name=State,proto3,enum=SimState" json:"State,omitempty"`
+	StateReason   string        `protobuf:"bytes,8,opt,name=StateReason,proto3" json:"StateReason,omitempty"`
+	Country       string        `protobuf:"bytes,9,opt,name=Country,proto3" json:"Country,omitempty"` // ISO 3166-1 alpha-2 code of the number country
+}
+
+func (x *SimData) Reset() {
+	*x = SimData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimData) ProtoMessage() {}
+
+func (x *SimData) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimData.ProtoReflect.Descriptor instead.
+func (*SimData) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SimData) GetID() int32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *SimData) GetNumber() string {
 	if x != nil {
 		return x.Number
 	}
 	return ""
 }
 
-func (x *AddSimData) GetProviderName() string {
+func (x *SimData) GetProvider() *ProviderData {
 	if x != nil {
-		return x.ProviderName
+		return x.Provider
 	}
-	return ""
+	return nil
 }
 
-func (x *AddSimData) GetIsActivated() bool {
+func (x *SimData) GetIsActivated() bool {
 	if x != nil {
 		return x.IsActivated
 	}
 	return false
 }
 
-func (x *AddSimData) GetActivateUntil() int64 {
+func (x *SimData) GetActivateUntil() int64 {
 	if x != nil {
 		return x.ActivateUntil
 	}
 	return 0
 }
 
-func (x *AddSimData) GetIsBlocked() bool {
+func (x *SimData) GetIsBlocked() bool {
 	if x != nil {
 		return x.IsBlocked
 	}
 	return false
 }
 
-type AddSimRequest struct {
+func (x *SimData) GetState() SimState {
+	if x != nil {
+		return x.State
+	}
+	return SimState_SIM_STATE_UNSPECIFIED
+}
+
+func (x *SimData) GetStateReason() string {
+	if x != nil {
+		return x.StateReason
+	}
+	return ""
+}
+
+func (x *SimData) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type USFSRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SimData *AddSimData `protobuf:"bytes,1,opt,name=SimData,proto3" json:"SimData,omitempty"`
+	SimID     int32 `protobuf:"varint,1,opt,name=SimID,proto3" json:"SimID,omitempty"`
+	ServiceID int32 `protobuf:"varint,2,opt,name=ServiceID,proto3" json:"ServiceID,omitempty"`
 }
 
-func (x *AddSimRequest) Reset() {
-	*x = AddSimRequest{}
+func (x *USFSRequest) Reset() {
+	*x = USFSRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[23]
+		mi := &file_sim_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddSimRequest) String() string {
+func (x *USFSRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSimRequest) ProtoMessage() {}
+func (*USFSRequest) ProtoMessage() {}
 
-func (x *AddSimRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[23]
+func (x *USFSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1239,44 +2096,50 @@ func (x *AddSimRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSimRequest.ProtoReflect.Descriptor instead.
-func (*AddSimRequest) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use USFSRequest.ProtoReflect.Descriptor instead.
+func (*USFSRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *AddSimRequest) GetSimData() *AddSimData {
+func (x *USFSRequest) GetSimID() int32 {
 	if x != nil {
-		return x.SimData
+		return x.SimID
 	}
-	return nil
+	return 0
 }
 
-type AddSimResponse struct {
+func (x *USFSRequest) GetServiceID() int32 {
+	if x != nil {
+		return x.ServiceID
+	}
+	return 0
+}
+
+type USFSResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
-	Id      int32  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	IsUsed bool `protobuf:"varint,1,opt,name=IsUsed,proto3" json:"IsUsed,omitempty"`
 }
 
-func (x *AddSimResponse) Reset() {
-	*x = AddSimResponse{}
+func (x *USFSResponse) Reset() {
+	*x = USFSResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[24]
+		mi := &file_sim_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddSimResponse) String() string {
+func (x *USFSResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSimResponse) ProtoMessage() {}
+func (*USFSResponse) ProtoMessage() {}
 
-func (x *AddSimResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[24]
+func (x *USFSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,50 +2150,44 @@ func (x *AddSimResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSimResponse.ProtoReflect.Descriptor instead.
-func (*AddSimResponse) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *AddSimResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use USFSResponse.ProtoReflect.Descriptor instead.
+func (*USFSResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *AddSimResponse) GetId() int32 {
+func (x *USFSResponse) GetIsUsed() bool {
 	if x != nil {
-		return x.Id
+		return x.IsUsed
 	}
-	return 0
+	return false
 }
 
-type DeleteSimRequest struct {
+type AcquireSimRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceID int32 `protobuf:"varint,1,opt,name=ServiceID,proto3" json:"ServiceID,omitempty"`
+	LeaseTTL  int64 `protobuf:"varint,2,opt,name=LeaseTTL,proto3" json:"LeaseTTL,omitempty"` // lease duration in seconds, server default is used if 0
 }
 
-func (x *DeleteSimRequest) Reset() {
-	*x = DeleteSimRequest{}
+func (x *AcquireSimRequest) Reset() {
+	*x = AcquireSimRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[25]
+		mi := &file_sim_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteSimRequest) String() string {
+func (x *AcquireSimRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSimRequest) ProtoMessage() {}
+func (*AcquireSimRequest) ProtoMessage() {}
 
-func (x *DeleteSimRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[25]
+func (x *AcquireSimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1341,43 +2198,52 @@ func (x *DeleteSimRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSimRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSimRequest) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AcquireSimRequest.ProtoReflect.Descriptor instead.
+func (*AcquireSimRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DeleteSimRequest) GetId() int32 {
+func (x *AcquireSimRequest) GetServiceID() int32 {
 	if x != nil {
-		return x.Id
+		return x.ServiceID
 	}
 	return 0
 }
 
-type DeleteSimResponse struct {
+func (x *AcquireSimRequest) GetLeaseTTL() int64 {
+	if x != nil {
+		return x.LeaseTTL
+	}
+	return 0
+}
+
+type AcquireSimResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LeaseID   string   `protobuf:"bytes,1,opt,name=LeaseID,proto3" json:"LeaseID,omitempty"`
+	Sim       *SimData `protobuf:"bytes,2,opt,name=Sim,proto3" json:"Sim,omitempty"`
+	ExpiresAt int64    `protobuf:"varint,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
 }
 
-func (x *DeleteSimResponse) Reset() {
-	*x = DeleteSimResponse{}
+func (x *AcquireSimResponse) Reset() {
+	*x = AcquireSimResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[26]
+		mi := &file_sim_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteSimResponse) String() string {
+func (x *AcquireSimResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSimResponse) ProtoMessage() {}
+func (*AcquireSimResponse) ProtoMessage() {}
 
-func (x *DeleteSimResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[26]
+func (x *AcquireSimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1388,257 +2254,4303 @@ func (x *DeleteSimResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSimResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSimResponse) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use AcquireSimResponse.ProtoReflect.Descriptor instead.
+func (*AcquireSimResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *DeleteSimResponse) GetId() int32 {
+func (x *AcquireSimResponse) GetLeaseID() string {
 	if x != nil {
-		return x.Id
+		return x.LeaseID
 	}
-	return 0
+	return ""
 }
 
-var File_sim_proto protoreflect.FileDescriptor
-
-var file_sim_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x73, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x1c, 0x0a, 0x0a, 0x53, 0x53, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x2b, 0x0a, 0x0b, 0x53, 0x53, 0x42, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22,
-	0x6b, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x47, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x55, 0x73, 0x65, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x69, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x69, 0x6d, 0x49,
-	0x64, 0x22, 0x3d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x72, 0x65, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05,
-	0x52, 0x0e, 0x46, 0x72, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73,
-	0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x32,
-	0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22,
-	0x2d, 0x0a, 0x07, 0x53, 0x69, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x53, 0x69,
-	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x69,
-	0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x53, 0x69, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xc2,
-	0x01, 0x0a, 0x07, 0x53, 0x69, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x29, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a,
-	0x0b, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x24, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x65, 0x64, 0x22, 0x41, 0x0a, 0x0b, 0x55, 0x53, 0x46, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x53, 0x69, 0x6d, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x22, 0x26, 0x0a, 0x0c, 0x55, 0x53, 0x46, 0x53, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x64, 0x22, 0x24,
-	0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x49,
-	0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x22, 0x31, 0x0a,
-	0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x37, 0x0a, 0x0b, 0x47, 0x53, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x41, 0x64, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x44,
-	0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x0a, 0x41, 0x64,
-	0x64, 0x53, 0x69, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x22, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
-	0x49, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x49, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x36, 0x0a, 0x0d, 0x41, 0x64,
-	0x64, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x53,
-	0x69, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41,
-	0x64, 0x64, 0x53, 0x69, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x53, 0x69, 0x6d, 0x44, 0x61,
-	0x74, 0x61, 0x22, 0x3a, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22,
-	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x32, 0xf4, 0x02, 0x0a, 0x03, 0x53, 0x69, 0x6d, 0x12,
-	0x2b, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6d, 0x12, 0x0e, 0x2e, 0x41, 0x64, 0x64, 0x53,
-	0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x41, 0x64, 0x64, 0x53,
-	0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x12, 0x11, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x69,
-	0x6d, 0x12, 0x13, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2c,
-	0x0a, 0x0d, 0x53, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12,
-	0x0b, 0x2e, 0x53, 0x53, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x53,
-	0x53, 0x42, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x20, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x08, 0x2e, 0x53, 0x69, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3e,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xae,
-	0x01, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x41, 0x64,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x41,
-	0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x0c, 0x2e, 0x47, 0x53, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32,
-	0x39, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x53, 0x69,
-	0x6d, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0c, 0x2e, 0x55, 0x53,
-	0x46, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x55, 0x53, 0x46, 0x53,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x36, 0x0a, 0x08, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x0d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
-	0x22, 0x00, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x4e, 0x69, 0x63, 0x6b, 0x2f, 0x53, 0x69, 0x6d, 0x48, 0x65,
-	0x6c, 0x70, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *AcquireSimResponse) GetSim() *SimData {
+	if x != nil {
+		return x.Sim
+	}
+	return nil
 }
 
-var (
-	file_sim_proto_rawDescOnce sync.Once
-	file_sim_proto_rawDescData = file_sim_proto_rawDesc
-)
-
-func file_sim_proto_rawDescGZIP() []byte {
-	file_sim_proto_rawDescOnce.Do(func() {
-		file_sim_proto_rawDescData = protoimpl.X.CompressGZIP(file_sim_proto_rawDescData)
-	})
-	return file_sim_proto_rawDescData
+func (x *AcquireSimResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
 }
 
-var file_sim_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_sim_proto_goTypes = []interface{}{
-	(*Empty)(nil),                 // 0: Empty
-	(*SSBRequest)(nil),            // 1: SSBRequest
-	(*SSBResponse)(nil),           // 2: SSBResponse
-	(*UsedService)(nil),           // 3: UsedService
-	(*GetUsedServResponse)(nil),   // 4: GetUsedServResponse
-	(*GetUsedServRequest)(nil),    // 5: GetUsedServRequest
-	(*GetFreeServResponse)(nil),   // 6: GetFreeServResponse
-	(*GetFreeServRequest)(nil),    // 7: GetFreeServRequest
-	(*ProviderData)(nil),          // 8: ProviderData
-	(*ProviderList)(nil),          // 9: ProviderList
-	(*SimList)(nil),               // 10: SimList
-	(*SimData)(nil),               // 11: SimData
-	(*USFSRequest)(nil),           // 12: USFSRequest
-	(*USFSResponse)(nil),          // 13: USFSResponse
-	(*ActivateSimRequest)(nil),    // 14: ActivateSimRequest
-	(*ActivateSimResponse)(nil),   // 15: ActivateSimResponse
-	(*ServiceData)(nil),           // 16: ServiceData
-	(*GSLResponse)(nil),           // 17: GSLResponse
-	(*AddServiceRequest)(nil),     // 18: AddServiceRequest
-	(*AddServiceResponse)(nil),    // 19: AddServiceResponse
-	(*DeleteServiceRequest)(nil),  // 20: DeleteServiceRequest
-	(*DeleteServiceResponse)(nil), // 21: DeleteServiceResponse
-	(*AddSimData)(nil),            // 22: AddSimData
-	(*AddSimRequest)(nil),         // 23: AddSimRequest
-	(*AddSimResponse)(nil),        // 24: AddSimResponse
-	(*DeleteSimRequest)(nil),      // 25: DeleteSimRequest
-	(*DeleteSimResponse)(nil),     // 26: DeleteSimResponse
+type LeaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=LeaseID,proto3" json:"LeaseID,omitempty"`
 }
-var file_sim_proto_depIdxs = []int32{
-	3,  // 0: GetUsedServResponse.UsedServices:type_name -> UsedService
-	8,  // 1: ProviderList.Providers:type_name -> ProviderData
-	11, // 2: SimList.SimList:type_name -> SimData
-	8,  // 3: SimData.Provider:type_name -> ProviderData
-	16, // 4: GSLResponse.Services:type_name -> ServiceData
-	22, // 5: AddSimRequest.SimData:type_name -> AddSimData
-	23, // 6: Sim.AddSim:input_type -> AddSimRequest
-	25, // 7: Sim.DeleteSim:input_type -> DeleteSimRequest
-	14, // 8: Sim.ActivateSim:input_type -> ActivateSimRequest
-	1,  // 9: Sim.SetSimBlocked:input_type -> SSBRequest
-	0,  // 10: Sim.GetSimList:input_type -> Empty
-	7,  // 11: Sim.GetFreeServices:input_type -> GetFreeServRequest
-	5,  // 12: Sim.GetUsedServices:input_type -> GetUsedServRequest
-	18, // 13: Service.AddService:input_type -> AddServiceRequest
-	20, // 14: Service.DeleteService:input_type -> DeleteServiceRequest
-	0,  // 15: Service.GetServiceList:input_type -> Empty
-	12, // 16: Used.UseSimForService:input_type -> USFSRequest
-	0,  // 17: Provider.GetProviderList:input_type -> Empty
-	24, // 18: Sim.AddSim:output_type -> AddSimResponse
-	26, // 19: Sim.DeleteSim:output_type -> DeleteSimResponse
-	15, // 20: Sim.ActivateSim:output_type -> ActivateSimResponse
-	2,  // 21: Sim.SetSimBlocked:output_type -> SSBResponse
-	10, // 22: Sim.GetSimList:output_type -> SimList
-	6,  // 23: Sim.GetFreeServices:output_type -> GetFreeServResponse
-	4,  // 24: Sim.GetUsedServices:output_type -> GetUsedServResponse
-	19, // 25: Service.AddService:output_type -> AddServiceResponse
-	21, // 26: Service.DeleteService:output_type -> DeleteServiceResponse
-	17, // 27: Service.GetServiceList:output_type -> GSLResponse
-	13, // 28: Used.UseSimForService:output_type -> USFSResponse
-	9,  // 29: Provider.GetProviderList:output_type -> ProviderList
-	18, // [18:30] is the sub-list for method output_type
-	6,  // [6:18] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+func (x *LeaseRequest) Reset() {
+	*x = LeaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func init() { file_sim_proto_init() }
-func file_sim_proto_init() {
-	if File_sim_proto != nil {
-		return
+func (x *LeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseRequest) ProtoMessage() {}
+
+func (x *LeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_sim_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseRequest.ProtoReflect.Descriptor instead.
+func (*LeaseRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LeaseRequest) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+type ConfirmLeaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UsedID int32 `protobuf:"varint,1,opt,name=UsedID,proto3" json:"UsedID,omitempty"`
+}
+
+func (x *ConfirmLeaseResponse) Reset() {
+	*x = ConfirmLeaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmLeaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmLeaseResponse) ProtoMessage() {}
+
+func (x *ConfirmLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmLeaseResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ConfirmLeaseResponse) GetUsedID() int32 {
+	if x != nil {
+		return x.UsedID
+	}
+	return 0
+}
+
+type ReleaseLeaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsReleased bool `protobuf:"varint,1,opt,name=IsReleased,proto3" json:"IsReleased,omitempty"`
+}
+
+func (x *ReleaseLeaseResponse) Reset() {
+	*x = ReleaseLeaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseLeaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLeaseResponse) ProtoMessage() {}
+
+func (x *ReleaseLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLeaseResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReleaseLeaseResponse) GetIsReleased() bool {
+	if x != nil {
+		return x.IsReleased
+	}
+	return false
+}
+
+// BlockUsedServiceRequest blocks the sim on the service, the used record is created if the sim was not used for it yet.
+type BlockUsedServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SimID     int32  `protobuf:"varint,1,opt,name=SimID,proto3" json:"SimID,omitempty"`
+	ServiceID int32  `protobuf:"varint,2,opt,name=ServiceID,proto3" json:"ServiceID,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"` // up to 64 characters
+}
+
+func (x *BlockUsedServiceRequest) Reset() {
+	*x = BlockUsedServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUsedServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUsedServiceRequest) ProtoMessage() {}
+
+func (x *BlockUsedServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUsedServiceRequest.ProtoReflect.Descriptor instead.
+func (*BlockUsedServiceRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BlockUsedServiceRequest) GetSimID() int32 {
+	if x != nil {
+		return x.SimID
+	}
+	return 0
+}
+
+func (x *BlockUsedServiceRequest) GetServiceID() int32 {
+	if x != nil {
+		return x.ServiceID
+	}
+	return 0
+}
+
+func (x *BlockUsedServiceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnblockUsedServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SimID     int32 `protobuf:"varint,1,opt,name=SimID,proto3" json:"SimID,omitempty"`
+	ServiceID int32 `protobuf:"varint,2,opt,name=ServiceID,proto3" json:"ServiceID,omitempty"`
+}
+
+func (x *UnblockUsedServiceRequest) Reset() {
+	*x = UnblockUsedServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUsedServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUsedServiceRequest) ProtoMessage() {}
+
+func (x *UnblockUsedServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUsedServiceRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUsedServiceRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UnblockUsedServiceRequest) GetSimID() int32 {
+	if x != nil {
+		return x.SimID
+	}
+	return 0
+}
+
+func (x *UnblockUsedServiceRequest) GetServiceID() int32 {
+	if x != nil {
+		return x.ServiceID
+	}
+	return 0
+}
+
+// ReleaseUsedServiceRequest releases the sim from the service, it can be used for the service again after the cooldown.
+type ReleaseUsedServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SimID     int32 `protobuf:"varint,1,opt,name=SimID,proto3" json:"SimID,omitempty"`
+	ServiceID int32 `protobuf:"varint,2,opt,name=ServiceID,proto3" json:"ServiceID,omitempty"`
+}
+
+func (x *ReleaseUsedServiceRequest) Reset() {
+	*x = ReleaseUsedServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseUsedServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseUsedServiceRequest) ProtoMessage() {}
+
+func (x *ReleaseUsedServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseUsedServiceRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseUsedServiceRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReleaseUsedServiceRequest) GetSimID() int32 {
+	if x != nil {
+		return x.SimID
+	}
+	return 0
+}
+
+func (x *ReleaseUsedServiceRequest) GetServiceID() int32 {
+	if x != nil {
+		return x.ServiceID
+	}
+	return 0
+}
+
+type UsedServiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UsedService *UsedService `protobuf:"bytes,1,opt,name=UsedService,proto3" json:"UsedService,omitempty"`
+}
+
+func (x *UsedServiceResponse) Reset() {
+	*x = UsedServiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsedServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsedServiceResponse) ProtoMessage() {}
+
+func (x *UsedServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsedServiceResponse.ProtoReflect.Descriptor instead.
+func (*UsedServiceResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UsedServiceResponse) GetUsedService() *UsedService {
+	if x != nil {
+		return x.UsedService
+	}
+	return nil
+}
+
+type EligibilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SimID     int32 `protobuf:"varint,1,opt,name=SimID,proto3" json:"SimID,omitempty"`
+	ServiceID int32 `protobuf:"varint,2,opt,name=ServiceID,proto3" json:"ServiceID,omitempty"`
+}
+
+func (x *EligibilityRequest) Reset() {
+	*x = EligibilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EligibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EligibilityRequest) ProtoMessage() {}
+
+func (x *EligibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EligibilityRequest.ProtoReflect.Descriptor instead.
+func (*EligibilityRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EligibilityRequest) GetSimID() int32 {
+	if x != nil {
+		return x.SimID
+	}
+	return 0
+}
+
+func (x *EligibilityRequest) GetServiceID() int32 {
+	if x != nil {
+		return x.ServiceID
+	}
+	return 0
+}
+
+// EligibilityResponse is also attached to the status details of a UseSimForService and ConfirmLease rejection.
+type EligibilityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eligible   bool                `protobuf:"varint,1,opt,name=Eligible,proto3" json:"Eligible,omitempty"`
+	Reasons    []EligibilityReason `protobuf:"varint,2,rep,packed,name=Reasons,proto3,enum=EligibilityReason" json:"Reasons,omitempty"`
+	EligibleAt int64               `protobuf:"varint,3,opt,name=EligibleAt,proto3" json:"EligibleAt,omitempty"` // unix timestamp, set for the cooldown reason
+}
+
+func (x *EligibilityResponse) Reset() {
+	*x = EligibilityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EligibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EligibilityResponse) ProtoMessage() {}
+
+func (x *EligibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EligibilityResponse.ProtoReflect.Descriptor instead.
+func (*EligibilityResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EligibilityResponse) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *EligibilityResponse) GetReasons() []EligibilityReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *EligibilityResponse) GetEligibleAt() int64 {
+	if x != nil {
+		return x.EligibleAt
+	}
+	return 0
+}
+
+type ActivateSimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// period of the activation, if not set the provider or server default period is used
+	//
+	// Types that are assignable to Period:
+	//	*ActivateSimRequest_ActivateUntil
+	//	*ActivateSimRequest_Duration
+	Period isActivateSimRequest_Period `protobuf_oneof:"period"`
+	Reason string                      `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ActivateSimRequest) Reset() {
+	*x = ActivateSimRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateSimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateSimRequest) ProtoMessage() {}
+
+func (x *ActivateSimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateSimRequest.ProtoReflect.Descriptor instead.
+func (*ActivateSimRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ActivateSimRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (m *ActivateSimRequest) GetPeriod() isActivateSimRequest_Period {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+func (x *ActivateSimRequest) GetActivateUntil() int64 {
+	if x, ok := x.GetPeriod().(*ActivateSimRequest_ActivateUntil); ok {
+		return x.ActivateUntil
+	}
+	return 0
+}
+
+func (x *ActivateSimRequest) GetDuration() int64 {
+	if x, ok := x.GetPeriod().(*ActivateSimRequest_Duration); ok {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ActivateSimRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type isActivateSimRequest_Period interface {
+	isActivateSimRequest_Period()
+}
+
+type ActivateSimRequest_ActivateUntil struct {
+	ActivateUntil int64 `protobuf:"varint,2,opt,name=ActivateUntil,proto3,oneof"` // unix timestamp
+}
+
+type ActivateSimRequest_Duration struct {
+	Duration int64 `protobuf:"varint,3,opt,name=Duration,proto3,oneof"` // seconds, extends an active sim from its current ActivateUntil
+}
+
+func (*ActivateSimRequest_ActivateUntil) isActivateSimRequest_Period() {}
+
+func (*ActivateSimRequest_Duration) isActivateSimRequest_Period() {}
+
+type ActivateSimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsActivated   bool  `protobuf:"varint,1,opt,name=IsActivated,proto3" json:"IsActivated,omitempty"`
+	ActivateUntil int64 `protobuf:"varint,2,opt,name=ActivateUntil,proto3" json:"ActivateUntil,omitempty"`
+}
+
+func (x *ActivateSimResponse) Reset() {
+	*x = ActivateSimResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateSimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateSimResponse) ProtoMessage() {}
+
+func (x *ActivateSimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateSimResponse.ProtoReflect.Descriptor instead.
+func (*ActivateSimResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ActivateSimResponse) GetIsActivated() bool {
+	if x != nil {
+		return x.IsActivated
+	}
+	return false
+}
+
+func (x *ActivateSimResponse) GetActivateUntil() int64 {
+	if x != nil {
+		return x.ActivateUntil
+	}
+	return 0
+}
+
+type ActivationData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int32          `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	SimId         int32          `protobuf:"varint,2,opt,name=SimId,proto3" json:"SimId,omitempty"`
+	Kind          ActivationKind `protobuf:"varint,3,opt,name=Kind,proto3,enum=ActivationKind" json:"Kind,omitempty"`
+	ActivatedAt   int64          `protobuf:"varint,4,opt,name=ActivatedAt,proto3" json:"ActivatedAt,omitempty"`
+	PreviousUntil int64          `protobuf:"varint,5,opt,name=PreviousUntil,proto3" json:"PreviousUntil,omitempty"`
+	ActivateUntil int64          `protobuf:"varint,6,opt,name=ActivateUntil,proto3" json:"ActivateUntil,omitempty"`
+}
+
+func (x *ActivationData) Reset() {
+	*x = ActivationData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivationData) ProtoMessage() {}
+
+func (x *ActivationData) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivationData.ProtoReflect.Descriptor instead.
+func (*ActivationData) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ActivationData) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivationData) GetSimId() int32 {
+	if x != nil {
+		return x.SimId
+	}
+	return 0
+}
+
+func (x *ActivationData) GetKind() ActivationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ActivationKind_ACTIVATION_KIND_UNSPECIFIED
+}
+
+func (x *ActivationData) GetActivatedAt() int64 {
+	if x != nil {
+		return x.ActivatedAt
+	}
+	return 0
+}
+
+func (x *ActivationData) GetPreviousUntil() int64 {
+	if x != nil {
+		return x.PreviousUntil
+	}
+	return 0
+}
+
+func (x *ActivationData) GetActivateUntil() int64 {
+	if x != nil {
+		return x.ActivateUntil
+	}
+	return 0
+}
+
+type GAHRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SimId int32 `protobuf:"varint,1,opt,name=SimId,proto3" json:"SimId,omitempty"`
+}
+
+func (x *GAHRequest) Reset() {
+	*x = GAHRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GAHRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GAHRequest) ProtoMessage() {}
+
+func (x *GAHRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GAHRequest.ProtoReflect.Descriptor instead.
+func (*GAHRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GAHRequest) GetSimId() int32 {
+	if x != nil {
+		return x.SimId
+	}
+	return 0
+}
+
+type GAHResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Activations []*ActivationData `protobuf:"bytes,1,rep,name=Activations,proto3" json:"Activations,omitempty"`
+}
+
+func (x *GAHResponse) Reset() {
+	*x = GAHResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GAHResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GAHResponse) ProtoMessage() {}
+
+func (x *GAHResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GAHResponse.ProtoReflect.Descriptor instead.
+func (*GAHResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GAHResponse) GetActivations() []*ActivationData {
+	if x != nil {
+		return x.Activations
+	}
+	return nil
+}
+
+type ServiceData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int32            `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name             string           `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Url              string           `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Category         string           `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	VerificationType VerificationType `protobuf:"varint,5,opt,name=verificationType,proto3,enum=VerificationType" json:"verificationType,omitempty"`
+	Countries        []string         `protobuf:"bytes,6,rep,name=countries,proto3" json:"countries,omitempty"` // ISO 3166-1 alpha-2, empty if the service accepts any country
+	Enabled          bool             `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ParentId         int32            `protobuf:"varint,8,opt,name=parentId,proto3" json:"parentId,omitempty"` // top level service of the group of the service, 0 for a top level service
+}
+
+func (x *ServiceData) Reset() {
+	*x = ServiceData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceData) ProtoMessage() {}
+
+func (x *ServiceData) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceData.ProtoReflect.Descriptor instead.
+func (*ServiceData) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ServiceData) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ServiceData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceData) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ServiceData) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ServiceData) GetVerificationType() VerificationType {
+	if x != nil {
+		return x.VerificationType
+	}
+	return VerificationType_VERIFICATION_TYPE_UNSPECIFIED
+}
+
+func (x *ServiceData) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *ServiceData) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ServiceData) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+// ServiceMetadata describes a service. Empty values mean unknown.
+type ServiceMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url              string           `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`           // http or https
+	Category         string           `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // e.g. messenger, up to 32 characters
+	VerificationType VerificationType `protobuf:"varint,3,opt,name=verification_type,json=verificationType,proto3,enum=VerificationType" json:"verification_type,omitempty"`
+	Countries        []string         `protobuf:"bytes,4,rep,name=countries,proto3" json:"countries,omitempty"`    // ISO 3166-1 alpha-2, empty if the service accepts any country
+	Enabled          *bool            `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"` // a new service is enabled if not set
+	// parent_id links the service to the group of a top level service, 0 makes it a top level service.
+	// The services of a group share an account backend, a sim is used for them as one unit.
+	ParentId int32 `protobuf:"varint,6,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+}
+
+func (x *ServiceMetadata) Reset() {
+	*x = ServiceMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceMetadata) ProtoMessage() {}
+
+func (x *ServiceMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceMetadata.ProtoReflect.Descriptor instead.
+func (*ServiceMetadata) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ServiceMetadata) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ServiceMetadata) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ServiceMetadata) GetVerificationType() VerificationType {
+	if x != nil {
+		return x.VerificationType
+	}
+	return VerificationType_VERIFICATION_TYPE_UNSPECIFIED
+}
+
+func (x *ServiceMetadata) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *ServiceMetadata) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *ServiceMetadata) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+// UpdateServiceRequest changes the fields of the update mask,
+// the paths are name and the fields of ServiceMetadata.
+type UpdateServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Metadata   *ServiceMetadata       `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateServiceRequest) Reset() {
+	*x = UpdateServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceRequest) ProtoMessage() {}
+
+func (x *UpdateServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServiceRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateServiceRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateServiceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateServiceRequest) GetMetadata() *ServiceMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UpdateServiceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type ServiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service *ServiceData `protobuf:"bytes,1,opt,name=Service,proto3" json:"Service,omitempty"`
+}
+
+func (x *ServiceResponse) Reset() {
+	*x = ServiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceResponse) ProtoMessage() {}
+
+func (x *ServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceResponse.ProtoReflect.Descriptor instead.
+func (*ServiceResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ServiceResponse) GetService() *ServiceData {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+type GSLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Services []*ServiceData `protobuf:"bytes,1,rep,name=Services,proto3" json:"Services,omitempty"`
+}
+
+func (x *GSLResponse) Reset() {
+	*x = GSLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GSLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GSLResponse) ProtoMessage() {}
+
+func (x *GSLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GSLResponse.ProtoReflect.Descriptor instead.
+func (*GSLResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GSLResponse) GetServices() []*ServiceData {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type AddServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string           `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Metadata *ServiceMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *AddServiceRequest) Reset() {
+	*x = AddServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddServiceRequest) ProtoMessage() {}
+
+func (x *AddServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddServiceRequest.ProtoReflect.Descriptor instead.
+func (*AddServiceRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AddServiceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddServiceRequest) GetMetadata() *ServiceMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type AddServiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AddServiceResponse) Reset() {
+	*x = AddServiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddServiceResponse) ProtoMessage() {}
+
+func (x *AddServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddServiceResponse.ProtoReflect.Descriptor instead.
+func (*AddServiceResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AddServiceResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int32 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteServiceRequest) Reset() {
+	*x = DeleteServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceRequest) ProtoMessage() {}
+
+func (x *DeleteServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServiceRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteServiceRequest) GetID() int32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type DeleteServiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteServiceResponse) Reset() {
+	*x = DeleteServiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceResponse) ProtoMessage() {}
+
+func (x *DeleteServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteServiceResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteServiceResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreServiceRequest) Reset() {
+	*x = RestoreServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreServiceRequest) ProtoMessage() {}
+
+func (x *RestoreServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreServiceRequest.ProtoReflect.Descriptor instead.
+func (*RestoreServiceRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RestoreServiceRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AddSimData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number string `protobuf:"bytes,1,opt,name=Number,proto3" json:"Number,omitempty"` // international format, e.g. +7 999 888-77-66
+	// empty to infer the provider from the country of the number, the country must have exactly one provider.
+	// The network of the number is not known, the mnc of the providers is not used.
+	ProviderName  string `protobuf:"bytes,2,opt,name=ProviderName,proto3" json:"ProviderName,omitempty"`
+	IsActivated   bool   `protobuf:"varint,3,opt,name=IsActivated,proto3" json:"IsActivated,omitempty"`
+	ActivateUntil int64  `protobuf:"varint,4,opt,name=ActivateUntil,proto3" json:"ActivateUntil,omitempty"`
+	IsBlocked     bool   `protobuf:"varint,5,opt,name=IsBlocked,proto3" json:"IsBlocked,omitempty"`
+}
+
+func (x *AddSimData) Reset() {
+	*x = AddSimData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSimData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSimData) ProtoMessage() {}
+
+func (x *AddSimData) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSimData.ProtoReflect.Descriptor instead.
+func (*AddSimData) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AddSimData) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *AddSimData) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *AddSimData) GetIsActivated() bool {
+	if x != nil {
+		return x.IsActivated
+	}
+	return false
+}
+
+func (x *AddSimData) GetActivateUntil() int64 {
+	if x != nil {
+		return x.ActivateUntil
+	}
+	return 0
+}
+
+func (x *AddSimData) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+type AddSimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SimData *AddSimData `protobuf:"bytes,1,opt,name=SimData,proto3" json:"SimData,omitempty"`
+}
+
+func (x *AddSimRequest) Reset() {
+	*x = AddSimRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSimRequest) ProtoMessage() {}
+
+func (x *AddSimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSimRequest.ProtoReflect.Descriptor instead.
+func (*AddSimRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AddSimRequest) GetSimData() *AddSimData {
+	if x != nil {
+		return x.SimData
+	}
+	return nil
+}
+
+type AddSimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	Id      int32  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AddSimResponse) Reset() {
+	*x = AddSimResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSimResponse) ProtoMessage() {}
+
+func (x *AddSimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSimResponse.ProtoReflect.Descriptor instead.
+func (*AddSimResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AddSimResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddSimResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteSimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteSimRequest) Reset() {
+	*x = DeleteSimRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSimRequest) ProtoMessage() {}
+
+func (x *DeleteSimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSimRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSimRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteSimRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteSimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteSimResponse) Reset() {
+	*x = DeleteSimResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSimResponse) ProtoMessage() {}
+
+func (x *DeleteSimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSimResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSimResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeleteSimResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreSimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreSimRequest) Reset() {
+	*x = RestoreSimRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreSimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSimRequest) ProtoMessage() {}
+
+func (x *RestoreSimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSimRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSimRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RestoreSimRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// UpdateSimData holds the new values of the fields listed in the update mask.
+// Field names are used as update mask paths: number, provider_name, activate_until.
+type UpdateSimData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number        string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	ProviderName  string `protobuf:"bytes,2,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	ActivateUntil int64  `protobuf:"varint,3,opt,name=activate_until,json=activateUntil,proto3" json:"activate_until,omitempty"`
+}
+
+func (x *UpdateSimData) Reset() {
+	*x = UpdateSimData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSimData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSimData) ProtoMessage() {}
+
+func (x *UpdateSimData) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSimData.ProtoReflect.Descriptor instead.
+func (*UpdateSimData) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateSimData) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *UpdateSimData) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *UpdateSimData) GetActivateUntil() int64 {
+	if x != nil {
+		return x.ActivateUntil
+	}
+	return 0
+}
+
+type UpdateSimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sim        *UpdateSimData         `protobuf:"bytes,2,opt,name=sim,proto3" json:"sim,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateSimRequest) Reset() {
+	*x = UpdateSimRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSimRequest) ProtoMessage() {}
+
+func (x *UpdateSimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSimRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSimRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UpdateSimRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSimRequest) GetSim() *UpdateSimData {
+	if x != nil {
+		return x.Sim
+	}
+	return nil
+}
+
+func (x *UpdateSimRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateSimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sim *SimData `protobuf:"bytes,1,opt,name=Sim,proto3" json:"Sim,omitempty"`
+}
+
+func (x *UpdateSimResponse) Reset() {
+	*x = UpdateSimResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSimResponse) ProtoMessage() {}
+
+func (x *UpdateSimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSimResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSimResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UpdateSimResponse) GetSim() *SimData {
+	if x != nil {
+		return x.Sim
+	}
+	return nil
+}
+
+type GetSimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSimRequest) Reset() {
+	*x = GetSimRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimRequest) ProtoMessage() {}
+
+func (x *GetSimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimRequest.ProtoReflect.Descriptor instead.
+func (*GetSimRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetSimRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSimByNumberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *GetSimByNumberRequest) Reset() {
+	*x = GetSimByNumberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSimByNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimByNumberRequest) ProtoMessage() {}
+
+func (x *GetSimByNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimByNumberRequest.ProtoReflect.Descriptor instead.
+func (*GetSimByNumberRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetSimByNumberRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type GetSimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sim *SimData `protobuf:"bytes,1,opt,name=Sim,proto3" json:"Sim,omitempty"`
+}
+
+func (x *GetSimResponse) Reset() {
+	*x = GetSimResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimResponse) ProtoMessage() {}
+
+func (x *GetSimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimResponse.ProtoReflect.Descriptor instead.
+func (*GetSimResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetSimResponse) GetSim() *SimData {
+	if x != nil {
+		return x.Sim
+	}
+	return nil
+}
+
+// SimFilter selects sims by their fields, unset fields do not filter.
+type SimFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProviderId          int32      `protobuf:"varint,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	ProviderName        string     `protobuf:"bytes,2,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	IsActivated         *bool      `protobuf:"varint,3,opt,name=is_activated,json=isActivated,proto3,oneof" json:"is_activated,omitempty"`
+	IsBlocked           *bool      `protobuf:"varint,4,opt,name=is_blocked,json=isBlocked,proto3,oneof" json:"is_blocked,omitempty"`
+	States              []SimState `protobuf:"varint,5,rep,packed,name=states,proto3,enum=SimState" json:"states,omitempty"`
+	ActivateUntilBefore int64      `protobuf:"varint,6,opt,name=activate_until_before,json=activateUntilBefore,proto3" json:"activate_until_before,omitempty"` // exclusive unix timestamp
+	ActivateUntilAfter  int64      `protobuf:"varint,7,opt,name=activate_until_after,json=activateUntilAfter,proto3" json:"activate_until_after,omitempty"`    // exclusive unix timestamp
+	NumberPrefix        string     `protobuf:"bytes,8,opt,name=number_prefix,json=numberPrefix,proto3" json:"number_prefix,omitempty"`                         // digits after the +
+	Country             string     `protobuf:"bytes,9,opt,name=country,proto3" json:"country,omitempty"`                                                       // ISO 3166-1 alpha-2 code
+}
+
+func (x *SimFilter) Reset() {
+	*x = SimFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimFilter) ProtoMessage() {}
+
+func (x *SimFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimFilter.ProtoReflect.Descriptor instead.
+func (*SimFilter) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SimFilter) GetProviderId() int32 {
+	if x != nil {
+		return x.ProviderId
+	}
+	return 0
+}
+
+func (x *SimFilter) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *SimFilter) GetIsActivated() bool {
+	if x != nil && x.IsActivated != nil {
+		return *x.IsActivated
+	}
+	return false
+}
+
+func (x *SimFilter) GetIsBlocked() bool {
+	if x != nil && x.IsBlocked != nil {
+		return *x.IsBlocked
+	}
+	return false
+}
+
+func (x *SimFilter) GetStates() []SimState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *SimFilter) GetActivateUntilBefore() int64 {
+	if x != nil {
+		return x.ActivateUntilBefore
+	}
+	return 0
+}
+
+func (x *SimFilter) GetActivateUntilAfter() int64 {
+	if x != nil {
+		return x.ActivateUntilAfter
+	}
+	return 0
+}
+
+func (x *SimFilter) GetNumberPrefix() string {
+	if x != nil {
+		return x.NumberPrefix
+	}
+	return ""
+}
+
+func (x *SimFilter) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type ListSimsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter     *SimFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortBy     SimSortKey `protobuf:"varint,2,opt,name=sort_by,json=sortBy,proto3,enum=SimSortKey" json:"sort_by,omitempty"`
+	Descending bool       `protobuf:"varint,3,opt,name=descending,proto3" json:"descending,omitempty"`
+	PageSize   int32      `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 50 if 0, at most 500
+	PageToken  string     `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, filter and sort must not change between pages
+}
+
+func (x *ListSimsRequest) Reset() {
+	*x = ListSimsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSimsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSimsRequest) ProtoMessage() {}
+
+func (x *ListSimsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSimsRequest.ProtoReflect.Descriptor instead.
+func (*ListSimsRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListSimsRequest) GetFilter() *SimFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListSimsRequest) GetSortBy() SimSortKey {
+	if x != nil {
+		return x.SortBy
+	}
+	return SimSortKey_SIM_SORT_KEY_ID
+}
+
+func (x *ListSimsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListSimsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSimsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSimsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sims          []*SimData `protobuf:"bytes,1,rep,name=Sims,proto3" json:"Sims,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+}
+
+func (x *ListSimsResponse) Reset() {
+	*x = ListSimsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSimsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSimsResponse) ProtoMessage() {}
+
+func (x *ListSimsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSimsResponse.ProtoReflect.Descriptor instead.
+func (*ListSimsResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListSimsResponse) GetSims() []*SimData {
+	if x != nil {
+		return x.Sims
+	}
+	return nil
+}
+
+func (x *ListSimsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ImportSimsRequest is one message of an import stream. A stream has either sims or csv chunks.
+type ImportSimsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllOrNothing bool `protobuf:"varint,1,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"` // read from the first message of the stream
+	// Types that are assignable to Payload:
+	//	*ImportSimsRequest_Sim
+	//	*ImportSimsRequest_CsvChunk
+	Payload isImportSimsRequest_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *ImportSimsRequest) Reset() {
+	*x = ImportSimsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSimsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSimsRequest) ProtoMessage() {}
+
+func (x *ImportSimsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSimsRequest.ProtoReflect.Descriptor instead.
+func (*ImportSimsRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ImportSimsRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+func (m *ImportSimsRequest) GetPayload() isImportSimsRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *ImportSimsRequest) GetSim() *AddSimData {
+	if x, ok := x.GetPayload().(*ImportSimsRequest_Sim); ok {
+		return x.Sim
+	}
+	return nil
+}
+
+func (x *ImportSimsRequest) GetCsvChunk() []byte {
+	if x, ok := x.GetPayload().(*ImportSimsRequest_CsvChunk); ok {
+		return x.CsvChunk
+	}
+	return nil
+}
+
+type isImportSimsRequest_Payload interface {
+	isImportSimsRequest_Payload()
+}
+
+type ImportSimsRequest_Sim struct {
+	Sim *AddSimData `protobuf:"bytes,2,opt,name=sim,proto3,oneof"`
+}
+
+type ImportSimsRequest_CsvChunk struct {
+	// part of a csv file with the columns: number, provider_name, is_activated, activate_until, is_blocked.
+	// The last three columns are optional, a first row starting with "number" is a header.
+	CsvChunk []byte `protobuf:"bytes,3,opt,name=csv_chunk,json=csvChunk,proto3,oneof"`
+}
+
+func (*ImportSimsRequest_Sim) isImportSimsRequest_Payload() {}
+
+func (*ImportSimsRequest_CsvChunk) isImportSimsRequest_Payload() {}
+
+type ImportRowResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row    int32           `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // 1-based, the csv header is not counted
+	Number string          `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Status ImportRowStatus `protobuf:"varint,3,opt,name=status,proto3,enum=ImportRowStatus" json:"status,omitempty"`
+	SimId  int32           `protobuf:"varint,4,opt,name=sim_id,json=simId,proto3" json:"sim_id,omitempty"` // created sim, or existing sim of a duplicate
+	Error  string          `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ImportRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetStatus() ImportRowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED
+}
+
+func (x *ImportRowResult) GetSimId() int32 {
+	if x != nil {
+		return x.SimId
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportSimsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results    []*ImportRowResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Created    int32              `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Duplicates int32              `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Invalid    int32              `protobuf:"varint,4,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	Committed  bool               `protobuf:"varint,5,opt,name=committed,proto3" json:"committed,omitempty"` // false if an all-or-nothing import was rolled back
+}
+
+func (x *ImportSimsResponse) Reset() {
+	*x = ImportSimsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSimsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSimsResponse) ProtoMessage() {}
+
+func (x *ImportSimsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSimsResponse.ProtoReflect.Descriptor instead.
+func (*ImportSimsResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ImportSimsResponse) GetResults() []*ImportRowResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportSimsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportSimsResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *ImportSimsResponse) GetInvalid() int32 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+func (x *ImportSimsResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+type ExportInventoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity    InventoryEntity `protobuf:"varint,1,opt,name=entity,proto3,enum=InventoryEntity" json:"entity,omitempty"`
+	Format    ExportFormat    `protobuf:"varint,2,opt,name=format,proto3,enum=ExportFormat" json:"format,omitempty"`
+	SimFilter *SimFilter      `protobuf:"bytes,3,opt,name=sim_filter,json=simFilter,proto3" json:"sim_filter,omitempty"`  // selects the sims of sims and usage exports
+	ServiceId int32           `protobuf:"varint,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // selects the service of services and usage exports, all services if 0
+}
+
+func (x *ExportInventoryRequest) Reset() {
+	*x = ExportInventoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInventoryRequest) ProtoMessage() {}
+
+func (x *ExportInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInventoryRequest.ProtoReflect.Descriptor instead.
+func (*ExportInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ExportInventoryRequest) GetEntity() InventoryEntity {
+	if x != nil {
+		return x.Entity
+	}
+	return InventoryEntity_INVENTORY_ENTITY_UNSPECIFIED
+}
+
+func (x *ExportInventoryRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportInventoryRequest) GetSimFilter() *SimFilter {
+	if x != nil {
+		return x.SimFilter
+	}
+	return nil
+}
+
+func (x *ExportInventoryRequest) GetServiceId() int32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+// ExportInventoryResponse is a chunk of the export file, chunks are sent in order.
+type ExportInventoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportInventoryResponse) Reset() {
+	*x = ExportInventoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInventoryResponse) ProtoMessage() {}
+
+func (x *ExportInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInventoryResponse.ProtoReflect.Descriptor instead.
+func (*ExportInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ExportInventoryResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// QueryAuditLogRequest selects audit entries, unset fields do not filter.
+type QueryAuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityType AuditEntity `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=AuditEntity" json:"entity_type,omitempty"`
+	EntityId   int32       `protobuf:"varint,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"` // requires entity_type
+	Actor      string      `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	From       int64       `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`   // unix timestamp, inclusive
+	To         int64       `protobuf:"varint,5,opt,name=to,proto3" json:"to,omitempty"`       // unix timestamp, inclusive
+	Limit      int32       `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"` // 100 if 0, at most 1000
+}
+
+func (x *QueryAuditLogRequest) Reset() {
+	*x = QueryAuditLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogRequest) ProtoMessage() {}
+
+func (x *QueryAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *QueryAuditLogRequest) GetEntityType() AuditEntity {
+	if x != nil {
+		return x.EntityType
+	}
+	return AuditEntity_AUDIT_ENTITY_UNSPECIFIED
+}
+
+func (x *QueryAuditLogRequest) GetEntityId() int32 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AuditEntryData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor      string      `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Rpc        string      `protobuf:"bytes,3,opt,name=rpc,proto3" json:"rpc,omitempty"` // full gRPC method, empty for the mutations of background workers
+	Action     string      `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	EntityType AuditEntity `protobuf:"varint,5,opt,name=entity_type,json=entityType,proto3,enum=AuditEntity" json:"entity_type,omitempty"`
+	EntityId   int32       `protobuf:"varint,6,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Before     string      `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"` // JSON value of the entity before the mutation, empty for an added entity
+	After      string      `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`   // JSON value of the entity after the mutation, empty for a deleted entity
+	CreatedAt  int64       `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AuditEntryData) Reset() {
+	*x = AuditEntryData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntryData) ProtoMessage() {}
+
+func (x *AuditEntryData) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntryData.ProtoReflect.Descriptor instead.
+func (*AuditEntryData) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AuditEntryData) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEntryData) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEntryData) GetRpc() string {
+	if x != nil {
+		return x.Rpc
+	}
+	return ""
+}
+
+func (x *AuditEntryData) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntryData) GetEntityType() AuditEntity {
+	if x != nil {
+		return x.EntityType
+	}
+	return AuditEntity_AUDIT_ENTITY_UNSPECIFIED
+}
+
+func (x *AuditEntryData) GetEntityId() int32 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *AuditEntryData) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEntryData) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEntryData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type QueryAuditLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*AuditEntryData `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // newest first
+}
+
+func (x *QueryAuditLogResponse) Reset() {
+	*x = QueryAuditLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogResponse) ProtoMessage() {}
+
+func (x *QueryAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *QueryAuditLogResponse) GetEntries() []*AuditEntryData {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+var File_sim_proto protoreflect.FileDescriptor
+
+var file_sim_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x73, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x07, 0x0a,
+	0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x34, 0x0a, 0x0a, 0x53, 0x53, 0x42, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x0b,
+	0x53, 0x53, 0x42, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x3e, 0x0a, 0x14, 0x53, 0x69, 0x6d,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x15, 0x53, 0x69, 0x6d,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x03, 0x53, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x53, 0x69, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x03, 0x53, 0x69, 0x6d, 0x22, 0xe3,
+	0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x55,
+	0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x0c, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x2a, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x73, 0x69, 0x6d, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x46, 0x72, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x46, 0x72, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x46, 0x72, 0x65, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x0c, 0x46, 0x72, 0x65, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x46, 0x72,
+	0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x46, 0x72, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xe8, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a,
+	0x17, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x63, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x63, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x6e, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x6e, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79,
+	0x43, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x6c, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0x3b, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x22, 0xcb, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x63, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x63,
+	0x63, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x6e, 0x63, 0x12, 0x3a, 0x0a, 0x19, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x43, 0x6f,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x57,
+	0x0a, 0x12, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x3b, 0x0a,
+	0x15, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x10, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x4b, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x6f,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x54, 0x6f, 0x49, 0x44, 0x22, 0x50, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x26, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x69,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x53, 0x69, 0x6d, 0x73, 0x22, 0x51, 0x0a, 0x15, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x09, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x73, 0x22, 0x7b, 0x0a, 0x16, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x69, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x4d, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x69, 0x6d, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x22, 0x3b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x4c, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a,
+	0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x22, 0x53, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x07, 0x53, 0x69, 0x6d, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x53, 0x69, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x69, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07,
+	0x53, 0x69, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x9f, 0x02, 0x0a, 0x07, 0x53, 0x69, 0x6d, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x49, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x49, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x05,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x53, 0x69,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x41, 0x0a, 0x0b, 0x55, 0x53, 0x46,
+	0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6d, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x69, 0x6d, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x22, 0x26, 0x0a, 0x0c,
+	0x55, 0x53, 0x46, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x49, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x49, 0x73,
+	0x55, 0x73, 0x65, 0x64, 0x22, 0x4d, 0x0a, 0x11, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x53,
+	0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x54, 0x54, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x54, 0x54, 0x4c, 0x22, 0x68, 0x0a, 0x12, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x53, 0x69,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x03, 0x53, 0x69, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x53, 0x69, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x03, 0x53, 0x69, 0x6d, 0x12,
+	0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x28, 0x0a,
+	0x0c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x22, 0x2e, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x55, 0x73, 0x65, 0x64, 0x49, 0x44, 0x22, 0x36, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x22,
+	0x65, 0x0a, 0x17, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69,
+	0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x69, 0x6d, 0x49, 0x44,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x19, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x53, 0x69, 0x6d, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x22, 0x4f, 0x0a, 0x19, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x69, 0x6d, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x22, 0x45, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x0b, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22,
+	0x48, 0x0a, 0x12, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6d, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x69, 0x6d, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x22, 0x7f, 0x0a, 0x13, 0x45, 0x6c, 0x69,
+	0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x07,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x07, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x6c,
+	0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x26, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x08, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42,
+	0x08, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x5d, 0x0a, 0x13, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0xc9, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x53,
+	0x69, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x69, 0x6d, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x24,
+	0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x22, 0x22, 0x0a, 0x0a, 0x47, 0x41, 0x48, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x53, 0x69, 0x6d, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x0b, 0x47, 0x41, 0x48, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x0b, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x3d, 0x0a, 0x10,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0xe5, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x3e, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22,
+	0x39, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x37, 0x0a, 0x0b, 0x47, 0x53,
+	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x24, 0x0a, 0x12, 0x41, 0x64,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x44, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x27, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x0a, 0x41,
+	0x64, 0x64, 0x53, 0x69, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1c, 0x0a,
+	0x09, 0x49, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x49, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x36, 0x0a, 0x0d, 0x41,
+	0x64, 0x64, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07,
+	0x53, 0x69, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x41, 0x64, 0x64, 0x53, 0x69, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x53, 0x69, 0x6d, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0x3a, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x69, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x73, 0x0a,
+	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16,
+	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x03, 0x73, 0x69, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6d,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x03, 0x73, 0x69, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x2f, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x03, 0x53,
+	0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x69, 0x6d, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x03, 0x53, 0x69, 0x6d, 0x22, 0x1f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x69,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53,
+	0x69, 0x6d, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x03, 0x53,
+	0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x69, 0x6d, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x03, 0x53, 0x69, 0x6d, 0x22, 0x85, 0x03, 0x0a, 0x09, 0x53, 0x69, 0x6d, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0c, 0x69,
+	0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x53, 0x69, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30,
+	0x0a, 0x14, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22,
+	0xb7, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x53, 0x69, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f,
+	0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x53, 0x69, 0x6d, 0x53, 0x6f,
+	0x72, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x58, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x04, 0x53, 0x69, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x69,
+	0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x53, 0x69, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6c, 0x6c,
+	0x5f, 0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x4f, 0x72, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12,
+	0x1f, 0x0a, 0x03, 0x73, 0x69, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41,
+	0x64, 0x64, 0x53, 0x69, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x03, 0x73, 0x69, 0x6d,
+	0x12, 0x1d, 0x0a, 0x09, 0x63, 0x73, 0x76, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x63, 0x73, 0x76, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x42,
+	0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x92, 0x01, 0x0a, 0x0f, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x69, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x73, 0x69, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0xb2, 0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x28, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x29, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x53, 0x69, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x09, 0x73, 0x69, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x17, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb2, 0x01, 0x0a, 0x14, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xf9,
+	0x01, 0x0a, 0x0e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x70, 0x63, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x70, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2d, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x42, 0x0a, 0x15, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2a, 0x93,
+	0x01, 0x0a, 0x08, 0x53, 0x69, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x53,
+	0x49, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x49, 0x4d, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x49, 0x4d,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12,
+	0x15, 0x0a, 0x11, 0x53, 0x49, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x58, 0x50,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x49, 0x4d, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x04, 0x12, 0x15, 0x0a,
+	0x11, 0x53, 0x49, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x05, 0x2a, 0xe0, 0x03, 0x0a, 0x11, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x4c,
+	0x49, 0x47, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24,
+	0x0a, 0x20, 0x45, 0x4c, 0x49, 0x47, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x4d, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x4c, 0x49, 0x47, 0x49, 0x42, 0x49, 0x4c,
+	0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x4d, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x4c, 0x49, 0x47,
+	0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53,
+	0x49, 0x4d, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x28, 0x0a, 0x24,
+	0x45, 0x4c, 0x49, 0x47, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x4c, 0x49, 0x47, 0x49, 0x42,
+	0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x10, 0x05, 0x12, 0x28, 0x0a, 0x24, 0x45,
+	0x4c, 0x49, 0x47, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x53, 0x49, 0x4d, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x29, 0x0a, 0x25, 0x45, 0x4c, 0x49, 0x47, 0x49, 0x42, 0x49,
+	0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43,
+	0x4b, 0x45, 0x44, 0x5f, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x07,
+	0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x4c, 0x49, 0x47, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4f, 0x4c, 0x44, 0x4f, 0x57, 0x4e, 0x10,
+	0x08, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x4c, 0x49, 0x47, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f,
+	0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x12, 0x2c, 0x0a, 0x28, 0x45, 0x4c,
+	0x49, 0x47, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x50,
+	0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4c, 0x49, 0x47,
+	0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4c,
+	0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x0b, 0x2a, 0x6b, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x45, 0x58, 0x54, 0x45,
+	0x4e, 0x44, 0x10, 0x02, 0x2a, 0x88, 0x01, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x56, 0x45, 0x52,
+	0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x53, 0x4d, 0x53, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x45, 0x52, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x4c,
+	0x4c, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x03, 0x2a,
+	0x5b, 0x0a, 0x0a, 0x53, 0x69, 0x6d, 0x53, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x13, 0x0a,
+	0x0f, 0x53, 0x49, 0x4d, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x49, 0x44,
+	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x49, 0x4d, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4b,
+	0x45, 0x59, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x53,
+	0x49, 0x4d, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x54, 0x49, 0x4c, 0x10, 0x02, 0x2a, 0xb6, 0x01, 0x0a,
+	0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f,
+	0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
+	0x45, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f,
+	0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42,
+	0x41, 0x43, 0x4b, 0x10, 0x04, 0x2a, 0xa9, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x56,
+	0x45, 0x4e, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x49,
+	0x4e, 0x56, 0x45, 0x4e, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f,
+	0x53, 0x49, 0x4d, 0x53, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x56, 0x45, 0x4e, 0x54,
+	0x4f, 0x52, 0x59, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49,
+	0x44, 0x45, 0x52, 0x53, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x56, 0x45, 0x4e, 0x54,
+	0x4f, 0x52, 0x59, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49,
+	0x43, 0x45, 0x53, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x45, 0x4e, 0x54, 0x4f,
+	0x52, 0x59, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x53, 0x41, 0x47, 0x45, 0x10,
+	0x04, 0x2a, 0x5d, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x10, 0x02,
+	0x2a, 0x7a, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x1c, 0x0a, 0x18, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a,
+	0x10, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x49,
+	0x4d, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x4e, 0x54,
+	0x49, 0x54, 0x59, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x02, 0x12, 0x1d, 0x0a,
+	0x19, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x53,
+	0x45, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x03, 0x32, 0xab, 0x07, 0x0a,
+	0x03, 0x53, 0x69, 0x6d, 0x12, 0x2b, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6d, 0x12, 0x0e,
+	0x2e, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
+	0x2e, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x39, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x6d, 0x73, 0x12,
+	0x12, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x34, 0x0a, 0x09,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x12, 0x11, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x33, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x69, 0x6d,
+	0x12, 0x12, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x69, 0x6d, 0x12, 0x11, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x12, 0x13, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x0d, 0x53, 0x65, 0x74,
+	0x53, 0x69, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x53, 0x53, 0x42,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x53, 0x53, 0x42, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x55, 0x6e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x69, 0x6d, 0x12, 0x15, 0x2e, 0x53, 0x69, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x53,
+	0x69, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x12, 0x15, 0x2e, 0x53, 0x69, 0x6d, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x53, 0x69, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x52, 0x65, 0x74, 0x69,
+	0x72, 0x65, 0x53, 0x69, 0x6d, 0x12, 0x15, 0x2e, 0x53, 0x69, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x53,
+	0x69, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x20, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x08, 0x2e, 0x53,
+	0x69, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x69, 0x6d, 0x73, 0x12, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x06, 0x47,
+	0x65, 0x74, 0x53, 0x69, 0x6d, 0x12, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53,
+	0x69, 0x6d, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x69, 0x6d, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0b, 0x2e,
+	0x47, 0x41, 0x48, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x41, 0x48,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x46, 0x72, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x13, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x13, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa8, 0x02, 0x0a, 0x07, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x15, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x47, 0x53, 0x4c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xfd, 0x03, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x64, 0x12, 0x31,
+	0x0a, 0x10, 0x55, 0x73, 0x65, 0x53, 0x69, 0x6d, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x0c, 0x2e, 0x55, 0x53, 0x46, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x55, 0x53, 0x46, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x0a, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x53, 0x69, 0x6d, 0x12,
+	0x12, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x53, 0x69, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x0d, 0x2e, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x12, 0x0d, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x10, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x48, 0x0a, 0x12, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x12, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x1a, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x55,
+	0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6c, 0x69,
+	0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x13, 0x2e, 0x45, 0x6c, 0x69, 0x67, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xd6, 0x04, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x2a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x37,
+	0x0a, 0x0b, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x13, 0x2e,
+	0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a,
+	0x10, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x15, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x15, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x55,
+	0x0a, 0x09, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x48, 0x0a, 0x0f, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x17,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x32, 0x49, 0x0a, 0x05, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x40,
+	0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12,
+	0x15, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x4e, 0x69, 0x63, 0x6b, 0x2f, 0x53, 0x69, 0x6d, 0x48, 0x65, 0x6c, 0x70,
+	0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sim_proto_rawDescOnce sync.Once
+	file_sim_proto_rawDescData = file_sim_proto_rawDesc
+)
+
+func file_sim_proto_rawDescGZIP() []byte {
+	file_sim_proto_rawDescOnce.Do(func() {
+		file_sim_proto_rawDescData = protoimpl.X.CompressGZIP(file_sim_proto_rawDescData)
+	})
+	return file_sim_proto_rawDescData
+}
+
+var file_sim_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_sim_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
+var file_sim_proto_goTypes = []interface{}{
+	(SimState)(0),                     // 0: SimState
+	(EligibilityReason)(0),            // 1: EligibilityReason
+	(ActivationKind)(0),               // 2: ActivationKind
+	(VerificationType)(0),             // 3: VerificationType
+	(SimSortKey)(0),                   // 4: SimSortKey
+	(ImportRowStatus)(0),              // 5: ImportRowStatus
+	(InventoryEntity)(0),              // 6: InventoryEntity
+	(ExportFormat)(0),                 // 7: ExportFormat
+	(AuditEntity)(0),                  // 8: AuditEntity
+	(*Empty)(nil),                     // 9: Empty
+	(*SSBRequest)(nil),                // 10: SSBRequest
+	(*SSBResponse)(nil),               // 11: SSBResponse
+	(*SimTransitionRequest)(nil),      // 12: SimTransitionRequest
+	(*SimTransitionResponse)(nil),     // 13: SimTransitionResponse
+	(*UsedService)(nil),               // 14: UsedService
+	(*GetUsedServResponse)(nil),       // 15: GetUsedServResponse
+	(*GetUsedServRequest)(nil),        // 16: GetUsedServRequest
+	(*GetFreeServResponse)(nil),       // 17: GetFreeServResponse
+	(*GetFreeServRequest)(nil),        // 18: GetFreeServRequest
+	(*ProviderData)(nil),              // 19: ProviderData
+	(*ProviderList)(nil),              // 20: ProviderList
+	(*ProviderMetadata)(nil),          // 21: ProviderMetadata
+	(*AddProviderRequest)(nil),        // 22: AddProviderRequest
+	(*UpdateProviderRequest)(nil),     // 23: UpdateProviderRequest
+	(*RenameProviderRequest)(nil),     // 24: RenameProviderRequest
+	(*ProviderResponse)(nil),          // 25: ProviderResponse
+	(*DeleteProviderRequest)(nil),     // 26: DeleteProviderRequest
+	(*DeleteProviderResponse)(nil),    // 27: DeleteProviderResponse
+	(*MergeProvidersRequest)(nil),     // 28: MergeProvidersRequest
+	(*MergeProvidersResponse)(nil),    // 29: MergeProvidersResponse
+	(*GetProviderAliasesRequest)(nil), // 30: GetProviderAliasesRequest
+	(*ProviderAliasRequest)(nil),      // 31: ProviderAliasRequest
+	(*ProviderAliasesResponse)(nil),   // 32: ProviderAliasesResponse
+	(*SimList)(nil),                   // 33: SimList
+	(*SimData)(nil),                   // 34: SimData
+	(*USFSRequest)(nil),               // 35: USFSRequest
+	(*USFSResponse)(nil),              // 36: USFSResponse
+	(*AcquireSimRequest)(nil),         // 37: AcquireSimRequest
+	(*AcquireSimResponse)(nil),        // 38: AcquireSimResponse
+	(*LeaseRequest)(nil),              // 39: LeaseRequest
+	(*ConfirmLeaseResponse)(nil),      // 40: ConfirmLeaseResponse
+	(*ReleaseLeaseResponse)(nil),      // 41: ReleaseLeaseResponse
+	(*BlockUsedServiceRequest)(nil),   // 42: BlockUsedServiceRequest
+	(*UnblockUsedServiceRequest)(nil), // 43: UnblockUsedServiceRequest
+	(*ReleaseUsedServiceRequest)(nil), // 44: ReleaseUsedServiceRequest
+	(*UsedServiceResponse)(nil),       // 45: UsedServiceResponse
+	(*EligibilityRequest)(nil),        // 46: EligibilityRequest
+	(*EligibilityResponse)(nil),       // 47: EligibilityResponse
+	(*ActivateSimRequest)(nil),        // 48: ActivateSimRequest
+	(*ActivateSimResponse)(nil),       // 49: ActivateSimResponse
+	(*ActivationData)(nil),            // 50: ActivationData
+	(*GAHRequest)(nil),                // 51: GAHRequest
+	(*GAHResponse)(nil),               // 52: GAHResponse
+	(*ServiceData)(nil),               // 53: ServiceData
+	(*ServiceMetadata)(nil),           // 54: ServiceMetadata
+	(*UpdateServiceRequest)(nil),      // 55: UpdateServiceRequest
+	(*ServiceResponse)(nil),           // 56: ServiceResponse
+	(*GSLResponse)(nil),               // 57: GSLResponse
+	(*AddServiceRequest)(nil),         // 58: AddServiceRequest
+	(*AddServiceResponse)(nil),        // 59: AddServiceResponse
+	(*DeleteServiceRequest)(nil),      // 60: DeleteServiceRequest
+	(*DeleteServiceResponse)(nil),     // 61: DeleteServiceResponse
+	(*RestoreServiceRequest)(nil),     // 62: RestoreServiceRequest
+	(*AddSimData)(nil),                // 63: AddSimData
+	(*AddSimRequest)(nil),             // 64: AddSimRequest
+	(*AddSimResponse)(nil),            // 65: AddSimResponse
+	(*DeleteSimRequest)(nil),          // 66: DeleteSimRequest
+	(*DeleteSimResponse)(nil),         // 67: DeleteSimResponse
+	(*RestoreSimRequest)(nil),         // 68: RestoreSimRequest
+	(*UpdateSimData)(nil),             // 69: UpdateSimData
+	(*UpdateSimRequest)(nil),          // 70: UpdateSimRequest
+	(*UpdateSimResponse)(nil),         // 71: UpdateSimResponse
+	(*GetSimRequest)(nil),             // 72: GetSimRequest
+	(*GetSimByNumberRequest)(nil),     // 73: GetSimByNumberRequest
+	(*GetSimResponse)(nil),            // 74: GetSimResponse
+	(*SimFilter)(nil),                 // 75: SimFilter
+	(*ListSimsRequest)(nil),           // 76: ListSimsRequest
+	(*ListSimsResponse)(nil),          // 77: ListSimsResponse
+	(*ImportSimsRequest)(nil),         // 78: ImportSimsRequest
+	(*ImportRowResult)(nil),           // 79: ImportRowResult
+	(*ImportSimsResponse)(nil),        // 80: ImportSimsResponse
+	(*ExportInventoryRequest)(nil),    // 81: ExportInventoryRequest
+	(*ExportInventoryResponse)(nil),   // 82: ExportInventoryResponse
+	(*QueryAuditLogRequest)(nil),      // 83: QueryAuditLogRequest
+	(*AuditEntryData)(nil),            // 84: AuditEntryData
+	(*QueryAuditLogResponse)(nil),     // 85: QueryAuditLogResponse
+	(*fieldmaskpb.FieldMask)(nil),     // 86: google.protobuf.FieldMask
+}
+var file_sim_proto_depIdxs = []int32{
+	34, // 0: SimTransitionResponse.Sim:type_name -> SimData
+	14, // 1: GetUsedServResponse.UsedServices:type_name -> UsedService
+	53, // 2: GetFreeServResponse.FreeServices:type_name -> ServiceData
+	19, // 3: ProviderList.Providers:type_name -> ProviderData
+	21, // 4: AddProviderRequest.metadata:type_name -> ProviderMetadata
+	21, // 5: UpdateProviderRequest.metadata:type_name -> ProviderMetadata
+	86, // 6: UpdateProviderRequest.update_mask:type_name -> google.protobuf.FieldMask
+	19, // 7: ProviderResponse.Provider:type_name -> ProviderData
+	19, // 8: MergeProvidersResponse.Provider:type_name -> ProviderData
+	34, // 9: SimList.SimList:type_name -> SimData
+	19, // 10: SimData.Provider:type_name -> ProviderData
+	0,  // 11: SimData.State:type_name -> SimState
+	34, // 12: AcquireSimResponse.Sim:type_name -> SimData
+	14, // 13: UsedServiceResponse.UsedService:type_name -> UsedService
+	1,  // 14: EligibilityResponse.Reasons:type_name -> EligibilityReason
+	2,  // 15: ActivationData.Kind:type_name -> ActivationKind
+	50, // 16: GAHResponse.Activations:type_name -> ActivationData
+	3,  // 17: ServiceData.verificationType:type_name -> VerificationType
+	3,  // 18: ServiceMetadata.verification_type:type_name -> VerificationType
+	54, // 19: UpdateServiceRequest.metadata:type_name -> ServiceMetadata
+	86, // 20: UpdateServiceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	53, // 21: ServiceResponse.Service:type_name -> ServiceData
+	53, // 22: GSLResponse.Services:type_name -> ServiceData
+	54, // 23: AddServiceRequest.metadata:type_name -> ServiceMetadata
+	63, // 24: AddSimRequest.SimData:type_name -> AddSimData
+	69, // 25: UpdateSimRequest.sim:type_name -> UpdateSimData
+	86, // 26: UpdateSimRequest.update_mask:type_name -> google.protobuf.FieldMask
+	34, // 27: UpdateSimResponse.Sim:type_name -> SimData
+	34, // 28: GetSimResponse.Sim:type_name -> SimData
+	0,  // 29: SimFilter.states:type_name -> SimState
+	75, // 30: ListSimsRequest.filter:type_name -> SimFilter
+	4,  // 31: ListSimsRequest.sort_by:type_name -> SimSortKey
+	34, // 32: ListSimsResponse.Sims:type_name -> SimData
+	63, // 33: ImportSimsRequest.sim:type_name -> AddSimData
+	5,  // 34: ImportRowResult.status:type_name -> ImportRowStatus
+	79, // 35: ImportSimsResponse.results:type_name -> ImportRowResult
+	6,  // 36: ExportInventoryRequest.entity:type_name -> InventoryEntity
+	7,  // 37: ExportInventoryRequest.format:type_name -> ExportFormat
+	75, // 38: ExportInventoryRequest.sim_filter:type_name -> SimFilter
+	8,  // 39: QueryAuditLogRequest.entity_type:type_name -> AuditEntity
+	8,  // 40: AuditEntryData.entity_type:type_name -> AuditEntity
+	84, // 41: QueryAuditLogResponse.entries:type_name -> AuditEntryData
+	64, // 42: Sim.AddSim:input_type -> AddSimRequest
+	78, // 43: Sim.ImportSims:input_type -> ImportSimsRequest
+	66, // 44: Sim.DeleteSim:input_type -> DeleteSimRequest
+	68, // 45: Sim.RestoreSim:input_type -> RestoreSimRequest
+	70, // 46: Sim.UpdateSim:input_type -> UpdateSimRequest
+	48, // 47: Sim.ActivateSim:input_type -> ActivateSimRequest
+	10, // 48: Sim.SetSimBlocked:input_type -> SSBRequest
+	12, // 49: Sim.UnblockSim:input_type -> SimTransitionRequest
+	12, // 50: Sim.DeactivateSim:input_type -> SimTransitionRequest
+	12, // 51: Sim.RetireSim:input_type -> SimTransitionRequest
+	9,  // 52: Sim.GetSimList:input_type -> Empty
+	76, // 53: Sim.ListSims:input_type -> ListSimsRequest
+	72, // 54: Sim.GetSim:input_type -> GetSimRequest
+	73, // 55: Sim.GetSimByNumber:input_type -> GetSimByNumberRequest
+	51, // 56: Sim.GetActivationHistory:input_type -> GAHRequest
+	18, // 57: Sim.GetFreeServices:input_type -> GetFreeServRequest
+	16, // 58: Sim.GetUsedServices:input_type -> GetUsedServRequest
+	58, // 59: Service.AddService:input_type -> AddServiceRequest
+	60, // 60: Service.DeleteService:input_type -> DeleteServiceRequest
+	62, // 61: Service.RestoreService:input_type -> RestoreServiceRequest
+	9,  // 62: Service.GetServiceList:input_type -> Empty
+	55, // 63: Service.UpdateService:input_type -> UpdateServiceRequest
+	35, // 64: Used.UseSimForService:input_type -> USFSRequest
+	37, // 65: Used.AcquireSim:input_type -> AcquireSimRequest
+	39, // 66: Used.ConfirmLease:input_type -> LeaseRequest
+	39, // 67: Used.ReleaseLease:input_type -> LeaseRequest
+	42, // 68: Used.BlockUsedService:input_type -> BlockUsedServiceRequest
+	43, // 69: Used.UnblockUsedService:input_type -> UnblockUsedServiceRequest
+	44, // 70: Used.ReleaseUsedService:input_type -> ReleaseUsedServiceRequest
+	46, // 71: Used.CheckEligibility:input_type -> EligibilityRequest
+	9,  // 72: Provider.GetProviderList:input_type -> Empty
+	22, // 73: Provider.AddProvider:input_type -> AddProviderRequest
+	24, // 74: Provider.RenameProvider:input_type -> RenameProviderRequest
+	23, // 75: Provider.UpdateProvider:input_type -> UpdateProviderRequest
+	26, // 76: Provider.DeleteProvider:input_type -> DeleteProviderRequest
+	28, // 77: Provider.MergeProviders:input_type -> MergeProvidersRequest
+	30, // 78: Provider.GetProviderAliases:input_type -> GetProviderAliasesRequest
+	31, // 79: Provider.AddProviderAlias:input_type -> ProviderAliasRequest
+	31, // 80: Provider.RemoveProviderAlias:input_type -> ProviderAliasRequest
+	81, // 81: Inventory.ExportInventory:input_type -> ExportInventoryRequest
+	83, // 82: Audit.QueryAuditLog:input_type -> QueryAuditLogRequest
+	65, // 83: Sim.AddSim:output_type -> AddSimResponse
+	80, // 84: Sim.ImportSims:output_type -> ImportSimsResponse
+	67, // 85: Sim.DeleteSim:output_type -> DeleteSimResponse
+	74, // 86: Sim.RestoreSim:output_type -> GetSimResponse
+	71, // 87: Sim.UpdateSim:output_type -> UpdateSimResponse
+	49, // 88: Sim.ActivateSim:output_type -> ActivateSimResponse
+	11, // 89: Sim.SetSimBlocked:output_type -> SSBResponse
+	13, // 90: Sim.UnblockSim:output_type -> SimTransitionResponse
+	13, // 91: Sim.DeactivateSim:output_type -> SimTransitionResponse
+	13, // 92: Sim.RetireSim:output_type -> SimTransitionResponse
+	33, // 93: Sim.GetSimList:output_type -> SimList
+	77, // 94: Sim.ListSims:output_type -> ListSimsResponse
+	74, // 95: Sim.GetSim:output_type -> GetSimResponse
+	74, // 96: Sim.GetSimByNumber:output_type -> GetSimResponse
+	52, // 97: Sim.GetActivationHistory:output_type -> GAHResponse
+	17, // 98: Sim.GetFreeServices:output_type -> GetFreeServResponse
+	15, // 99: Sim.GetUsedServices:output_type -> GetUsedServResponse
+	59, // 100: Service.AddService:output_type -> AddServiceResponse
+	61, // 101: Service.DeleteService:output_type -> DeleteServiceResponse
+	56, // 102: Service.RestoreService:output_type -> ServiceResponse
+	57, // 103: Service.GetServiceList:output_type -> GSLResponse
+	56, // 104: Service.UpdateService:output_type -> ServiceResponse
+	36, // 105: Used.UseSimForService:output_type -> USFSResponse
+	38, // 106: Used.AcquireSim:output_type -> AcquireSimResponse
+	40, // 107: Used.ConfirmLease:output_type -> ConfirmLeaseResponse
+	41, // 108: Used.ReleaseLease:output_type -> ReleaseLeaseResponse
+	45, // 109: Used.BlockUsedService:output_type -> UsedServiceResponse
+	45, // 110: Used.UnblockUsedService:output_type -> UsedServiceResponse
+	45, // 111: Used.ReleaseUsedService:output_type -> UsedServiceResponse
+	47, // 112: Used.CheckEligibility:output_type -> EligibilityResponse
+	20, // 113: Provider.GetProviderList:output_type -> ProviderList
+	25, // 114: Provider.AddProvider:output_type -> ProviderResponse
+	25, // 115: Provider.RenameProvider:output_type -> ProviderResponse
+	25, // 116: Provider.UpdateProvider:output_type -> ProviderResponse
+	27, // 117: Provider.DeleteProvider:output_type -> DeleteProviderResponse
+	29, // 118: Provider.MergeProviders:output_type -> MergeProvidersResponse
+	32, // 119: Provider.GetProviderAliases:output_type -> ProviderAliasesResponse
+	32, // 120: Provider.AddProviderAlias:output_type -> ProviderAliasesResponse
+	32, // 121: Provider.RemoveProviderAlias:output_type -> ProviderAliasesResponse
+	82, // 122: Inventory.ExportInventory:output_type -> ExportInventoryResponse
+	85, // 123: Audit.QueryAuditLog:output_type -> QueryAuditLogResponse
+	83, // [83:124] is the sub-list for method output_type
+	42, // [42:83] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_sim_proto_init() }
+func file_sim_proto_init() {
+	if File_sim_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sim_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSBRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSBResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimTransitionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimTransitionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsedService); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsedServResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsedServRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFreeServResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFreeServRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProviderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProviderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameProviderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProviderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProviderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeProvidersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeProvidersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProviderAliasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderAliasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderAliasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*USFSRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*USFSResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireSimRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireSimResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1649,8 +6561,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SSBRequest); i {
+		file_sim_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmLeaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1661,8 +6573,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SSBResponse); i {
+		file_sim_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLeaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1673,8 +6585,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsedService); i {
+		file_sim_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUsedServiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1685,8 +6597,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUsedServResponse); i {
+		file_sim_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockUsedServiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1697,8 +6609,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUsedServRequest); i {
+		file_sim_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseUsedServiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1709,8 +6621,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFreeServResponse); i {
+		file_sim_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsedServiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1721,8 +6633,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFreeServRequest); i {
+		file_sim_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EligibilityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1733,8 +6645,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderData); i {
+		file_sim_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EligibilityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1745,8 +6657,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderList); i {
+		file_sim_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateSimRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1757,8 +6669,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimList); i {
+		file_sim_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateSimResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1769,8 +6681,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimData); i {
+		file_sim_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivationData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1781,8 +6693,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*USFSRequest); i {
+		file_sim_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GAHRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1793,8 +6705,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*USFSResponse); i {
+		file_sim_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GAHResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1805,8 +6717,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateSimRequest); i {
+		file_sim_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1817,8 +6729,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateSimResponse); i {
+		file_sim_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1829,8 +6741,8 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceData); i {
+		file_sim_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateServiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1841,7 +6753,19 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_sim_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GSLResponse); i {
 			case 0:
 				return &v.state
@@ -1853,7 +6777,7 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_sim_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddServiceRequest); i {
 			case 0:
 				return &v.state
@@ -1865,7 +6789,7 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_sim_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddServiceResponse); i {
 			case 0:
 				return &v.state
@@ -1877,7 +6801,7 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_sim_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteServiceRequest); i {
 			case 0:
 				return &v.state
@@ -1889,7 +6813,7 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_sim_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteServiceResponse); i {
 			case 0:
 				return &v.state
@@ -1901,7 +6825,19 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_sim_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreServiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddSimData); i {
 			case 0:
 				return &v.state
@@ -1913,7 +6849,7 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_sim_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddSimRequest); i {
 			case 0:
 				return &v.state
@@ -1925,7 +6861,7 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_sim_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddSimResponse); i {
 			case 0:
 				return &v.state
@@ -1937,7 +6873,7 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_sim_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteSimRequest); i {
 			case 0:
 				return &v.state
@@ -1949,7 +6885,7 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
-		file_sim_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_sim_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteSimResponse); i {
 			case 0:
 				return &v.state
@@ -1961,19 +6897,246 @@ func file_sim_proto_init() {
 				return nil
 			}
 		}
+		file_sim_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSimRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSimData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSimRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSimResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSimRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSimByNumberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSimResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSimsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSimsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSimsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRowResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSimsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportInventoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportInventoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAuditLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntryData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAuditLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_sim_proto_msgTypes[39].OneofWrappers = []interface{}{
+		(*ActivateSimRequest_ActivateUntil)(nil),
+		(*ActivateSimRequest_Duration)(nil),
+	}
+	file_sim_proto_msgTypes[45].OneofWrappers = []interface{}{}
+	file_sim_proto_msgTypes[66].OneofWrappers = []interface{}{}
+	file_sim_proto_msgTypes[69].OneofWrappers = []interface{}{
+		(*ImportSimsRequest_Sim)(nil),
+		(*ImportSimsRequest_CsvChunk)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sim_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   27,
+			NumEnums:      9,
+			NumMessages:   77,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   6,
 		},
 		GoTypes:           file_sim_proto_goTypes,
 		DependencyIndexes: file_sim_proto_depIdxs,
+		EnumInfos:         file_sim_proto_enumTypes,
 		MessageInfos:      file_sim_proto_msgTypes,
 	}.Build()
 	File_sim_proto = out.File
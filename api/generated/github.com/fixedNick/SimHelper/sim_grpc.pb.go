@@ -23,10 +23,21 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SimClient interface {
 	AddSim(ctx context.Context, in *AddSimRequest, opts ...grpc.CallOption) (*AddSimResponse, error)
+	ImportSims(ctx context.Context, opts ...grpc.CallOption) (Sim_ImportSimsClient, error)
+	// DeleteSim marks a sim as deleted, it can be restored until it is purged after the retention period
 	DeleteSim(ctx context.Context, in *DeleteSimRequest, opts ...grpc.CallOption) (*DeleteSimResponse, error)
+	RestoreSim(ctx context.Context, in *RestoreSimRequest, opts ...grpc.CallOption) (*GetSimResponse, error)
+	UpdateSim(ctx context.Context, in *UpdateSimRequest, opts ...grpc.CallOption) (*UpdateSimResponse, error)
 	ActivateSim(ctx context.Context, in *ActivateSimRequest, opts ...grpc.CallOption) (*ActivateSimResponse, error)
 	SetSimBlocked(ctx context.Context, in *SSBRequest, opts ...grpc.CallOption) (*SSBResponse, error)
+	UnblockSim(ctx context.Context, in *SimTransitionRequest, opts ...grpc.CallOption) (*SimTransitionResponse, error)
+	DeactivateSim(ctx context.Context, in *SimTransitionRequest, opts ...grpc.CallOption) (*SimTransitionResponse, error)
+	RetireSim(ctx context.Context, in *SimTransitionRequest, opts ...grpc.CallOption) (*SimTransitionResponse, error)
 	GetSimList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SimList, error)
+	ListSims(ctx context.Context, in *ListSimsRequest, opts ...grpc.CallOption) (*ListSimsResponse, error)
+	GetSim(ctx context.Context, in *GetSimRequest, opts ...grpc.CallOption) (*GetSimResponse, error)
+	GetSimByNumber(ctx context.Context, in *GetSimByNumberRequest, opts ...grpc.CallOption) (*GetSimResponse, error)
+	GetActivationHistory(ctx context.Context, in *GAHRequest, opts ...grpc.CallOption) (*GAHResponse, error)
 	GetFreeServices(ctx context.Context, in *GetFreeServRequest, opts ...grpc.CallOption) (*GetFreeServResponse, error)
 	GetUsedServices(ctx context.Context, in *GetUsedServRequest, opts ...grpc.CallOption) (*GetUsedServResponse, error)
 }
@@ -48,6 +59,40 @@ func (c *simClient) AddSim(ctx context.Context, in *AddSimRequest, opts ...grpc.
 	return out, nil
 }
 
+func (c *simClient) ImportSims(ctx context.Context, opts ...grpc.CallOption) (Sim_ImportSimsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Sim_ServiceDesc.Streams[0], "/Sim/ImportSims", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &simImportSimsClient{stream}
+	return x, nil
+}
+
+type Sim_ImportSimsClient interface {
+	Send(*ImportSimsRequest) error
+	CloseAndRecv() (*ImportSimsResponse, error)
+	grpc.ClientStream
+}
+
+type simImportSimsClient struct {
+	grpc.ClientStream
+}
+
+func (x *simImportSimsClient) Send(m *ImportSimsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *simImportSimsClient) CloseAndRecv() (*ImportSimsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportSimsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *simClient) DeleteSim(ctx context.Context, in *DeleteSimRequest, opts ...grpc.CallOption) (*DeleteSimResponse, error) {
 	out := new(DeleteSimResponse)
 	err := c.cc.Invoke(ctx, "/Sim/DeleteSim", in, out, opts...)
@@ -57,6 +102,24 @@ func (c *simClient) DeleteSim(ctx context.Context, in *DeleteSimRequest, opts ..
 	return out, nil
 }
 
+func (c *simClient) RestoreSim(ctx context.Context, in *RestoreSimRequest, opts ...grpc.CallOption) (*GetSimResponse, error) {
+	out := new(GetSimResponse)
+	err := c.cc.Invoke(ctx, "/Sim/RestoreSim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simClient) UpdateSim(ctx context.Context, in *UpdateSimRequest, opts ...grpc.CallOption) (*UpdateSimResponse, error) {
+	out := new(UpdateSimResponse)
+	err := c.cc.Invoke(ctx, "/Sim/UpdateSim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *simClient) ActivateSim(ctx context.Context, in *ActivateSimRequest, opts ...grpc.CallOption) (*ActivateSimResponse, error) {
 	out := new(ActivateSimResponse)
 	err := c.cc.Invoke(ctx, "/Sim/ActivateSim", in, out, opts...)
@@ -75,6 +138,33 @@ func (c *simClient) SetSimBlocked(ctx context.Context, in *SSBRequest, opts ...g
 	return out, nil
 }
 
+func (c *simClient) UnblockSim(ctx context.Context, in *SimTransitionRequest, opts ...grpc.CallOption) (*SimTransitionResponse, error) {
+	out := new(SimTransitionResponse)
+	err := c.cc.Invoke(ctx, "/Sim/UnblockSim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simClient) DeactivateSim(ctx context.Context, in *SimTransitionRequest, opts ...grpc.CallOption) (*SimTransitionResponse, error) {
+	out := new(SimTransitionResponse)
+	err := c.cc.Invoke(ctx, "/Sim/DeactivateSim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simClient) RetireSim(ctx context.Context, in *SimTransitionRequest, opts ...grpc.CallOption) (*SimTransitionResponse, error) {
+	out := new(SimTransitionResponse)
+	err := c.cc.Invoke(ctx, "/Sim/RetireSim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *simClient) GetSimList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SimList, error) {
 	out := new(SimList)
 	err := c.cc.Invoke(ctx, "/Sim/GetSimList", in, out, opts...)
@@ -84,6 +174,42 @@ func (c *simClient) GetSimList(ctx context.Context, in *Empty, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *simClient) ListSims(ctx context.Context, in *ListSimsRequest, opts ...grpc.CallOption) (*ListSimsResponse, error) {
+	out := new(ListSimsResponse)
+	err := c.cc.Invoke(ctx, "/Sim/ListSims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simClient) GetSim(ctx context.Context, in *GetSimRequest, opts ...grpc.CallOption) (*GetSimResponse, error) {
+	out := new(GetSimResponse)
+	err := c.cc.Invoke(ctx, "/Sim/GetSim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simClient) GetSimByNumber(ctx context.Context, in *GetSimByNumberRequest, opts ...grpc.CallOption) (*GetSimResponse, error) {
+	out := new(GetSimResponse)
+	err := c.cc.Invoke(ctx, "/Sim/GetSimByNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simClient) GetActivationHistory(ctx context.Context, in *GAHRequest, opts ...grpc.CallOption) (*GAHResponse, error) {
+	out := new(GAHResponse)
+	err := c.cc.Invoke(ctx, "/Sim/GetActivationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *simClient) GetFreeServices(ctx context.Context, in *GetFreeServRequest, opts ...grpc.CallOption) (*GetFreeServResponse, error) {
 	out := new(GetFreeServResponse)
 	err := c.cc.Invoke(ctx, "/Sim/GetFreeServices", in, out, opts...)
@@ -107,10 +233,21 @@ func (c *simClient) GetUsedServices(ctx context.Context, in *GetUsedServRequest,
 // for forward compatibility
 type SimServer interface {
 	AddSim(context.Context, *AddSimRequest) (*AddSimResponse, error)
+	ImportSims(Sim_ImportSimsServer) error
+	// DeleteSim marks a sim as deleted, it can be restored until it is purged after the retention period
 	DeleteSim(context.Context, *DeleteSimRequest) (*DeleteSimResponse, error)
+	RestoreSim(context.Context, *RestoreSimRequest) (*GetSimResponse, error)
+	UpdateSim(context.Context, *UpdateSimRequest) (*UpdateSimResponse, error)
 	ActivateSim(context.Context, *ActivateSimRequest) (*ActivateSimResponse, error)
 	SetSimBlocked(context.Context, *SSBRequest) (*SSBResponse, error)
+	UnblockSim(context.Context, *SimTransitionRequest) (*SimTransitionResponse, error)
+	DeactivateSim(context.Context, *SimTransitionRequest) (*SimTransitionResponse, error)
+	RetireSim(context.Context, *SimTransitionRequest) (*SimTransitionResponse, error)
 	GetSimList(context.Context, *Empty) (*SimList, error)
+	ListSims(context.Context, *ListSimsRequest) (*ListSimsResponse, error)
+	GetSim(context.Context, *GetSimRequest) (*GetSimResponse, error)
+	GetSimByNumber(context.Context, *GetSimByNumberRequest) (*GetSimResponse, error)
+	GetActivationHistory(context.Context, *GAHRequest) (*GAHResponse, error)
 	GetFreeServices(context.Context, *GetFreeServRequest) (*GetFreeServResponse, error)
 	GetUsedServices(context.Context, *GetUsedServRequest) (*GetUsedServResponse, error)
 	mustEmbedUnimplementedSimServer()
@@ -123,18 +260,48 @@ type UnimplementedSimServer struct {
 func (UnimplementedSimServer) AddSim(context.Context, *AddSimRequest) (*AddSimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddSim not implemented")
 }
+func (UnimplementedSimServer) ImportSims(Sim_ImportSimsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSims not implemented")
+}
 func (UnimplementedSimServer) DeleteSim(context.Context, *DeleteSimRequest) (*DeleteSimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSim not implemented")
 }
+func (UnimplementedSimServer) RestoreSim(context.Context, *RestoreSimRequest) (*GetSimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSim not implemented")
+}
+func (UnimplementedSimServer) UpdateSim(context.Context, *UpdateSimRequest) (*UpdateSimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSim not implemented")
+}
 func (UnimplementedSimServer) ActivateSim(context.Context, *ActivateSimRequest) (*ActivateSimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActivateSim not implemented")
 }
 func (UnimplementedSimServer) SetSimBlocked(context.Context, *SSBRequest) (*SSBResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSimBlocked not implemented")
 }
+func (UnimplementedSimServer) UnblockSim(context.Context, *SimTransitionRequest) (*SimTransitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockSim not implemented")
+}
+func (UnimplementedSimServer) DeactivateSim(context.Context, *SimTransitionRequest) (*SimTransitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateSim not implemented")
+}
+func (UnimplementedSimServer) RetireSim(context.Context, *SimTransitionRequest) (*SimTransitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireSim not implemented")
+}
 func (UnimplementedSimServer) GetSimList(context.Context, *Empty) (*SimList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSimList not implemented")
 }
+func (UnimplementedSimServer) ListSims(context.Context, *ListSimsRequest) (*ListSimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSims not implemented")
+}
+func (UnimplementedSimServer) GetSim(context.Context, *GetSimRequest) (*GetSimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSim not implemented")
+}
+func (UnimplementedSimServer) GetSimByNumber(context.Context, *GetSimByNumberRequest) (*GetSimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimByNumber not implemented")
+}
+func (UnimplementedSimServer) GetActivationHistory(context.Context, *GAHRequest) (*GAHResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivationHistory not implemented")
+}
 func (UnimplementedSimServer) GetFreeServices(context.Context, *GetFreeServRequest) (*GetFreeServResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFreeServices not implemented")
 }
@@ -172,6 +339,32 @@ func _Sim_AddSim_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sim_ImportSims_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SimServer).ImportSims(&simImportSimsServer{stream})
+}
+
+type Sim_ImportSimsServer interface {
+	SendAndClose(*ImportSimsResponse) error
+	Recv() (*ImportSimsRequest, error)
+	grpc.ServerStream
+}
+
+type simImportSimsServer struct {
+	grpc.ServerStream
+}
+
+func (x *simImportSimsServer) SendAndClose(m *ImportSimsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *simImportSimsServer) Recv() (*ImportSimsRequest, error) {
+	m := new(ImportSimsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Sim_DeleteSim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteSimRequest)
 	if err := dec(in); err != nil {
@@ -190,6 +383,42 @@ func _Sim_DeleteSim_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sim_RestoreSim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimServer).RestoreSim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Sim/RestoreSim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimServer).RestoreSim(ctx, req.(*RestoreSimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sim_UpdateSim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimServer).UpdateSim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Sim/UpdateSim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimServer).UpdateSim(ctx, req.(*UpdateSimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Sim_ActivateSim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ActivateSimRequest)
 	if err := dec(in); err != nil {
@@ -226,6 +455,60 @@ func _Sim_SetSimBlocked_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sim_UnblockSim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimServer).UnblockSim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Sim/UnblockSim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimServer).UnblockSim(ctx, req.(*SimTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sim_DeactivateSim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimServer).DeactivateSim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Sim/DeactivateSim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimServer).DeactivateSim(ctx, req.(*SimTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sim_RetireSim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimServer).RetireSim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Sim/RetireSim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimServer).RetireSim(ctx, req.(*SimTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Sim_GetSimList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -244,6 +527,78 @@ func _Sim_GetSimList_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sim_ListSims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimServer).ListSims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Sim/ListSims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimServer).ListSims(ctx, req.(*ListSimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sim_GetSim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimServer).GetSim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Sim/GetSim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimServer).GetSim(ctx, req.(*GetSimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sim_GetSimByNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimByNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimServer).GetSimByNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Sim/GetSimByNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimServer).GetSimByNumber(ctx, req.(*GetSimByNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sim_GetActivationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GAHRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimServer).GetActivationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Sim/GetActivationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimServer).GetActivationHistory(ctx, req.(*GAHRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Sim_GetFreeServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFreeServRequest)
 	if err := dec(in); err != nil {
@@ -295,6 +650,14 @@ var Sim_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSim",
 			Handler:    _Sim_DeleteSim_Handler,
 		},
+		{
+			MethodName: "RestoreSim",
+			Handler:    _Sim_RestoreSim_Handler,
+		},
+		{
+			MethodName: "UpdateSim",
+			Handler:    _Sim_UpdateSim_Handler,
+		},
 		{
 			MethodName: "ActivateSim",
 			Handler:    _Sim_ActivateSim_Handler,
@@ -303,10 +666,38 @@ var Sim_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSimBlocked",
 			Handler:    _Sim_SetSimBlocked_Handler,
 		},
+		{
+			MethodName: "UnblockSim",
+			Handler:    _Sim_UnblockSim_Handler,
+		},
+		{
+			MethodName: "DeactivateSim",
+			Handler:    _Sim_DeactivateSim_Handler,
+		},
+		{
+			MethodName: "RetireSim",
+			Handler:    _Sim_RetireSim_Handler,
+		},
 		{
 			MethodName: "GetSimList",
 			Handler:    _Sim_GetSimList_Handler,
 		},
+		{
+			MethodName: "ListSims",
+			Handler:    _Sim_ListSims_Handler,
+		},
+		{
+			MethodName: "GetSim",
+			Handler:    _Sim_GetSim_Handler,
+		},
+		{
+			MethodName: "GetSimByNumber",
+			Handler:    _Sim_GetSimByNumber_Handler,
+		},
+		{
+			MethodName: "GetActivationHistory",
+			Handler:    _Sim_GetActivationHistory_Handler,
+		},
 		{
 			MethodName: "GetFreeServices",
 			Handler:    _Sim_GetFreeServices_Handler,
@@ -316,7 +707,13 @@ var Sim_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Sim_GetUsedServices_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportSims",
+			Handler:       _Sim_ImportSims_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "sim.proto",
 }
 
@@ -325,8 +722,11 @@ var Sim_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ServiceClient interface {
 	AddService(ctx context.Context, in *AddServiceRequest, opts ...grpc.CallOption) (*AddServiceResponse, error)
+	// DeleteService marks a service as deleted, it can be restored until it is purged after the retention period
 	DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceResponse, error)
+	RestoreService(ctx context.Context, in *RestoreServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error)
 	GetServiceList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GSLResponse, error)
+	UpdateService(ctx context.Context, in *UpdateServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error)
 }
 
 type serviceClient struct {
@@ -355,6 +755,15 @@ func (c *serviceClient) DeleteService(ctx context.Context, in *DeleteServiceRequ
 	return out, nil
 }
 
+func (c *serviceClient) RestoreService(ctx context.Context, in *RestoreServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error) {
+	out := new(ServiceResponse)
+	err := c.cc.Invoke(ctx, "/Service/RestoreService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetServiceList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GSLResponse, error) {
 	out := new(GSLResponse)
 	err := c.cc.Invoke(ctx, "/Service/GetServiceList", in, out, opts...)
@@ -364,13 +773,25 @@ func (c *serviceClient) GetServiceList(ctx context.Context, in *Empty, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) UpdateService(ctx context.Context, in *UpdateServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error) {
+	out := new(ServiceResponse)
+	err := c.cc.Invoke(ctx, "/Service/UpdateService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
 type ServiceServer interface {
 	AddService(context.Context, *AddServiceRequest) (*AddServiceResponse, error)
+	// DeleteService marks a service as deleted, it can be restored until it is purged after the retention period
 	DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error)
+	RestoreService(context.Context, *RestoreServiceRequest) (*ServiceResponse, error)
 	GetServiceList(context.Context, *Empty) (*GSLResponse, error)
+	UpdateService(context.Context, *UpdateServiceRequest) (*ServiceResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -384,9 +805,15 @@ func (UnimplementedServiceServer) AddService(context.Context, *AddServiceRequest
 func (UnimplementedServiceServer) DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteService not implemented")
 }
+func (UnimplementedServiceServer) RestoreService(context.Context, *RestoreServiceRequest) (*ServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreService not implemented")
+}
 func (UnimplementedServiceServer) GetServiceList(context.Context, *Empty) (*GSLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServiceList not implemented")
 }
+func (UnimplementedServiceServer) UpdateService(context.Context, *UpdateServiceRequest) (*ServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateService not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -436,29 +863,65 @@ func _Service_DeleteService_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_GetServiceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Service_RestoreService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreServiceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).GetServiceList(ctx, in)
+		return srv.(ServiceServer).RestoreService(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Service/GetServiceList",
+		FullMethod: "/Service/RestoreService",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).GetServiceList(ctx, req.(*Empty))
+		return srv.(ServiceServer).RestoreService(ctx, req.(*RestoreServiceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Service_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "Service",
+func _Service_GetServiceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetServiceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Service/GetServiceList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetServiceList(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UpdateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UpdateService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Service/UpdateService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UpdateService(ctx, req.(*UpdateServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Service_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Service",
 	HandlerType: (*ServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -469,10 +932,18 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteService",
 			Handler:    _Service_DeleteService_Handler,
 		},
+		{
+			MethodName: "RestoreService",
+			Handler:    _Service_RestoreService_Handler,
+		},
 		{
 			MethodName: "GetServiceList",
 			Handler:    _Service_GetServiceList_Handler,
 		},
+		{
+			MethodName: "UpdateService",
+			Handler:    _Service_UpdateService_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sim.proto",
@@ -483,6 +954,13 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UsedClient interface {
 	UseSimForService(ctx context.Context, in *USFSRequest, opts ...grpc.CallOption) (*USFSResponse, error)
+	AcquireSim(ctx context.Context, in *AcquireSimRequest, opts ...grpc.CallOption) (*AcquireSimResponse, error)
+	ConfirmLease(ctx context.Context, in *LeaseRequest, opts ...grpc.CallOption) (*ConfirmLeaseResponse, error)
+	ReleaseLease(ctx context.Context, in *LeaseRequest, opts ...grpc.CallOption) (*ReleaseLeaseResponse, error)
+	BlockUsedService(ctx context.Context, in *BlockUsedServiceRequest, opts ...grpc.CallOption) (*UsedServiceResponse, error)
+	UnblockUsedService(ctx context.Context, in *UnblockUsedServiceRequest, opts ...grpc.CallOption) (*UsedServiceResponse, error)
+	ReleaseUsedService(ctx context.Context, in *ReleaseUsedServiceRequest, opts ...grpc.CallOption) (*UsedServiceResponse, error)
+	CheckEligibility(ctx context.Context, in *EligibilityRequest, opts ...grpc.CallOption) (*EligibilityResponse, error)
 }
 
 type usedClient struct {
@@ -502,11 +980,81 @@ func (c *usedClient) UseSimForService(ctx context.Context, in *USFSRequest, opts
 	return out, nil
 }
 
+func (c *usedClient) AcquireSim(ctx context.Context, in *AcquireSimRequest, opts ...grpc.CallOption) (*AcquireSimResponse, error) {
+	out := new(AcquireSimResponse)
+	err := c.cc.Invoke(ctx, "/Used/AcquireSim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usedClient) ConfirmLease(ctx context.Context, in *LeaseRequest, opts ...grpc.CallOption) (*ConfirmLeaseResponse, error) {
+	out := new(ConfirmLeaseResponse)
+	err := c.cc.Invoke(ctx, "/Used/ConfirmLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usedClient) ReleaseLease(ctx context.Context, in *LeaseRequest, opts ...grpc.CallOption) (*ReleaseLeaseResponse, error) {
+	out := new(ReleaseLeaseResponse)
+	err := c.cc.Invoke(ctx, "/Used/ReleaseLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usedClient) BlockUsedService(ctx context.Context, in *BlockUsedServiceRequest, opts ...grpc.CallOption) (*UsedServiceResponse, error) {
+	out := new(UsedServiceResponse)
+	err := c.cc.Invoke(ctx, "/Used/BlockUsedService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usedClient) UnblockUsedService(ctx context.Context, in *UnblockUsedServiceRequest, opts ...grpc.CallOption) (*UsedServiceResponse, error) {
+	out := new(UsedServiceResponse)
+	err := c.cc.Invoke(ctx, "/Used/UnblockUsedService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usedClient) ReleaseUsedService(ctx context.Context, in *ReleaseUsedServiceRequest, opts ...grpc.CallOption) (*UsedServiceResponse, error) {
+	out := new(UsedServiceResponse)
+	err := c.cc.Invoke(ctx, "/Used/ReleaseUsedService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usedClient) CheckEligibility(ctx context.Context, in *EligibilityRequest, opts ...grpc.CallOption) (*EligibilityResponse, error) {
+	out := new(EligibilityResponse)
+	err := c.cc.Invoke(ctx, "/Used/CheckEligibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsedServer is the server API for Used service.
 // All implementations must embed UnimplementedUsedServer
 // for forward compatibility
 type UsedServer interface {
 	UseSimForService(context.Context, *USFSRequest) (*USFSResponse, error)
+	AcquireSim(context.Context, *AcquireSimRequest) (*AcquireSimResponse, error)
+	ConfirmLease(context.Context, *LeaseRequest) (*ConfirmLeaseResponse, error)
+	ReleaseLease(context.Context, *LeaseRequest) (*ReleaseLeaseResponse, error)
+	BlockUsedService(context.Context, *BlockUsedServiceRequest) (*UsedServiceResponse, error)
+	UnblockUsedService(context.Context, *UnblockUsedServiceRequest) (*UsedServiceResponse, error)
+	ReleaseUsedService(context.Context, *ReleaseUsedServiceRequest) (*UsedServiceResponse, error)
+	CheckEligibility(context.Context, *EligibilityRequest) (*EligibilityResponse, error)
 	mustEmbedUnimplementedUsedServer()
 }
 
@@ -517,6 +1065,27 @@ type UnimplementedUsedServer struct {
 func (UnimplementedUsedServer) UseSimForService(context.Context, *USFSRequest) (*USFSResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UseSimForService not implemented")
 }
+func (UnimplementedUsedServer) AcquireSim(context.Context, *AcquireSimRequest) (*AcquireSimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireSim not implemented")
+}
+func (UnimplementedUsedServer) ConfirmLease(context.Context, *LeaseRequest) (*ConfirmLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmLease not implemented")
+}
+func (UnimplementedUsedServer) ReleaseLease(context.Context, *LeaseRequest) (*ReleaseLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLease not implemented")
+}
+func (UnimplementedUsedServer) BlockUsedService(context.Context, *BlockUsedServiceRequest) (*UsedServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUsedService not implemented")
+}
+func (UnimplementedUsedServer) UnblockUsedService(context.Context, *UnblockUsedServiceRequest) (*UsedServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUsedService not implemented")
+}
+func (UnimplementedUsedServer) ReleaseUsedService(context.Context, *ReleaseUsedServiceRequest) (*UsedServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseUsedService not implemented")
+}
+func (UnimplementedUsedServer) CheckEligibility(context.Context, *EligibilityRequest) (*EligibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckEligibility not implemented")
+}
 func (UnimplementedUsedServer) mustEmbedUnimplementedUsedServer() {}
 
 // UnsafeUsedServer may be embedded to opt out of forward compatibility for this service.
@@ -548,6 +1117,132 @@ func _Used_UseSimForService_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Used_AcquireSim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireSimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsedServer).AcquireSim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Used/AcquireSim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsedServer).AcquireSim(ctx, req.(*AcquireSimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Used_ConfirmLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsedServer).ConfirmLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Used/ConfirmLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsedServer).ConfirmLease(ctx, req.(*LeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Used_ReleaseLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsedServer).ReleaseLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Used/ReleaseLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsedServer).ReleaseLease(ctx, req.(*LeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Used_BlockUsedService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUsedServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsedServer).BlockUsedService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Used/BlockUsedService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsedServer).BlockUsedService(ctx, req.(*BlockUsedServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Used_UnblockUsedService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUsedServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsedServer).UnblockUsedService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Used/UnblockUsedService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsedServer).UnblockUsedService(ctx, req.(*UnblockUsedServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Used_ReleaseUsedService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseUsedServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsedServer).ReleaseUsedService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Used/ReleaseUsedService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsedServer).ReleaseUsedService(ctx, req.(*ReleaseUsedServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Used_CheckEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EligibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsedServer).CheckEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Used/CheckEligibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsedServer).CheckEligibility(ctx, req.(*EligibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Used_ServiceDesc is the grpc.ServiceDesc for Used service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -559,6 +1254,34 @@ var Used_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UseSimForService",
 			Handler:    _Used_UseSimForService_Handler,
 		},
+		{
+			MethodName: "AcquireSim",
+			Handler:    _Used_AcquireSim_Handler,
+		},
+		{
+			MethodName: "ConfirmLease",
+			Handler:    _Used_ConfirmLease_Handler,
+		},
+		{
+			MethodName: "ReleaseLease",
+			Handler:    _Used_ReleaseLease_Handler,
+		},
+		{
+			MethodName: "BlockUsedService",
+			Handler:    _Used_BlockUsedService_Handler,
+		},
+		{
+			MethodName: "UnblockUsedService",
+			Handler:    _Used_UnblockUsedService_Handler,
+		},
+		{
+			MethodName: "ReleaseUsedService",
+			Handler:    _Used_ReleaseUsedService_Handler,
+		},
+		{
+			MethodName: "CheckEligibility",
+			Handler:    _Used_CheckEligibility_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sim.proto",
@@ -569,6 +1292,14 @@ var Used_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ProviderClient interface {
 	GetProviderList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ProviderList, error)
+	AddProvider(ctx context.Context, in *AddProviderRequest, opts ...grpc.CallOption) (*ProviderResponse, error)
+	RenameProvider(ctx context.Context, in *RenameProviderRequest, opts ...grpc.CallOption) (*ProviderResponse, error)
+	UpdateProvider(ctx context.Context, in *UpdateProviderRequest, opts ...grpc.CallOption) (*ProviderResponse, error)
+	DeleteProvider(ctx context.Context, in *DeleteProviderRequest, opts ...grpc.CallOption) (*DeleteProviderResponse, error)
+	MergeProviders(ctx context.Context, in *MergeProvidersRequest, opts ...grpc.CallOption) (*MergeProvidersResponse, error)
+	GetProviderAliases(ctx context.Context, in *GetProviderAliasesRequest, opts ...grpc.CallOption) (*ProviderAliasesResponse, error)
+	AddProviderAlias(ctx context.Context, in *ProviderAliasRequest, opts ...grpc.CallOption) (*ProviderAliasesResponse, error)
+	RemoveProviderAlias(ctx context.Context, in *ProviderAliasRequest, opts ...grpc.CallOption) (*ProviderAliasesResponse, error)
 }
 
 type providerClient struct {
@@ -588,11 +1319,91 @@ func (c *providerClient) GetProviderList(ctx context.Context, in *Empty, opts ..
 	return out, nil
 }
 
+func (c *providerClient) AddProvider(ctx context.Context, in *AddProviderRequest, opts ...grpc.CallOption) (*ProviderResponse, error) {
+	out := new(ProviderResponse)
+	err := c.cc.Invoke(ctx, "/Provider/AddProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) RenameProvider(ctx context.Context, in *RenameProviderRequest, opts ...grpc.CallOption) (*ProviderResponse, error) {
+	out := new(ProviderResponse)
+	err := c.cc.Invoke(ctx, "/Provider/RenameProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) UpdateProvider(ctx context.Context, in *UpdateProviderRequest, opts ...grpc.CallOption) (*ProviderResponse, error) {
+	out := new(ProviderResponse)
+	err := c.cc.Invoke(ctx, "/Provider/UpdateProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) DeleteProvider(ctx context.Context, in *DeleteProviderRequest, opts ...grpc.CallOption) (*DeleteProviderResponse, error) {
+	out := new(DeleteProviderResponse)
+	err := c.cc.Invoke(ctx, "/Provider/DeleteProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) MergeProviders(ctx context.Context, in *MergeProvidersRequest, opts ...grpc.CallOption) (*MergeProvidersResponse, error) {
+	out := new(MergeProvidersResponse)
+	err := c.cc.Invoke(ctx, "/Provider/MergeProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) GetProviderAliases(ctx context.Context, in *GetProviderAliasesRequest, opts ...grpc.CallOption) (*ProviderAliasesResponse, error) {
+	out := new(ProviderAliasesResponse)
+	err := c.cc.Invoke(ctx, "/Provider/GetProviderAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) AddProviderAlias(ctx context.Context, in *ProviderAliasRequest, opts ...grpc.CallOption) (*ProviderAliasesResponse, error) {
+	out := new(ProviderAliasesResponse)
+	err := c.cc.Invoke(ctx, "/Provider/AddProviderAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) RemoveProviderAlias(ctx context.Context, in *ProviderAliasRequest, opts ...grpc.CallOption) (*ProviderAliasesResponse, error) {
+	out := new(ProviderAliasesResponse)
+	err := c.cc.Invoke(ctx, "/Provider/RemoveProviderAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProviderServer is the server API for Provider service.
 // All implementations must embed UnimplementedProviderServer
 // for forward compatibility
 type ProviderServer interface {
 	GetProviderList(context.Context, *Empty) (*ProviderList, error)
+	AddProvider(context.Context, *AddProviderRequest) (*ProviderResponse, error)
+	RenameProvider(context.Context, *RenameProviderRequest) (*ProviderResponse, error)
+	UpdateProvider(context.Context, *UpdateProviderRequest) (*ProviderResponse, error)
+	DeleteProvider(context.Context, *DeleteProviderRequest) (*DeleteProviderResponse, error)
+	MergeProviders(context.Context, *MergeProvidersRequest) (*MergeProvidersResponse, error)
+	GetProviderAliases(context.Context, *GetProviderAliasesRequest) (*ProviderAliasesResponse, error)
+	AddProviderAlias(context.Context, *ProviderAliasRequest) (*ProviderAliasesResponse, error)
+	RemoveProviderAlias(context.Context, *ProviderAliasRequest) (*ProviderAliasesResponse, error)
 	mustEmbedUnimplementedProviderServer()
 }
 
@@ -603,6 +1414,30 @@ type UnimplementedProviderServer struct {
 func (UnimplementedProviderServer) GetProviderList(context.Context, *Empty) (*ProviderList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProviderList not implemented")
 }
+func (UnimplementedProviderServer) AddProvider(context.Context, *AddProviderRequest) (*ProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProvider not implemented")
+}
+func (UnimplementedProviderServer) RenameProvider(context.Context, *RenameProviderRequest) (*ProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameProvider not implemented")
+}
+func (UnimplementedProviderServer) UpdateProvider(context.Context, *UpdateProviderRequest) (*ProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProvider not implemented")
+}
+func (UnimplementedProviderServer) DeleteProvider(context.Context, *DeleteProviderRequest) (*DeleteProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProvider not implemented")
+}
+func (UnimplementedProviderServer) MergeProviders(context.Context, *MergeProvidersRequest) (*MergeProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeProviders not implemented")
+}
+func (UnimplementedProviderServer) GetProviderAliases(context.Context, *GetProviderAliasesRequest) (*ProviderAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProviderAliases not implemented")
+}
+func (UnimplementedProviderServer) AddProviderAlias(context.Context, *ProviderAliasRequest) (*ProviderAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProviderAlias not implemented")
+}
+func (UnimplementedProviderServer) RemoveProviderAlias(context.Context, *ProviderAliasRequest) (*ProviderAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProviderAlias not implemented")
+}
 func (UnimplementedProviderServer) mustEmbedUnimplementedProviderServer() {}
 
 // UnsafeProviderServer may be embedded to opt out of forward compatibility for this service.
@@ -634,6 +1469,150 @@ func _Provider_GetProviderList_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_AddProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).AddProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Provider/AddProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).AddProvider(ctx, req.(*AddProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_RenameProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).RenameProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Provider/RenameProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).RenameProvider(ctx, req.(*RenameProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_UpdateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).UpdateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Provider/UpdateProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).UpdateProvider(ctx, req.(*UpdateProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_DeleteProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).DeleteProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Provider/DeleteProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).DeleteProvider(ctx, req.(*DeleteProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_MergeProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).MergeProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Provider/MergeProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).MergeProviders(ctx, req.(*MergeProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_GetProviderAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetProviderAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Provider/GetProviderAliases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetProviderAliases(ctx, req.(*GetProviderAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_AddProviderAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).AddProviderAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Provider/AddProviderAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).AddProviderAlias(ctx, req.(*ProviderAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_RemoveProviderAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).RemoveProviderAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Provider/RemoveProviderAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).RemoveProviderAlias(ctx, req.(*ProviderAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Provider_ServiceDesc is the grpc.ServiceDesc for Provider service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -645,6 +1624,237 @@ var Provider_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProviderList",
 			Handler:    _Provider_GetProviderList_Handler,
 		},
+		{
+			MethodName: "AddProvider",
+			Handler:    _Provider_AddProvider_Handler,
+		},
+		{
+			MethodName: "RenameProvider",
+			Handler:    _Provider_RenameProvider_Handler,
+		},
+		{
+			MethodName: "UpdateProvider",
+			Handler:    _Provider_UpdateProvider_Handler,
+		},
+		{
+			MethodName: "DeleteProvider",
+			Handler:    _Provider_DeleteProvider_Handler,
+		},
+		{
+			MethodName: "MergeProviders",
+			Handler:    _Provider_MergeProviders_Handler,
+		},
+		{
+			MethodName: "GetProviderAliases",
+			Handler:    _Provider_GetProviderAliases_Handler,
+		},
+		{
+			MethodName: "AddProviderAlias",
+			Handler:    _Provider_AddProviderAlias_Handler,
+		},
+		{
+			MethodName: "RemoveProviderAlias",
+			Handler:    _Provider_RemoveProviderAlias_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sim.proto",
+}
+
+// InventoryClient is the client API for Inventory service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InventoryClient interface {
+	ExportInventory(ctx context.Context, in *ExportInventoryRequest, opts ...grpc.CallOption) (Inventory_ExportInventoryClient, error)
+}
+
+type inventoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInventoryClient(cc grpc.ClientConnInterface) InventoryClient {
+	return &inventoryClient{cc}
+}
+
+func (c *inventoryClient) ExportInventory(ctx context.Context, in *ExportInventoryRequest, opts ...grpc.CallOption) (Inventory_ExportInventoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Inventory_ServiceDesc.Streams[0], "/Inventory/ExportInventory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &inventoryExportInventoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Inventory_ExportInventoryClient interface {
+	Recv() (*ExportInventoryResponse, error)
+	grpc.ClientStream
+}
+
+type inventoryExportInventoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *inventoryExportInventoryClient) Recv() (*ExportInventoryResponse, error) {
+	m := new(ExportInventoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// InventoryServer is the server API for Inventory service.
+// All implementations must embed UnimplementedInventoryServer
+// for forward compatibility
+type InventoryServer interface {
+	ExportInventory(*ExportInventoryRequest, Inventory_ExportInventoryServer) error
+	mustEmbedUnimplementedInventoryServer()
+}
+
+// UnimplementedInventoryServer must be embedded to have forward compatible implementations.
+type UnimplementedInventoryServer struct {
+}
+
+func (UnimplementedInventoryServer) ExportInventory(*ExportInventoryRequest, Inventory_ExportInventoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportInventory not implemented")
+}
+func (UnimplementedInventoryServer) mustEmbedUnimplementedInventoryServer() {}
+
+// UnsafeInventoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryServer will
+// result in compilation errors.
+type UnsafeInventoryServer interface {
+	mustEmbedUnimplementedInventoryServer()
+}
+
+func RegisterInventoryServer(s grpc.ServiceRegistrar, srv InventoryServer) {
+	s.RegisterService(&Inventory_ServiceDesc, srv)
+}
+
+func _Inventory_ExportInventory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportInventoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServer).ExportInventory(m, &inventoryExportInventoryServer{stream})
+}
+
+type Inventory_ExportInventoryServer interface {
+	Send(*ExportInventoryResponse) error
+	grpc.ServerStream
+}
+
+type inventoryExportInventoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *inventoryExportInventoryServer) Send(m *ExportInventoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Inventory_ServiceDesc is the grpc.ServiceDesc for Inventory service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Inventory_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Inventory",
+	HandlerType: (*InventoryServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportInventory",
+			Handler:       _Inventory_ExportInventory_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "sim.proto",
+}
+
+// AuditClient is the client API for Audit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuditClient interface {
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+}
+
+type auditClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditClient(cc grpc.ClientConnInterface) AuditClient {
+	return &auditClient{cc}
+}
+
+func (c *auditClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/Audit/QueryAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServer is the server API for Audit service.
+// All implementations must embed UnimplementedAuditServer
+// for forward compatibility
+type AuditServer interface {
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	mustEmbedUnimplementedAuditServer()
+}
+
+// UnimplementedAuditServer must be embedded to have forward compatible implementations.
+type UnimplementedAuditServer struct {
+}
+
+func (UnimplementedAuditServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedAuditServer) mustEmbedUnimplementedAuditServer() {}
+
+// UnsafeAuditServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServer will
+// result in compilation errors.
+type UnsafeAuditServer interface {
+	mustEmbedUnimplementedAuditServer()
+}
+
+func RegisterAuditServer(s grpc.ServiceRegistrar, srv AuditServer) {
+	s.RegisterService(&Audit_ServiceDesc, srv)
+}
+
+func _Audit_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Audit/QueryAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Audit_ServiceDesc is the grpc.ServiceDesc for Audit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Audit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Audit",
+	HandlerType: (*AuditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _Audit_QueryAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sim.proto",
@@ -1,17 +1,23 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"log/slog"
 	"os"
 	"os/signal"
 	"simactive/internal/config"
+	"simactive/internal/core"
 	"simactive/internal/core/grpc"
 	repository "simactive/internal/infrastructure"
+	"simactive/internal/lib/apikey"
 	"simactive/internal/lib/logger/handlers/slogpretty"
+	"simactive/internal/lib/logger/sl"
 	"simactive/internal/services"
 	coresql "simactive/internal/sql"
+	"simactive/internal/workers/expiry"
+	"simactive/internal/workers/purge"
 	"syscall"
 )
 
@@ -28,15 +34,38 @@ func main() {
 
 	// Init services
 	repo := repository.NewRepository(logger, db)
-	simService, serviceService, providerService, usedService := initServices(db, logger, repo)
+	if err := repo.Load(context.Background()); err != nil {
+		logger.Error("Failed to load repositories", sl.Err(err))
+		os.Exit(1)
+	}
+	simService, serviceService, providerService, usedService, inventoryService := initServices(cfg, db, logger, repo)
+	auditService := services.NewAuditService(repo)
+
+	// Set the country of the sims stored before it was detected
+	if n, err := simService.BackfillCountries(context.Background()); err != nil {
+		logger.Error("Failed to backfill sim countries", sl.Err(err))
+	} else if n > 0 {
+		logger.Info("Sim countries backfilled", slog.Int("sim count", n))
+	}
 
 	// Init gRPC Server
-	gs := grpc.NewGRPCServer(cfg)
+	gs := grpc.NewGRPCServer(cfg, mustLoadKeys(cfg.Auth))
 	// Run gRPC server
 	go func() {
-		gs.MustRun(logger, simService, serviceService, providerService, usedService)
+		gs.MustRun(logger, simService, serviceService, providerService, usedService, inventoryService, auditService)
 	}()
 
+	// Run activation expiry worker
+	expiryWorker := expiry.New(logger, simService, cfg.Expiry.Interval)
+	go expiryWorker.Run()
+
+	// Run purge worker of deleted sims and services
+	purgeWorker := purge.New(logger, cfg.Purge.Interval, cfg.Purge.Retention, map[string]purge.Purger{
+		"sims":     simService,
+		"services": serviceService,
+	})
+	go purgeWorker.Run()
+
 	// gracefull shutdown
 	//...
 
@@ -46,20 +75,74 @@ func main() {
 	<-stop
 
 	gs.Stop()
+	expiryWorker.Stop()
+	purgeWorker.Stop()
 	log.Print("Gracefull shutdown")
 }
 
-func initServices(db *sql.DB, logger *slog.Logger, repo *repository.Repository) (*services.SimService, *services.ServiceService, *services.ProviderService, *services.UsedService) {
+func initServices(cfg *config.Config, db *sql.DB, logger *slog.Logger, repo *repository.Repository) (*services.SimService, *services.ServiceService, *services.ProviderService, *services.UsedService, *services.InventoryService) {
+
+	policies := usePolicies(cfg.UsePolicy)
 
-	simService := services.NewSimService(repo)
+	simService := services.NewSimService(repo, cfg.Activation.DefaultPeriod, policies)
 
 	serviceService := services.NewServiceService(repo)
 
 	providerService := services.NewProviderService(repo)
 
-	usedService := services.NewUsedService(repo)
+	strategies, err := services.NewSelectionStrategies(cfg.Selection.Strategy, cfg.Selection.Services)
+	if err != nil {
+		log.Fatalf("Invalid selection config: %v", err)
+	}
+
+	propagation := core.BlockPropagation{
+		Default: cfg.ServiceGroups.PropagateBlocks,
+		ByGroup: cfg.ServiceGroups.Groups,
+	}
+	usedService := services.NewUsedService(repo, policies, strategies, propagation)
+
+	inventoryService := services.NewInventoryService(repo)
+
+	return simService, serviceService, providerService, usedService, inventoryService
+}
+
+// mustLoadKeys creates the store of the configured API keys, it exits if a key is not valid.
+func mustLoadKeys(cfg config.AuthConfig) *apikey.Store {
+	if len(cfg.Keys) == 0 {
+		log.Print("No API keys are configured, every gRPC request is rejected")
+	}
+
+	keys := make([]apikey.Key, 0, len(cfg.Keys))
+	for _, k := range cfg.Keys {
+		role, err := core.ParseRole(k.Role)
+		if err != nil {
+			log.Fatalf("Invalid role of API key %s: %v", k.Name, err)
+		}
+
+		key, err := apikey.NewKey(k.Name, k.KeySHA256, role)
+		if err != nil {
+			log.Fatalf("Invalid API key: %v", err)
+		}
+		keys = append(keys, key)
+	}
 
-	return simService, serviceService, providerService, usedService
+	store, err := apikey.NewStore(keys...)
+	if err != nil {
+		log.Fatalf("Invalid API keys: %v", err)
+	}
+	return store
+}
+
+// usePolicies converts the use policy config to the policies of the services.
+func usePolicies(cfg config.UsePolicyConfig) core.UsePolicies {
+	policies := core.UsePolicies{
+		Default:   core.UsePolicy(cfg.UsePolicy),
+		ByService: make(map[string]core.UsePolicy, len(cfg.Services)),
+	}
+	for name, policy := range cfg.Services {
+		policies.ByService[name] = core.UsePolicy(policy)
+	}
+	return policies
 }
 
 func setupLogger() *slog.Logger {
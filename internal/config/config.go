@@ -9,9 +9,17 @@ import (
 )
 
 type Config struct {
-	Env         string     `yaml:"env" env-required:"true"`
-	StoragePath string     `yaml:"storage_path" env-required:"true"`
-	GRPC        GRPCConfig `yaml:"grpc"`
+	Env         string           `yaml:"env" env-required:"true"`
+	StoragePath string           `yaml:"storage_path" env-required:"true"`
+	GRPC        GRPCConfig       `yaml:"grpc"`
+	Auth        AuthConfig       `yaml:"auth"`
+	Expiry      ExpiryConfig     `yaml:"expiry"`
+	Purge       PurgeConfig      `yaml:"purge"`
+	Activation  ActivationConfig `yaml:"activation"`
+	UsePolicy   UsePolicyConfig  `yaml:"use_policy"`
+	Selection   SelectionConfig  `yaml:"selection"`
+	// ServiceGroups configures the groups of services that share an account backend
+	ServiceGroups ServiceGroupConfig `yaml:"service_groups"`
 }
 
 type GRPCConfig struct {
@@ -19,6 +27,68 @@ type GRPCConfig struct {
 	Timeout time.Duration `yaml:"timeout"`
 }
 
+// AuthConfig lists the API keys allowed to call the gRPC server.
+type AuthConfig struct {
+	Keys []APIKeyConfig `yaml:"keys"`
+}
+
+type APIKeyConfig struct {
+	// Name identifies the caller in the logs and the audit log
+	Name string `yaml:"name"`
+	// KeySHA256 is the hex encoded SHA-256 hash of the key, the key itself is not stored
+	KeySHA256 string `yaml:"key_sha256"`
+	// Role is one of viewer, operator and admin
+	Role string `yaml:"role"`
+}
+
+type ExpiryConfig struct {
+	// Interval is how often sims are checked for an ended activation period
+	Interval time.Duration `yaml:"interval" env-default:"1m"`
+}
+
+// PurgeConfig sets when deleted sims and services are deleted for good.
+type PurgeConfig struct {
+	// Interval is how often deleted records are checked
+	Interval time.Duration `yaml:"interval" env-default:"1h"`
+	// Retention is how long a deleted record can be restored before it is purged
+	Retention time.Duration `yaml:"retention" env-default:"720h"`
+}
+
+type ActivationConfig struct {
+	// DefaultPeriod is used for activations without an explicit period when the sim provider has no default period
+	DefaultPeriod time.Duration `yaml:"default_period" env-default:"720h"`
+}
+
+// UsePolicyConfig limits how often a sim is used for a service.
+type UsePolicyConfig struct {
+	UsePolicy `yaml:",inline"`
+	// Services overrides the default policy by the service name
+	Services map[string]UsePolicy `yaml:"services"`
+}
+
+type UsePolicy struct {
+	// Cooldown is the time after the release of a sim from a service before the sim can be used for it again
+	Cooldown time.Duration `yaml:"cooldown"`
+	// MaxPerDay is the max number of services a sim is registered with within 24 hours, 0 means no limit
+	MaxPerDay int `yaml:"max_per_day"`
+}
+
+// SelectionConfig chooses the sim acquired for a service when several sims are free.
+type SelectionConfig struct {
+	// Strategy is one of first, least_recently_used, round_robin, oldest_activation and random
+	Strategy string `yaml:"strategy" env-default:"first"`
+	// Services overrides the default strategy by the service name
+	Services map[string]string `yaml:"services"`
+}
+
+// ServiceGroupConfig sets whether a block of a sim on a service of a group spreads over the group.
+type ServiceGroupConfig struct {
+	// PropagateBlocks blocks a sim on every service of a group when it is blocked on one of them
+	PropagateBlocks bool `yaml:"propagate_blocks" env-default:"true"`
+	// Groups overrides PropagateBlocks by the name of the top level service of the group
+	Groups map[string]bool `yaml:"groups"`
+}
+
 func MustLoad() *Config {
 	path := fetchConfigPath()
 	if path == "" {
@@ -38,6 +108,17 @@ func MustLoadByPath(path string) *Config {
 		panic("failed to read config " + err.Error())
 	}
 
+	// time.NewTicker panics on a non-positive interval
+	if cfg.Expiry.Interval <= 0 {
+		panic("expiry interval must be positive")
+	}
+	if cfg.Purge.Interval <= 0 {
+		panic("purge interval must be positive")
+	}
+	if cfg.Purge.Retention < 0 {
+		panic("purge retention must not be negative")
+	}
+
 	return &cfg
 }
 
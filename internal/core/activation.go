@@ -0,0 +1,70 @@
+package core
+
+import "database/sql"
+
+// ActivationKind tells whether an activation started a new period or extended the current one.
+type ActivationKind string
+
+const (
+	ActivationKindActivate ActivationKind = "activate"
+	ActivationKindExtend   ActivationKind = "extend"
+)
+
+// Activation is a history record of a single sim activation or extension.
+type Activation struct {
+	id            int
+	simId         int
+	kind          ActivationKind
+	activatedAt   int64
+	previousUntil int64
+	activateUntil int64
+}
+
+// NewActivation creates a new Activation object with the given parameters.
+// Parameters:
+//   - id: the id of the Activation.
+//   - simId: the id of the activated Sim.
+//   - kind: whether the Sim was activated or its activation was extended.
+//   - activatedAt: the timestamp of the activation.
+//   - previousUntil: the timestamp the Sim was activated until before the activation.
+//   - activateUntil: the timestamp the Sim is activated until after the activation.
+func NewActivation(id, simId int, kind ActivationKind, activatedAt, previousUntil, activateUntil int64) Activation {
+	return Activation{
+		id:            id,
+		simId:         simId,
+		kind:          kind,
+		activatedAt:   activatedAt,
+		previousUntil: previousUntil,
+		activateUntil: activateUntil,
+	}
+}
+
+// Getters
+
+func (a Activation) Id() int              { return a.id }
+func (a Activation) SimID() int           { return a.simId }
+func (a Activation) Kind() ActivationKind { return a.kind }
+func (a Activation) ActivatedAt() int64   { return a.activatedAt }
+func (a Activation) PreviousUntil() int64 { return a.previousUntil }
+func (a Activation) ActivateUntil() int64 { return a.activateUntil }
+
+// ScanRows scans the values from the given sql.Rows into the fields of the Activation struct.
+func (a *Activation) ScanRows(rows *sql.Rows) (int, error) {
+	err := rows.Scan(&a.id, &a.simId, &a.kind, &a.activatedAt, &a.previousUntil, &a.activateUntil)
+	return a.id, err
+}
+
+// ScanRow scans the values from the given sql.Row into the fields of the Activation struct.
+func (a *Activation) ScanRow(row *sql.Row) error {
+	return row.Scan(&a.id, &a.simId, &a.kind, &a.activatedAt, &a.previousUntil, &a.activateUntil)
+}
+
+// GetKey returns the map key of the Activation that used in the List.
+func (a *Activation) GetKey() int {
+	return a.Id()
+}
+
+// SetKey sets the id of the Activation.
+func (a *Activation) SetKey(id int) {
+	a.id = id
+}
@@ -0,0 +1,183 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+)
+
+// AuditEntity is the kind of record an audit entry is about.
+type AuditEntity string
+
+const (
+	AuditEntitySim     AuditEntity = "sim"
+	AuditEntityService AuditEntity = "service"
+	AuditEntityUsed    AuditEntity = "used_service"
+)
+
+// AnonymousActor is the actor of the mutations made by a caller without an identity.
+const AnonymousActor = "anonymous"
+
+// Caller identifies who made a request and through which RPC.
+type Caller struct {
+	Actor string
+	// Role is the role of the API key of the request, empty for background workers
+	Role Role
+	// RPC is the full gRPC method name, empty for the mutations of background workers
+	RPC string
+}
+
+type callerKey struct{}
+
+// WithCaller returns a copy of ctx that holds the caller.
+func WithCaller(ctx context.Context, c Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, c)
+}
+
+// CallerFrom returns the caller held by ctx, the actor is AnonymousActor if it is not set.
+func CallerFrom(ctx context.Context) Caller {
+	c, _ := ctx.Value(callerKey{}).(Caller)
+	if c.Actor == "" {
+		c.Actor = AnonymousActor
+	}
+	return c
+}
+
+// AuditEntry is an append-only record of a single mutation of a sim, a service or a used record.
+// Before and after hold the JSON values of the entity, before is empty for an added entity
+// and after is empty for a deleted one.
+type AuditEntry struct {
+	id         int
+	actor      string
+	rpc        string
+	action     string
+	entityType AuditEntity
+	entityId   int
+	before     string
+	after      string
+	createdAt  int64
+}
+
+// NewAuditEntry creates a new AuditEntry object with the given parameters.
+// Parameters:
+//   - caller: the caller that made the mutation.
+//   - action: the name of the mutation, e.g. BlockSim.
+//   - entityType, entityId: the mutated record.
+//   - before, after: the values of the record before and after the mutation, nil if there is none.
+//   - createdAt: the timestamp of the mutation.
+func NewAuditEntry(caller Caller, action string, entityType AuditEntity, entityId int, before, after any, createdAt int64) (AuditEntry, error) {
+	beforeJSON, err := auditJSON(before)
+	if err != nil {
+		return AuditEntry{}, err
+	}
+	afterJSON, err := auditJSON(after)
+	if err != nil {
+		return AuditEntry{}, err
+	}
+
+	return AuditEntry{
+		actor:      caller.Actor,
+		rpc:        caller.RPC,
+		action:     action,
+		entityType: entityType,
+		entityId:   entityId,
+		before:     beforeJSON,
+		after:      afterJSON,
+		createdAt:  createdAt,
+	}, nil
+}
+
+// auditJSON encodes the audit value, nil is encoded as an empty string.
+func auditJSON(v any) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	b, err := json.Marshal(v)
+	return string(b), err
+}
+
+// Getters
+
+func (e AuditEntry) Id() int                 { return e.id }
+func (e AuditEntry) Actor() string           { return e.actor }
+func (e AuditEntry) RPC() string             { return e.rpc }
+func (e AuditEntry) Action() string          { return e.action }
+func (e AuditEntry) EntityType() AuditEntity { return e.entityType }
+func (e AuditEntry) EntityID() int           { return e.entityId }
+func (e AuditEntry) Before() string          { return e.before }
+func (e AuditEntry) After() string           { return e.after }
+func (e AuditEntry) CreatedAt() int64        { return e.createdAt }
+
+// ScanRows scans the values from the given sql.Rows into the fields of the AuditEntry struct.
+func (e *AuditEntry) ScanRows(rows *sql.Rows) (int, error) {
+	err := rows.Scan(&e.id, &e.actor, &e.rpc, &e.action, &e.entityType, &e.entityId, &e.before, &e.after, &e.createdAt)
+	return e.id, err
+}
+
+// AuditQuery selects audit entries, zero fields do not filter.
+// From and To are unix timestamps, both are inclusive. Entries are returned newest first.
+type AuditQuery struct {
+	EntityType AuditEntity
+	EntityID   int
+	Actor      string
+	From       int64
+	To         int64
+	Limit      int
+}
+
+// SimAuditValue returns the audited fields of the sim, nil if there is no sim.
+func SimAuditValue(s *Sim) any {
+	if s == nil {
+		return nil
+	}
+
+	v := map[string]any{
+		"id":             s.Id(),
+		"number":         s.Number(),
+		"country":        s.Country(),
+		"state":          s.State(),
+		"state_reason":   s.StateReason(),
+		"activate_until": s.ActivateUntil(),
+	}
+	if s.Provider() != nil {
+		v["provider_id"] = s.Provider().Id()
+		v["provider_name"] = s.Provider().Name()
+	}
+	return v
+}
+
+// ServiceAuditValue returns the audited fields of the service, nil if there is no service.
+func ServiceAuditValue(s *Service) any {
+	if s == nil {
+		return nil
+	}
+
+	return map[string]any{
+		"id":                s.Id(),
+		"name":              s.Name(),
+		"url":               s.URL(),
+		"category":          s.Category(),
+		"verification_type": s.VerificationType(),
+		"countries":         s.Countries(),
+		"enabled":           s.Enabled(),
+		"parent_id":         s.ParentID(),
+	}
+}
+
+// UsedAuditValue returns the audited fields of the used record, nil if there is no record.
+func UsedAuditValue(u *Used) any {
+	if u == nil {
+		return nil
+	}
+
+	return map[string]any{
+		"id":           u.Id(),
+		"sim_id":       u.SimID(),
+		"service_id":   u.ServiceID(),
+		"is_blocked":   u.IsBlocked(),
+		"blocked_info": u.BlockedInfo(),
+		"blocked_at":   u.BlockedAt(),
+		"used_at":      u.UsedAt(),
+		"released_at":  u.ReleasedAt(),
+	}
+}
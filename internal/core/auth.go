@@ -0,0 +1,43 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Role is the access level of an API key. Every role may do what the lower roles may do.
+//
+// A viewer reads sims, services and providers, an operator also works with sims and their used services,
+// an admin also deletes and restores records, manages providers and reads the audit log.
+type Role string
+
+const (
+	RoleViewer   Role = "viewer"
+	RoleOperator Role = "operator"
+	RoleAdmin    Role = "admin"
+)
+
+var ErrUnknownRole = errors.New("unknown role")
+
+// roleRanks orders the roles from the lowest to the highest.
+var roleRanks = map[Role]int{
+	RoleViewer:   1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+// ParseRole returns the role with the given name, one of viewer, operator and admin.
+func ParseRole(name string) (Role, error) {
+	r := Role(name)
+	if _, ok := roleRanks[r]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownRole, name)
+	}
+	return r, nil
+}
+
+// Allows reports whether the role may do what the required role may do.
+// An unknown role allows nothing.
+func (r Role) Allows(required Role) bool {
+	rank, ok := roleRanks[r]
+	return ok && rank >= roleRanks[required]
+}
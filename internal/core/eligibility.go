@@ -0,0 +1,113 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// EligibilityReason explains why a sim can not be used for a service.
+type EligibilityReason string
+
+const (
+	ReasonSimNotFound     EligibilityReason = "sim_not_found"
+	ReasonSimBlocked      EligibilityReason = "sim_blocked"
+	ReasonSimExpired      EligibilityReason = "sim_expired"
+	ReasonSimNotActivated EligibilityReason = "sim_not_activated"
+	ReasonServiceNotFound EligibilityReason = "service_not_found"
+	ReasonServiceDisabled EligibilityReason = "service_disabled"
+	// ReasonCountryNotSupported is a service that does not accept numbers of the country of the sim
+	ReasonCountryNotSupported EligibilityReason = "country_not_supported"
+	ReasonAlreadyUsed         EligibilityReason = "already_used"
+	// ReasonBlockedOnService is a block of the sim on the service, not of the sim itself
+	ReasonBlockedOnService EligibilityReason = "blocked_on_service"
+	// ReasonCooldown is a use policy that does not allow the use yet, see Eligibility.EligibleAt
+	ReasonCooldown EligibilityReason = "cooldown"
+	// ReasonLeased is a not confirmed lease of the sim for the service or another service of its group, see Lease
+	ReasonLeased EligibilityReason = "leased"
+)
+
+var ErrNotEligible = errors.New("Sim is not eligible for the service")
+
+// Eligibility is the answer to whether a sim can be used for a service.
+type Eligibility struct {
+	// Reasons are empty if the sim is eligible
+	Reasons []EligibilityReason
+	// EligibleAt is the unix timestamp the sim becomes eligible at, if cooldown is the only reason
+	EligibleAt int64
+}
+
+func (e Eligibility) Eligible() bool {
+	return len(e.Reasons) == 0
+}
+
+// SimEligibility checks the state of the sim for a use at now, the sim may be nil if it does not exist.
+func SimEligibility(s *Sim, now int64) []EligibilityReason {
+	if s == nil {
+		return []EligibilityReason{ReasonSimNotFound}
+	}
+
+	switch s.State() {
+	case SimStateBlocked:
+		return []EligibilityReason{ReasonSimBlocked}
+	case SimStateExpired:
+		return []EligibilityReason{ReasonSimExpired}
+	case SimStateActive:
+		// the expiry worker may not have expired the sim yet
+		if s.ActivateUntil() != 0 && s.ActivateUntil() <= now {
+			return []EligibilityReason{ReasonSimExpired}
+		}
+		return nil
+	default:
+		return []EligibilityReason{ReasonSimNotActivated}
+	}
+}
+
+// ServiceEligibility checks that the service is enabled and accepts the number of the sim.
+// A sim of an unknown country is accepted.
+func ServiceEligibility(service *Service, s *Sim) []EligibilityReason {
+	var reasons []EligibilityReason
+	if !service.Enabled() {
+		reasons = append(reasons, ReasonServiceDisabled)
+	}
+	if !service.SupportsCountry(s.Country()) {
+		reasons = append(reasons, ReasonCountryNotSupported)
+	}
+	return reasons
+}
+
+// AddPolicyError adds the reason of a use policy error, see UsePolicy.Check. It returns false for other errors.
+func (e *Eligibility) AddPolicyError(err error) bool {
+	var notAllowed *UseNotAllowedError
+	switch {
+	case err == nil:
+	case errors.As(err, &notAllowed):
+		e.Reasons = append(e.Reasons, ReasonCooldown)
+		e.EligibleAt = notAllowed.EligibleAt
+	case errors.Is(err, ErrBlockedOnUse):
+		e.Reasons = append(e.Reasons, ReasonBlockedOnService)
+	case errors.Is(err, ErrAlreadyUsed):
+		e.Reasons = append(e.Reasons, ReasonAlreadyUsed)
+	default:
+		return false
+	}
+	return true
+}
+
+// NotEligibleError is ErrNotEligible with the eligibility of the sim.
+// errors.Is(err, ErrNotEligible) reports true for it.
+type NotEligibleError struct {
+	Eligibility Eligibility
+}
+
+func (e *NotEligibleError) Error() string {
+	reasons := make([]string, 0, len(e.Eligibility.Reasons))
+	for _, r := range e.Eligibility.Reasons {
+		reasons = append(reasons, string(r))
+	}
+	return fmt.Sprintf("%s: %s", ErrNotEligible, strings.Join(reasons, ", "))
+}
+
+func (e *NotEligibleError) Unwrap() error {
+	return ErrNotEligible
+}
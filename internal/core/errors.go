@@ -0,0 +1,76 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Domain errors returned by the services layer.
+var (
+	ErrNoFreeSim         = errors.New("No free sim")
+	ErrLeaseNotFound     = errors.New("Lease not found")
+	ErrIllegalTransition = errors.New("Illegal sim state transition")
+	ErrSimNotFound       = errors.New("Sim not found")
+	ErrServiceNotFound   = errors.New("Service not found")
+	ErrServiceDisabled   = errors.New("Service is disabled")
+	// ErrServiceParentNotFound is returned when the parent of a service does not exist
+	ErrServiceParentNotFound = errors.New("Parent service not found")
+	// ErrNestedServiceGroup is returned when a service would become a child of a child service,
+	// of itself, or a service with children would get a parent. Groups have one level.
+	ErrNestedServiceGroup = errors.New("Service groups can not be nested")
+	ErrProviderInUse      = errors.New("Provider has sims")
+	ErrProviderNotFound   = errors.New("Provider not found")
+	// ErrProviderNotInferred is returned when a sim has no provider name and the country of its number
+	// does not have exactly one provider
+	ErrProviderNotInferred = errors.New("Provider can not be inferred from the number")
+	// ErrReassignTargetNotFound is returned when the provider the sims are reassigned to does not exist
+	ErrReassignTargetNotFound = errors.New("Provider to reassign sims to not found")
+	// ErrCurrencyRequired is returned when a provider would have a monthly cost without a currency
+	ErrCurrencyRequired = errors.New("Currency is required with monthly cost")
+	// ErrMCCCountryMismatch is returned when the mcc of a provider belongs to another country than the provider
+	ErrMCCCountryMismatch = errors.New("Mcc belongs to another country")
+)
+
+// ProviderInUseError is ErrProviderInUse with the number of the provider sims.
+// errors.Is(err, ErrProviderInUse) reports true for it.
+type ProviderInUseError struct {
+	Sims int
+}
+
+func (e *ProviderInUseError) Error() string {
+	return fmt.Sprintf("%s: %d", ErrProviderInUse, e.Sims)
+}
+
+func (e *ProviderInUseError) Unwrap() error {
+	return ErrProviderInUse
+}
+
+// ProviderNotFoundError is ErrProviderNotFound with the id of the provider.
+// errors.Is(err, ErrProviderNotFound) reports true for it.
+type ProviderNotFoundError struct {
+	ID int
+}
+
+func (e *ProviderNotFoundError) Error() string {
+	return fmt.Sprintf("%s: %d", ErrProviderNotFound, e.ID)
+}
+
+func (e *ProviderNotFoundError) Unwrap() error {
+	return ErrProviderNotFound
+}
+
+// MCCCountryError is ErrMCCCountryMismatch with the mcc and both countries.
+// errors.Is(err, ErrMCCCountryMismatch) reports true for it.
+type MCCCountryError struct {
+	MCC        string
+	MCCCountry string
+	Country    string
+}
+
+func (e *MCCCountryError) Error() string {
+	return fmt.Sprintf("Mcc %s belongs to %s, not to %s", e.MCC, e.MCCCountry, e.Country)
+}
+
+func (e *MCCCountryError) Unwrap() error {
+	return ErrMCCCountryMismatch
+}
@@ -0,0 +1,40 @@
+package core
+
+// ExportEntity is the kind of records of an inventory export.
+type ExportEntity string
+
+const (
+	ExportSims      ExportEntity = "sims"
+	ExportProviders ExportEntity = "providers"
+	ExportServices  ExportEntity = "services"
+	// ExportUsage exports the used services of the sims
+	ExportUsage ExportEntity = "usage"
+)
+
+// exportColumns are the columns of the entity exports in their order.
+// Columns are only appended, so that the order stays stable for the consumers of exports.
+var exportColumns = map[ExportEntity][]string{
+	ExportSims: {
+		"id", "number", "country", "provider_id", "provider_name",
+		"state", "state_reason", "is_activated", "activate_until", "is_blocked",
+	},
+	ExportProviders: {"id", "name", "default_activation_period", "country", "mcc", "mnc", "monthly_cost", "currency"},
+	ExportServices:  {"id", "name", "url", "category", "verification_type", "countries", "enabled", "parent_id"},
+	ExportUsage: {
+		"id", "sim_id", "sim_number", "service_id", "service_name", "is_blocked", "blocked_info",
+	},
+}
+
+// Columns returns the columns of the export, nil for an unknown entity.
+func (e ExportEntity) Columns() []string {
+	return exportColumns[e]
+}
+
+// ExportQuery selects the records of an inventory export.
+type ExportQuery struct {
+	Entity ExportEntity
+	// SimFilter selects the sims of sims and usage exports
+	SimFilter SimFilter
+	// ServiceID selects the service of services and usage exports, 0 selects all services
+	ServiceID int
+}
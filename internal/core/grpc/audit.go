@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+	"simactive/internal/core"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+)
+
+var auditEntities = map[pb.AuditEntity]core.AuditEntity{
+	pb.AuditEntity_AUDIT_ENTITY_UNSPECIFIED:  "",
+	pb.AuditEntity_AUDIT_ENTITY_SIM:          core.AuditEntitySim,
+	pb.AuditEntity_AUDIT_ENTITY_SERVICE:      core.AuditEntityService,
+	pb.AuditEntity_AUDIT_ENTITY_USED_SERVICE: core.AuditEntityUsed,
+}
+
+type AuditService interface {
+	Query(ctx context.Context, q core.AuditQuery) ([]*core.AuditEntry, error)
+}
+
+type GRPCAuditService struct {
+	pb.UnimplementedAuditServer
+
+	logger       *slog.Logger
+	timeout      time.Duration
+	auditService AuditService
+}
+
+func NewGRPCAuditService(logger *slog.Logger, as AuditService, timeout time.Duration) GRPCAuditService {
+	return GRPCAuditService{
+		logger:       logger,
+		auditService: as,
+		timeout:      timeout,
+	}
+}
+
+// QueryAuditLog retrieves the audit entries of an entity, an actor or a time range, newest first.
+func (gas GRPCAuditService) QueryAuditLog(ctx context.Context, req *pb.QueryAuditLogRequest) (*pb.QueryAuditLogResponse, error) {
+	entity, ok := auditEntities[req.GetEntityType()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid entity type %v", req.GetEntityType())
+	}
+	if req.GetEntityId() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid entity id, entity id must not be negative")
+	}
+	if req.GetEntityId() != 0 && entity == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Entity type is required to filter by entity id")
+	}
+	if req.GetFrom() < 0 || req.GetTo() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid time range, timestamps must not be negative")
+	}
+	if req.GetTo() != 0 && req.GetFrom() > req.GetTo() {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid time range, from must not be after to")
+	}
+
+	limit := int(req.GetLimit())
+	switch {
+	case limit < 0 || limit > maxAuditLimit:
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid limit, limit must be between 0 and %d", maxAuditLimit)
+	case limit == 0:
+		limit = defaultAuditLimit
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gas.timeout)
+	defer cancel()
+
+	entries, err := gas.auditService.Query(ctx, core.AuditQuery{
+		EntityType: entity,
+		EntityID:   int(req.GetEntityId()),
+		Actor:      req.GetActor(),
+		From:       req.GetFrom(),
+		To:         req.GetTo(),
+		Limit:      limit,
+	})
+	if err != nil {
+		gas.logger.Error("Failed to query audit log", "err", err)
+		return nil, ErrInternal
+	}
+
+	response := &pb.QueryAuditLogResponse{
+		Entries: make([]*pb.AuditEntryData, 0, len(entries)),
+	}
+	for _, e := range entries {
+		response.Entries = append(response.Entries, auditEntryToPB(e))
+	}
+	return response, nil
+}
+
+func auditEntryToPB(e *core.AuditEntry) *pb.AuditEntryData {
+	var entity pb.AuditEntity
+	for pbEntity, coreEntity := range auditEntities {
+		if coreEntity == e.EntityType() {
+			entity = pbEntity
+		}
+	}
+
+	return &pb.AuditEntryData{
+		Id:         int64(e.Id()),
+		Actor:      e.Actor(),
+		Rpc:        e.RPC(),
+		Action:     e.Action(),
+		EntityType: entity,
+		EntityId:   int32(e.EntityID()),
+		Before:     e.Before(),
+		After:      e.After(),
+		CreatedAt:  e.CreatedAt(),
+	}
+}
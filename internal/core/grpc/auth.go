@@ -0,0 +1,141 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"simactive/internal/core"
+	"simactive/internal/lib/apikey"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyMetadataKey is the metadata key of the API key, it may be sent as a bearer token of the authorization metadata too.
+const apiKeyMetadataKey = "x-api-key"
+
+// permissions is the lowest role allowed to call each method. Methods that are not listed require the admin role.
+var permissions = map[string]core.Role{
+	"/Sim/GetSimList":           core.RoleViewer,
+	"/Sim/ListSims":             core.RoleViewer,
+	"/Sim/GetSim":               core.RoleViewer,
+	"/Sim/GetSimByNumber":       core.RoleViewer,
+	"/Sim/GetActivationHistory": core.RoleViewer,
+	"/Sim/GetFreeServices":      core.RoleViewer,
+	"/Sim/GetUsedServices":      core.RoleViewer,
+	"/Sim/AddSim":               core.RoleOperator,
+	"/Sim/ImportSims":           core.RoleOperator,
+	"/Sim/UpdateSim":            core.RoleOperator,
+	"/Sim/ActivateSim":          core.RoleOperator,
+	"/Sim/SetSimBlocked":        core.RoleOperator,
+	"/Sim/UnblockSim":           core.RoleOperator,
+	"/Sim/DeactivateSim":        core.RoleOperator,
+	"/Sim/RetireSim":            core.RoleAdmin,
+	"/Sim/DeleteSim":            core.RoleAdmin,
+	"/Sim/RestoreSim":           core.RoleAdmin,
+
+	"/Service/GetServiceList": core.RoleViewer,
+	"/Service/AddService":     core.RoleOperator,
+	"/Service/UpdateService":  core.RoleOperator,
+	"/Service/DeleteService":  core.RoleAdmin,
+	"/Service/RestoreService": core.RoleAdmin,
+
+	"/Used/CheckEligibility":   core.RoleViewer,
+	"/Used/UseSimForService":   core.RoleOperator,
+	"/Used/AcquireSim":         core.RoleOperator,
+	"/Used/ConfirmLease":       core.RoleOperator,
+	"/Used/ReleaseLease":       core.RoleOperator,
+	"/Used/BlockUsedService":   core.RoleOperator,
+	"/Used/UnblockUsedService": core.RoleOperator,
+	"/Used/ReleaseUsedService": core.RoleOperator,
+
+	"/Provider/GetProviderList":    core.RoleViewer,
+	"/Provider/GetProviderAliases": core.RoleViewer,
+
+	"/Inventory/ExportInventory": core.RoleViewer,
+
+	"/Audit/QueryAuditLog": core.RoleAdmin,
+}
+
+// requiredRole returns the lowest role allowed to call the method.
+func requiredRole(method string) core.Role {
+	if role, ok := permissions[method]; ok {
+		return role
+	}
+	return core.RoleAdmin
+}
+
+type KeyStore interface {
+	Lookup(value string) (apikey.Key, bool)
+}
+
+// authenticator checks the API key of a request and whether the role of the key may call the method.
+// The caller of an allowed request is put into its context, see core.CallerFrom.
+type authenticator struct {
+	logger *slog.Logger
+	keys   KeyStore
+}
+
+func (a authenticator) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a authenticator) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticate returns the Unauthenticated status if the request has no valid API key
+// and the PermissionDenied status if the role of the key may not call the method.
+func (a authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	const op = "grpc.authenticator.authenticate"
+
+	value := apiKeyFromMetadata(ctx)
+	if value == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "API key is required, send it in the %s metadata or as a bearer token", apiKeyMetadataKey)
+	}
+
+	key, ok := a.keys.Lookup(value)
+	if !ok {
+		a.logger.Warn("Invalid API key", slog.String("op", op), slog.String("method", method))
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid API key")
+	}
+
+	required := requiredRole(method)
+	if !key.Role.Allows(required) {
+		a.logger.Warn(
+			"Permission denied",
+			slog.String("op", op),
+			slog.String("method", method),
+			slog.String("key", key.Name),
+			slog.String("role", string(key.Role)),
+		)
+		return nil, status.Errorf(codes.PermissionDenied, "Role %s can not call %s, role %s is required", key.Role, method, required)
+	}
+
+	return withCaller(ctx, method, key), nil
+}
+
+// apiKeyFromMetadata returns the API key of the x-api-key metadata or the bearer token of the authorization metadata.
+func apiKeyFromMetadata(ctx context.Context) string {
+	if values := metadata.ValueFromIncomingContext(ctx, apiKeyMetadataKey); len(values) > 0 && values[0] != "" {
+		return values[0]
+	}
+
+	for _, value := range metadata.ValueFromIncomingContext(ctx, "authorization") {
+		scheme, token, ok := strings.Cut(value, " ")
+		if ok && strings.EqualFold(scheme, "bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+	"simactive/internal/core"
+	"simactive/internal/lib/apikey"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// actorMetadataKey is the metadata key of the person behind a shared API key, e.g. the name of an operator.
+const actorMetadataKey = "x-actor"
+
+// withCaller returns a copy of ctx with the caller of the method, see core.CallerFrom.
+// The actor is the name of the API key, the x-actor metadata is appended to it as name/x-actor,
+// so a caller can not act as another key.
+func withCaller(ctx context.Context, method string, key apikey.Key) context.Context {
+	caller := core.Caller{Actor: key.Name, Role: key.Role, RPC: method}
+	if values := metadata.ValueFromIncomingContext(ctx, actorMetadataKey); len(values) > 0 && values[0] != "" {
+		caller.Actor = key.Name + "/" + values[0]
+	}
+	return core.WithCaller(ctx, caller)
+}
+
+// contextStream is a grpc.ServerStream with a replaced context.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -22,23 +22,27 @@ var (
 type GRPCServer struct {
 	port    int
 	timeout time.Duration
+	// keys are the API keys allowed to call the server
+	keys KeyStore
 
 	// gRPC services
 	server *grpc.Server
 }
 
-func NewGRPCServer(cfg *config.Config) *GRPCServer {
+func NewGRPCServer(cfg *config.Config, keys KeyStore) *GRPCServer {
 	return &GRPCServer{
 		port:    cfg.GRPC.Port,
 		timeout: cfg.GRPC.Timeout,
+		keys:    keys,
 	}
 }
 
 // MustRun runs the GRPCServer.
 //
-// It takes a SimService, a ServiceService, a ProviderService, and a UsedService as arguments.
+// It takes a SimService, a ServiceService, a ProviderService, a UsedService, an InventoryService and an AuditService as arguments.
+// Every request must have an API key whose role may call the method, see permissions.
 // It truly panics if the gRPC server fails to start.
-func (s *GRPCServer) MustRun(logger *slog.Logger, sim SimService, ss ServiceService, ps ProviderService, us UsedService) {
+func (s *GRPCServer) MustRun(logger *slog.Logger, sim SimService, ss ServiceService, ps ProviderService, us UsedService, is InventoryService, as AuditService) {
 	addr := fmt.Sprintf("127.0.0.1:%d", s.port)
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
@@ -46,13 +50,19 @@ func (s *GRPCServer) MustRun(logger *slog.Logger, sim SimService, ss ServiceServ
 		log.Fatalf("failed to start gRPC server: %v", err)
 	}
 
-	gs := grpc.NewServer()
+	auth := authenticator{logger: logger, keys: s.keys}
+	gs := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(auth.unary),
+		grpc.ChainStreamInterceptor(auth.stream),
+	)
 	s.server = gs
 
-	pb.RegisterSimServer(gs, NewGRPCSimService(logger, sim, s.timeout))
-	pb.RegisterServiceServer(gs, NewGRPCServiceService(ss, s.timeout))
-	pb.RegisterProviderServer(gs, NewGRPCProviderService(ps, s.timeout))
-	pb.RegisterUsedServer(gs, NewGRPCUsedService(us, s.timeout))
+	pb.RegisterSimServer(gs, NewGRPCSimService(logger, sim, ss, s.timeout))
+	pb.RegisterServiceServer(gs, NewGRPCServiceService(logger, ss, s.timeout))
+	pb.RegisterProviderServer(gs, NewGRPCProviderService(logger, ps, s.timeout))
+	pb.RegisterUsedServer(gs, NewGRPCUsedService(logger, us, ss, s.timeout))
+	pb.RegisterInventoryServer(gs, NewGRPCInventoryService(logger, is))
+	pb.RegisterAuditServer(gs, NewGRPCAuditService(logger, as, s.timeout))
 
 	logger.Info("Starting gRPC server", slog.String("addr", addr))
 	if err = gs.Serve(lis); err != nil {
@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+	"simactive/internal/core"
+	"simactive/internal/lib/export"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize is the size of the export chunks sent to the client.
+const exportChunkSize = 64 << 10
+
+type InventoryService interface {
+	Export(ctx context.Context, q core.ExportQuery, write func(values []any) error) error
+}
+
+type GRPCInventoryService struct {
+	pb.UnimplementedInventoryServer
+
+	logger           *slog.Logger
+	inventoryService InventoryService
+}
+
+func NewGRPCInventoryService(logger *slog.Logger, is InventoryService) GRPCInventoryService {
+	return GRPCInventoryService{
+		logger:           logger,
+		inventoryService: is,
+	}
+}
+
+// ExportInventory streams an export of sims, providers, services or their usage as csv or json lines.
+// The export is sent in chunks while the records are read, the client joins the chunks into the file.
+// An export is not limited by the request timeout, it ends when the records end or the client cancels the stream.
+func (gis GRPCInventoryService) ExportInventory(req *pb.ExportInventoryRequest, stream pb.Inventory_ExportInventoryServer) error {
+	q := core.ExportQuery{
+		ServiceID: int(req.GetServiceId()),
+	}
+
+	switch req.GetEntity() {
+	case pb.InventoryEntity_INVENTORY_ENTITY_SIMS:
+		q.Entity = core.ExportSims
+	case pb.InventoryEntity_INVENTORY_ENTITY_PROVIDERS:
+		q.Entity = core.ExportProviders
+	case pb.InventoryEntity_INVENTORY_ENTITY_SERVICES:
+		q.Entity = core.ExportServices
+	case pb.InventoryEntity_INVENTORY_ENTITY_USAGE:
+		q.Entity = core.ExportUsage
+	default:
+		return status.Errorf(codes.InvalidArgument, "Invalid entity %v", req.GetEntity())
+	}
+
+	if q.ServiceID < 0 {
+		return status.Errorf(codes.InvalidArgument, "Invalid service id, service id must not be negative")
+	}
+
+	filter, err := simFilterFromPB(req.GetSimFilter())
+	if err != nil {
+		return err
+	}
+	q.SimFilter = filter
+
+	w := &chunkWriter{stream: stream}
+
+	var encoder export.Encoder
+	switch req.GetFormat() {
+	case pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, pb.ExportFormat_EXPORT_FORMAT_CSV:
+		if encoder, err = export.NewCSV(w, q.Entity.Columns()); err != nil {
+			return ErrInternal
+		}
+	case pb.ExportFormat_EXPORT_FORMAT_JSONL:
+		encoder = export.NewJSONL(w, q.Entity.Columns())
+	default:
+		return status.Errorf(codes.InvalidArgument, "Invalid format %v", req.GetFormat())
+	}
+
+	if err := gis.inventoryService.Export(stream.Context(), q, encoder.Write); err != nil {
+		if w.err != nil {
+			// the client is gone, the error of the stream is returned as is
+			return w.err
+		}
+		gis.logger.Error("Failed to export inventory", slog.String("entity", string(q.Entity)), "err", err)
+		return ErrInternal
+	}
+	if err := encoder.Flush(); err != nil {
+		if w.err != nil {
+			return w.err
+		}
+		return ErrInternal
+	}
+
+	return w.flush()
+}
+
+// chunkWriter buffers the export and sends it to the stream in chunks of exportChunkSize.
+type chunkWriter struct {
+	stream pb.Inventory_ExportInventoryServer
+	buf    bytes.Buffer
+	// err is the error of the stream, nothing is sent after it
+	err error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	w.buf.Write(p)
+	if w.buf.Len() >= exportChunkSize {
+		if err := w.flush(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// flush sends the buffered data as one chunk.
+func (w *chunkWriter) flush() error {
+	if w.err != nil || w.buf.Len() == 0 {
+		return w.err
+	}
+
+	w.err = w.stream.Send(&pb.ExportInventoryResponse{
+		Data: bytes.Clone(w.buf.Bytes()),
+	})
+	w.buf.Reset()
+	return w.err
+}
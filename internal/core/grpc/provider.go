@@ -2,24 +2,49 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"log/slog"
 	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
 	"simactive/internal/core"
+	"simactive/internal/infrastructure/repoerrors"
+	"simactive/internal/lib/mcc"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// maxProviderNameLength is the size of the provider name column
+const maxProviderNameLength = 16
+
+// providerMetadataPaths are the update mask paths of pb.ProviderMetadata.
+var providerMetadataPaths = []string{"country", "mcc", "mnc", "default_activation_period", "monthly_cost", "currency"}
+
 type ProviderService interface {
 	GetProviderList(ctx context.Context) (*core.List[*core.Provider], error)
+	Add(ctx context.Context, p *core.Provider) (int, error)
+	Rename(ctx context.Context, id int, name string) (*core.Provider, error)
+	Update(ctx context.Context, id int, update core.ProviderUpdate) (*core.Provider, error)
+	Delete(ctx context.Context, id, reassignTo int) (int, error)
+	Merge(ctx context.Context, targetId int, sourceIds []int) (*core.Provider, int, error)
+	Aliases(ctx context.Context, id int) ([]string, error)
+	AddAlias(ctx context.Context, id int, alias string) ([]string, error)
+	RemoveAlias(ctx context.Context, id int, alias string) ([]string, error)
 }
 
 type GRPCProviderService struct {
 	pb.UnimplementedProviderServer
 
+	logger          *slog.Logger
 	timeout         time.Duration
 	providerService ProviderService
 }
 
-func NewGRPCProviderService(ss ProviderService, timeout time.Duration) GRPCProviderService {
+func NewGRPCProviderService(logger *slog.Logger, ss ProviderService, timeout time.Duration) GRPCProviderService {
 	return GRPCProviderService{
+		logger:          logger,
 		providerService: ss,
 		timeout:         timeout,
 	}
@@ -42,10 +67,378 @@ func (gps GRPCProviderService) GetProviderList(ctx context.Context, _ *pb.Empty)
 
 	providers := make([]*pb.ProviderData, 0, len(*providerList))
 	for _, provider := range *providerList {
-		providers = append(providers, &pb.ProviderData{
-			Id:   int32(provider.Id()),
-			Name: provider.Name(),
-		})
+		providers = append(providers, providerToPB(provider))
 	}
 	return &pb.ProviderList{Providers: providers}, nil
 }
+
+// AddProvider adds a provider with the name and the metadata of the request.
+// The country of the provider is inferred from the mcc if it is not set.
+func (gps GRPCProviderService) AddProvider(ctx context.Context, req *pb.AddProviderRequest) (*pb.ProviderResponse, error) {
+	if err := validateProviderName(req.GetName()); err != nil {
+		return nil, err
+	}
+
+	update, err := providerUpdateFromPB(req.GetMetadata(), providerMetadataPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gps.timeout)
+	defer cancel()
+
+	provider := core.Provider{}.WithName(req.GetName())
+	update.Apply(&provider)
+	id, err := gps.providerService.Add(ctx, &provider)
+	if err != nil {
+		if st := providerMetadataError(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, repoerrors.ErrAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "provider with name %s already exists", req.GetName())
+		}
+		gps.logger.Error("Failed to add provider", slog.String("provider name", req.GetName()), "err", err)
+		return nil, ErrInternal
+	}
+	provider.SetId(id)
+
+	return &pb.ProviderResponse{Provider: providerToPB(&provider)}, nil
+}
+
+// RenameProvider changes the name of a provider.
+func (gps GRPCProviderService) RenameProvider(ctx context.Context, req *pb.RenameProviderRequest) (*pb.ProviderResponse, error) {
+	if req.GetID() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id, id must be greater than 0")
+	}
+	if err := validateProviderName(req.GetName()); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gps.timeout)
+	defer cancel()
+
+	provider, err := gps.providerService.Rename(ctx, int(req.GetID()), req.GetName())
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerrors.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "provider with id %d not found", req.GetID())
+		case errors.Is(err, repoerrors.ErrAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "provider with name %s already exists", req.GetName())
+		}
+		gps.logger.Error("Failed to rename provider", slog.Int("provider id", int(req.GetID())), "err", err)
+		return nil, ErrInternal
+	}
+
+	return &pb.ProviderResponse{Provider: providerToPB(provider)}, nil
+}
+
+// UpdateProvider changes the metadata of a provider that is listed in the update mask.
+// A provider without a country gets the country of its mcc, an mcc of another country than the provider is rejected.
+func (gps GRPCProviderService) UpdateProvider(ctx context.Context, req *pb.UpdateProviderRequest) (*pb.ProviderResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id, id must be greater than 0")
+	}
+
+	mask := req.GetUpdateMask()
+	if len(mask.GetPaths()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Update mask is required. Paths: %s", strings.Join(providerMetadataPaths, ", "))
+	}
+	if !mask.IsValid(&pb.ProviderMetadata{}) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid update mask %v. Paths: %s", mask.GetPaths(), strings.Join(providerMetadataPaths, ", "))
+	}
+	mask.Normalize()
+
+	update, err := providerUpdateFromPB(req.GetMetadata(), mask.GetPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gps.timeout)
+	defer cancel()
+
+	provider, err := gps.providerService.Update(ctx, int(req.GetId()), update)
+	if err != nil {
+		if st := providerMetadataError(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "provider with id %d not found", req.GetId())
+		}
+		gps.logger.Error("Failed to update provider", slog.Int("provider id", int(req.GetId())), "err", err)
+		return nil, ErrInternal
+	}
+
+	return &pb.ProviderResponse{Provider: providerToPB(provider)}, nil
+}
+
+// DeleteProvider deletes a provider.
+// A provider with sims is rejected with FailedPrecondition unless the sims are reassigned to another provider.
+func (gps GRPCProviderService) DeleteProvider(ctx context.Context, req *pb.DeleteProviderRequest) (*pb.DeleteProviderResponse, error) {
+	if req.GetID() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id, id must be greater than 0")
+	}
+	if req.GetReassignToID() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid reassign id, reassign id must not be negative")
+	}
+	if req.GetReassignToID() == req.GetID() {
+		return nil, status.Errorf(codes.InvalidArgument, "Sims can not be reassigned to the deleted provider")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gps.timeout)
+	defer cancel()
+
+	reassigned, err := gps.providerService.Delete(ctx, int(req.GetID()), int(req.GetReassignToID()))
+	if err != nil {
+		var inUse *core.ProviderInUseError
+		switch {
+		case errors.Is(err, repoerrors.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "provider with id %d not found", req.GetID())
+		case errors.Is(err, core.ErrReassignTargetNotFound):
+			return nil, status.Errorf(codes.NotFound, "provider with id %d to reassign sims to not found", req.GetReassignToID())
+		case errors.As(err, &inUse):
+			return nil, status.Errorf(codes.FailedPrecondition, "provider with id %d has %d sims, reassign them to another provider", req.GetID(), inUse.Sims)
+		}
+		gps.logger.Error("Failed to delete provider", slog.Int("provider id", int(req.GetID())), "err", err)
+		return nil, ErrInternal
+	}
+
+	return &pb.DeleteProviderResponse{
+		Id:             req.GetID(),
+		ReassignedSims: int32(reassigned),
+	}, nil
+}
+
+// MergeProviders moves the sims of the source providers to the target provider and deletes the sources.
+func (gps GRPCProviderService) MergeProviders(ctx context.Context, req *pb.MergeProvidersRequest) (*pb.MergeProvidersResponse, error) {
+	if req.GetTargetID() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid target id, target id must be greater than 0")
+	}
+	if len(req.GetSourceIDs()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Source ids are required")
+	}
+
+	sourceIds := make([]int, 0, len(req.GetSourceIDs()))
+	seen := make(map[int32]bool, len(req.GetSourceIDs()))
+	for _, id := range req.GetSourceIDs() {
+		if id <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid source id %d, source ids must be greater than 0", id)
+		}
+		if id == req.GetTargetID() {
+			return nil, status.Errorf(codes.InvalidArgument, "The target provider can not be a source")
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		sourceIds = append(sourceIds, int(id))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gps.timeout)
+	defer cancel()
+
+	target, moved, err := gps.providerService.Merge(ctx, int(req.GetTargetID()), sourceIds)
+	if err != nil {
+		var notFound *core.ProviderNotFoundError
+		var inUse *core.ProviderInUseError
+		switch {
+		case errors.As(err, &notFound):
+			return nil, status.Errorf(codes.NotFound, "provider with id %d not found", notFound.ID)
+		case errors.As(err, &inUse):
+			return nil, status.Errorf(codes.Aborted, "a source provider got %d sims during the merge, retry the merge", inUse.Sims)
+		}
+		gps.logger.Error("Failed to merge providers", slog.Int("target id", int(req.GetTargetID())), "err", err)
+		return nil, ErrInternal
+	}
+
+	aliases, err := gps.providerService.Aliases(ctx, target.Id())
+	if err != nil {
+		gps.logger.Error("Failed to get provider aliases", slog.Int("provider id", target.Id()), "err", err)
+		return nil, ErrInternal
+	}
+
+	return &pb.MergeProvidersResponse{
+		Provider:  providerToPB(target),
+		MovedSims: int32(moved),
+		Aliases:   aliases,
+	}, nil
+}
+
+// GetProviderAliases retrieves the aliases of a provider.
+func (gps GRPCProviderService) GetProviderAliases(ctx context.Context, req *pb.GetProviderAliasesRequest) (*pb.ProviderAliasesResponse, error) {
+	if req.GetProviderID() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid provider id, provider id must be greater than 0")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gps.timeout)
+	defer cancel()
+
+	aliases, err := gps.providerService.Aliases(ctx, int(req.GetProviderID()))
+	if err != nil {
+		return nil, gps.aliasError(req.GetProviderID(), "", err)
+	}
+	return &pb.ProviderAliasesResponse{ProviderID: req.GetProviderID(), Aliases: aliases}, nil
+}
+
+// AddProviderAlias adds an alias a provider is found by when sims are added.
+func (gps GRPCProviderService) AddProviderAlias(ctx context.Context, req *pb.ProviderAliasRequest) (*pb.ProviderAliasesResponse, error) {
+	if err := validateProviderAlias(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gps.timeout)
+	defer cancel()
+
+	aliases, err := gps.providerService.AddAlias(ctx, int(req.GetProviderID()), req.GetAlias())
+	if err != nil {
+		return nil, gps.aliasError(req.GetProviderID(), req.GetAlias(), err)
+	}
+	return &pb.ProviderAliasesResponse{ProviderID: req.GetProviderID(), Aliases: aliases}, nil
+}
+
+// RemoveProviderAlias removes an alias of a provider.
+func (gps GRPCProviderService) RemoveProviderAlias(ctx context.Context, req *pb.ProviderAliasRequest) (*pb.ProviderAliasesResponse, error) {
+	if err := validateProviderAlias(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gps.timeout)
+	defer cancel()
+
+	aliases, err := gps.providerService.RemoveAlias(ctx, int(req.GetProviderID()), req.GetAlias())
+	if err != nil {
+		return nil, gps.aliasError(req.GetProviderID(), req.GetAlias(), err)
+	}
+	return &pb.ProviderAliasesResponse{ProviderID: req.GetProviderID(), Aliases: aliases}, nil
+}
+
+// aliasError converts an error of the alias methods of the provider service to a status.
+func (gps GRPCProviderService) aliasError(providerId int32, alias string, err error) error {
+	var existsErr *repoerrors.AlreadyExistsError
+	switch {
+	case errors.As(err, &existsErr):
+		return status.Errorf(codes.AlreadyExists, "%s is already a name or an alias of the provider with id %d", alias, existsErr.ID)
+	case errors.Is(err, repoerrors.ErrNotFound) && alias != "":
+		return status.Errorf(codes.NotFound, "provider with id %d and alias %s not found", providerId, alias)
+	case errors.Is(err, repoerrors.ErrNotFound):
+		return status.Errorf(codes.NotFound, "provider with id %d not found", providerId)
+	}
+	gps.logger.Error("Failed to handle provider alias", slog.Int("provider id", int(providerId)), slog.String("alias", alias), "err", err)
+	return ErrInternal
+}
+
+// validateProviderAlias checks the provider id and that the alias fits the alias column and has letters or digits.
+func validateProviderAlias(req *pb.ProviderAliasRequest) error {
+	if req.GetProviderID() <= 0 {
+		return status.Errorf(codes.InvalidArgument, "Invalid provider id, provider id must be greater than 0")
+	}
+	if utf8.RuneCountInString(req.GetAlias()) > maxProviderNameLength {
+		return status.Errorf(codes.InvalidArgument, "provider alias cannot be longer than %d characters", maxProviderNameLength)
+	}
+	if core.ProviderNameKey(req.GetAlias()) == "" {
+		return status.Error(codes.InvalidArgument, "provider alias must have letters or digits")
+	}
+	return nil
+}
+
+// validateProviderName checks that the provider name fits the provider name column and has letters or digits.
+func validateProviderName(name string) error {
+	if core.ProviderNameKey(name) == "" {
+		return status.Error(codes.InvalidArgument, "provider name cannot be empty")
+	}
+	if utf8.RuneCountInString(name) > maxProviderNameLength {
+		return status.Errorf(codes.InvalidArgument, "provider name cannot be longer than %d characters", maxProviderNameLength)
+	}
+	return nil
+}
+
+// providerMetadataError converts the metadata errors of the provider service to the InvalidArgument status,
+// it returns nil for other errors.
+func providerMetadataError(err error) error {
+	var mismatch *core.MCCCountryError
+	switch {
+	case errors.Is(err, core.ErrCurrencyRequired):
+		return status.Errorf(codes.InvalidArgument, "Currency is required with monthly cost")
+	case errors.As(err, &mismatch):
+		return status.Errorf(codes.InvalidArgument, "Mcc %s belongs to %s, not to %s", mismatch.MCC, mismatch.MCCCountry, mismatch.Country)
+	}
+	return nil
+}
+
+// providerUpdateFromPB validates the metadata fields of the paths and converts them to a core.ProviderUpdate.
+// It returns the InvalidArgument status if the metadata is not valid.
+func providerUpdateFromPB(m *pb.ProviderMetadata, paths []string) (core.ProviderUpdate, error) {
+	var update core.ProviderUpdate
+	for _, path := range paths {
+		switch path {
+		case "country":
+			country := strings.ToUpper(m.GetCountry())
+			if country != "" && !isLetterCode(country, 2) {
+				return update, status.Errorf(codes.InvalidArgument, "Invalid country %q, country must be an ISO 3166-1 alpha-2 code", m.GetCountry())
+			}
+			update.Country = &country
+		case "mcc":
+			code := m.GetMcc()
+			if code != "" {
+				if _, err := mcc.Country(code); err != nil {
+					return update, status.Errorf(codes.InvalidArgument, "Invalid mcc. %v", err)
+				}
+			}
+			update.MCC = &code
+		case "mnc":
+			code := m.GetMnc()
+			if code != "" {
+				if err := mcc.ValidateMNC(code); err != nil {
+					return update, status.Errorf(codes.InvalidArgument, "Invalid mnc. %v", err)
+				}
+			}
+			update.MNC = &code
+		case "default_activation_period":
+			period := m.GetDefaultActivationPeriod()
+			if period < 0 {
+				return update, status.Errorf(codes.InvalidArgument, "Invalid default activation period, period must not be negative")
+			}
+			update.DefaultActivationPeriod = &period
+		case "monthly_cost":
+			cost := m.GetMonthlyCost()
+			if cost < 0 {
+				return update, status.Errorf(codes.InvalidArgument, "Invalid monthly cost, cost must not be negative")
+			}
+			update.MonthlyCost = &cost
+		case "currency":
+			currency := strings.ToUpper(m.GetCurrency())
+			if currency != "" && !isLetterCode(currency, 3) {
+				return update, status.Errorf(codes.InvalidArgument, "Invalid currency %q, currency must be an ISO 4217 code", m.GetCurrency())
+			}
+			update.Currency = &currency
+		}
+	}
+	// the fields are checked against each other with the stored metadata by the provider service
+	return update, nil
+}
+
+// isLetterCode reports whether the code consists of n letters A-Z.
+func isLetterCode(code string, n int) bool {
+	if len(code) != n {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// providerToPB converts a core.Provider to a pb.ProviderData.
+func providerToPB(p *core.Provider) *pb.ProviderData {
+	return &pb.ProviderData{
+		Id:                      int32(p.Id()),
+		Name:                    p.Name(),
+		DefaultActivationPeriod: p.DefaultActivationPeriod(),
+		Country:                 p.Country(),
+		Mcc:                     p.MCC(),
+		Mnc:                     p.MNC(),
+		MonthlyCost:             p.MonthlyCost(),
+		Currency:                p.Currency(),
+	}
+}
@@ -4,30 +4,59 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"net/url"
 	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
 	"simactive/internal/core"
 	"simactive/internal/infrastructure/repoerrors"
+	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// maxServiceNameLength, maxServiceURLLength and maxServiceCategoryLength are the sizes of the service columns
+	maxServiceNameLength     = 64
+	maxServiceURLLength      = 255
+	maxServiceCategoryLength = 32
+	// maxServiceCountries fits the codes and their separators into the countries column
+	maxServiceCountries = 85
+)
+
+// serviceUpdatePaths are the update mask paths of pb.UpdateServiceRequest.
+var serviceUpdatePaths = []string{"name", "url", "category", "verification_type", "countries", "enabled", "parent_id"}
+
+var verificationTypes = map[pb.VerificationType]core.VerificationType{
+	pb.VerificationType_VERIFICATION_TYPE_UNSPECIFIED: "",
+	pb.VerificationType_VERIFICATION_TYPE_SMS:         core.VerificationSMS,
+	pb.VerificationType_VERIFICATION_TYPE_CALL:        core.VerificationCall,
+	pb.VerificationType_VERIFICATION_TYPE_BOTH:        core.VerificationBoth,
+}
+
 type ServiceService interface {
 	Add(ctx context.Context, s *core.Service) (int, error)
 	Remove(ctx context.Context, id int) error
+	Restore(ctx context.Context, id int) (*core.Service, error)
 	GetServiceList(ctx context.Context) (*core.List[*core.Service], error)
+	ByID(ctx context.Context, id int) (*core.Service, error)
+	Update(ctx context.Context, id int, update core.ServiceUpdate) (*core.Service, error)
 }
 
 type GRPCServiceService struct {
 	pb.UnimplementedServiceServer
 
+	logger         *slog.Logger
 	timeout        time.Duration
 	serviceService ServiceService
 }
 
-func NewGRPCServiceService(ss ServiceService, timeout time.Duration) GRPCServiceService {
+func NewGRPCServiceService(logger *slog.Logger, ss ServiceService, timeout time.Duration) GRPCServiceService {
 	return GRPCServiceService{
+		logger:         logger,
 		serviceService: ss,
 		timeout:        timeout,
 	}
@@ -41,24 +70,34 @@ func NewGRPCServiceService(ss ServiceService, timeout time.Duration) GRPCService
 func (gss GRPCServiceService) AddService(ctx context.Context, req *pb.AddServiceRequest) (*pb.AddServiceResponse, error) {
 
 	name := req.GetName()
-	if name == "" {
-		return nil, status.Error(codes.InvalidArgument, "service name cannot be empty")
+	if err := validateServiceName(name); err != nil {
+		return nil, err
 	}
 
-	if len(name) > 64 {
-		return nil, status.Error(codes.InvalidArgument, "service name cannot be longer than 64 characters")
+	paths := []string{"url", "category", "verification_type", "countries", "parent_id"}
+	// a new service is enabled unless the request disables it
+	if req.GetMetadata().Enabled != nil {
+		paths = append(paths, "enabled")
+	}
+	update, err := serviceUpdateFromPB(name, req.GetMetadata(), paths)
+	if err != nil {
+		return nil, err
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, gss.timeout)
 	defer cancel()
 
-	service := core.Service{}.WithName(name)
+	service := core.NewService(0, name)
+	update.Apply(&service)
 
 	id, err := gss.serviceService.Add(ctx, &service)
 	if err != nil {
-		if err == repoerrors.ErrAlreadyExists {
+		if errors.Is(err, repoerrors.ErrAlreadyExists) {
 			return nil, status.Error(codes.AlreadyExists, fmt.Sprintf("service with name %s already exists", name))
 		}
+		if st := serviceParentStatus(err, req.GetMetadata().GetParentId()); st != nil {
+			return nil, st
+		}
 		return nil, err
 	}
 
@@ -67,7 +106,52 @@ func (gss GRPCServiceService) AddService(ctx context.Context, req *pb.AddService
 	}, nil
 }
 
-// DeleteService deletes a service.
+// UpdateService changes the name and the metadata of a service that are listed in the update mask.
+// Disabling a service keeps it with its used records, but the sims are not used for it anymore.
+func (gss GRPCServiceService) UpdateService(ctx context.Context, req *pb.UpdateServiceRequest) (*pb.ServiceResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id, id must be greater than 0")
+	}
+
+	mask := req.GetUpdateMask()
+	if len(mask.GetPaths()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Update mask is required. Paths: %s", strings.Join(serviceUpdatePaths, ", "))
+	}
+	mask.Normalize()
+	for _, path := range mask.GetPaths() {
+		if !slices.Contains(serviceUpdatePaths, path) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid update mask %v. Paths: %s", mask.GetPaths(), strings.Join(serviceUpdatePaths, ", "))
+		}
+	}
+
+	update, err := serviceUpdateFromPB(req.GetName(), req.GetMetadata(), mask.GetPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gss.timeout)
+	defer cancel()
+
+	service, err := gss.serviceService.Update(ctx, int(req.GetId()), update)
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerrors.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "service with id %d not found", req.GetId())
+		case errors.Is(err, repoerrors.ErrAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "service with name %s already exists", req.GetName())
+		}
+		if st := serviceParentStatus(err, req.GetMetadata().GetParentId()); st != nil {
+			return nil, st
+		}
+		gss.logger.Error("Failed to update service", slog.Int("service id", int(req.GetId())), "err", err)
+		return nil, ErrInternal
+	}
+
+	return &pb.ServiceResponse{Service: serviceToPB(service)}, nil
+}
+
+// DeleteService marks a service as deleted, it keeps its used records until it is purged.
+// A service with child services can not be deleted.
 // ctx context.Context, req *pb.DeleteServiceRequest
 // *pb.DeleteServiceResponse, error
 func (gss GRPCServiceService) DeleteService(ctx context.Context, req *pb.DeleteServiceRequest) (*pb.DeleteServiceResponse, error) {
@@ -89,6 +173,9 @@ func (gss GRPCServiceService) DeleteService(ctx context.Context, req *pb.DeleteS
 		if errors.Is(err, repoerrors.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "service with id %d not found", id)
 		}
+		if errors.Is(err, repoerrors.ErrInUse) {
+			return nil, status.Errorf(codes.FailedPrecondition, "service with id %d has child services, delete them or move them to another group first", id)
+		}
 		return nil, err
 	}
 
@@ -97,6 +184,31 @@ func (gss GRPCServiceService) DeleteService(ctx context.Context, req *pb.DeleteS
 	}, nil
 }
 
+// RestoreService restores a deleted service that is not purged yet.
+// A service whose parent is deleted is restored as a top level service.
+func (gss GRPCServiceService) RestoreService(ctx context.Context, req *pb.RestoreServiceRequest) (*pb.ServiceResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id, id must be greater than 0")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gss.timeout)
+	defer cancel()
+
+	service, err := gss.serviceService.Restore(ctx, int(req.GetId()))
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerrors.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "deleted service with id %d not found", req.GetId())
+		case errors.Is(err, repoerrors.ErrAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "name of service with id %d is taken by another service", req.GetId())
+		}
+		gss.logger.Error("Failed to restore service", slog.Int("service id", int(req.GetId())), "err", err)
+		return nil, ErrInternal
+	}
+
+	return &pb.ServiceResponse{Service: serviceToPB(service)}, nil
+}
+
 // GetServiceList retrieves a list of services.
 //
 // ctx: the context for the request
@@ -125,13 +237,111 @@ func (gss GRPCServiceService) GetServiceList(ctx context.Context, req *pb.Empty)
 	}, nil
 }
 
+// validateServiceName returns the InvalidArgument status if the service name is empty or too long.
+func validateServiceName(name string) error {
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "service name cannot be empty")
+	}
+	if utf8.RuneCountInString(name) > maxServiceNameLength {
+		return status.Errorf(codes.InvalidArgument, "service name cannot be longer than %d characters", maxServiceNameLength)
+	}
+	return nil
+}
+
+// serviceUpdateFromPB validates the name and the metadata fields of the paths and converts them to a core.ServiceUpdate.
+// It returns the InvalidArgument status if a field is not valid.
+func serviceUpdateFromPB(name string, m *pb.ServiceMetadata, paths []string) (core.ServiceUpdate, error) {
+	var update core.ServiceUpdate
+	for _, path := range paths {
+		switch path {
+		case "name":
+			if err := validateServiceName(name); err != nil {
+				return update, err
+			}
+			update.Name = &name
+		case "url":
+			address := strings.TrimSpace(m.GetUrl())
+			if address != "" {
+				u, err := url.Parse(address)
+				if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+					return update, status.Errorf(codes.InvalidArgument, "Invalid url %q, url must be an absolute http or https url", m.GetUrl())
+				}
+				if len(address) > maxServiceURLLength {
+					return update, status.Errorf(codes.InvalidArgument, "Invalid url, url cannot be longer than %d characters", maxServiceURLLength)
+				}
+			}
+			update.URL = &address
+		case "category":
+			category := strings.ToLower(strings.TrimSpace(m.GetCategory()))
+			if utf8.RuneCountInString(category) > maxServiceCategoryLength {
+				return update, status.Errorf(codes.InvalidArgument, "Invalid category, category cannot be longer than %d characters", maxServiceCategoryLength)
+			}
+			update.Category = &category
+		case "verification_type":
+			verification, ok := verificationTypes[m.GetVerificationType()]
+			if !ok {
+				return update, status.Errorf(codes.InvalidArgument, "Invalid verification type %v", m.GetVerificationType())
+			}
+			update.VerificationType = &verification
+		case "countries":
+			if len(m.GetCountries()) > maxServiceCountries {
+				return update, status.Errorf(codes.InvalidArgument, "Too many countries, a service can have up to %d countries", maxServiceCountries)
+			}
+			countries := make([]string, 0, len(m.GetCountries()))
+			for _, c := range m.GetCountries() {
+				country := strings.ToUpper(c)
+				if !isLetterCode(country, 2) {
+					return update, status.Errorf(codes.InvalidArgument, "Invalid country %q, country must be an ISO 3166-1 alpha-2 code", c)
+				}
+				if !slices.Contains(countries, country) {
+					countries = append(countries, country)
+				}
+			}
+			slices.Sort(countries)
+			update.Countries = &countries
+		case "enabled":
+			enabled := m.GetEnabled()
+			update.Enabled = &enabled
+		case "parent_id":
+			parentId := int(m.GetParentId())
+			if parentId < 0 {
+				return update, status.Errorf(codes.InvalidArgument, "Invalid parent id, parent id must not be negative")
+			}
+			update.ParentID = &parentId
+		}
+	}
+	return update, nil
+}
+
+// serviceParentStatus converts an error of an invalid parent of a service to its status, nil for other errors.
+func serviceParentStatus(err error, parentId int32) error {
+	switch {
+	case errors.Is(err, core.ErrServiceParentNotFound):
+		return status.Errorf(codes.NotFound, "parent service with id %d not found", parentId)
+	case errors.Is(err, core.ErrNestedServiceGroup):
+		return status.Errorf(codes.FailedPrecondition, "service can not get parent with id %d, service groups have one level", parentId)
+	}
+	return nil
+}
+
 // serviceToPB converts a core.Service to a pb.ServiceData.
 //
 // s *core.Service - input core.Service
 // *pb.ServiceData - returned pb.ServiceData
 func serviceToPB(s *core.Service) *pb.ServiceData {
-	return &pb.ServiceData{
-		Id:   int32(s.Id()),
-		Name: s.Name(),
+	data := &pb.ServiceData{
+		Id:        int32(s.Id()),
+		Name:      s.Name(),
+		Url:       s.URL(),
+		Category:  s.Category(),
+		Countries: s.Countries(),
+		Enabled:   s.Enabled(),
+		ParentId:  int32(s.ParentID()),
+	}
+	for pbType, verification := range verificationTypes {
+		if verification == s.VerificationType() {
+			data.VerificationType = pbType
+		}
 	}
+	return data
 }
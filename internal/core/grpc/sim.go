@@ -2,26 +2,50 @@ package grpc
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
 	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
 	"simactive/internal/core"
 	"simactive/internal/infrastructure/repoerrors"
+	"simactive/internal/lib/phone"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// maxReasonLength is the length of the sim.state_reason column.
+const maxReasonLength = 255
+
+const (
+	defaultSimPageSize = 50
+	maxSimPageSize     = 500
+)
+
 type SimService interface {
 	Add(ctx context.Context, s *core.Sim) (int, error)
+	ImportSims(ctx context.Context, sims []*core.Sim, allOrNothing bool) ([]core.SimImportResult, bool, error)
 	Remove(ctx context.Context, id int) error
+	Restore(ctx context.Context, id int) (*core.Sim, error)
+	UpdateSim(ctx context.Context, id int, update core.SimUpdate) (*core.Sim, error)
 	GetSimList(ctx context.Context) (*core.List[*core.Sim], error)
-	ActivateSim(ctx context.Context, id int) error
-	BlockSim(ctx context.Context, id int) error
+	ListSims(ctx context.Context, q core.SimQuery) ([]*core.Sim, *core.SimCursor, error)
+	GetSim(ctx context.Context, id int) (*core.Sim, error)
+	GetSimByNumber(ctx context.Context, number string) (*core.Sim, error)
+	ActivateSim(ctx context.Context, id int, until int64, period time.Duration, reason string) (int64, error)
+	BlockSim(ctx context.Context, id int, reason string) (*core.Sim, error)
+	UnblockSim(ctx context.Context, id int, reason string) (*core.Sim, error)
+	DeactivateSim(ctx context.Context, id int, reason string) (*core.Sim, error)
+	RetireSim(ctx context.Context, id int, reason string) (*core.Sim, error)
 	GetUsedServiceList(ctx context.Context, id int) (core.List[*core.Used], error)
+	GetFreeServiceList(ctx context.Context, number string) (core.List[*core.Service], error)
+	GetActivationHistory(ctx context.Context, id int) (core.List[*core.Activation], error)
 }
 
 type GRPCSimService struct {
@@ -29,43 +53,38 @@ type GRPCSimService struct {
 
 	timeout    time.Duration
 	simService SimService
-	logger     *slog.Logger
+	// serviceService is used to resolve service names of used services
+	serviceService ServiceService
+	logger         *slog.Logger
 }
 
-func NewGRPCSimService(logger *slog.Logger, ss SimService, timeout time.Duration) GRPCSimService {
+func NewGRPCSimService(logger *slog.Logger, ss SimService, srv ServiceService, timeout time.Duration) GRPCSimService {
 	return GRPCSimService{
-		simService: ss,
-		timeout:    timeout,
-		logger:     logger,
+		simService:     ss,
+		serviceService: srv,
+		timeout:        timeout,
+		logger:         logger,
 	}
 }
 func (gs GRPCSimService) AddSim(ctx context.Context, req *pb.AddSimRequest) (*pb.AddSimResponse, error) {
 
 	gs.logger.Info("AddSim request", slog.Any("req", req))
 
-	number := req.SimData.Number
-
-	// Validates the length of phone number. Length of phone number should be in range from 11 to 15.
-	// And all the digits in the phone number should be in range from 0 to 9
-	// Example: 1 999 888 77 66
-	if !validatePhoneNumber(number) {
-		return nil, status.Errorf(codes.InvalidArgument, "Bad phone number. Please use correct phone number. Example: 1 999 888 77 66")
-	}
-
-	if req.SimData.ProviderName == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "Provider name is required. Example: Vodafone, Beeline, Tele2, etc.")
+	sim, err := simFromPB(req.GetSimData())
+	if err != nil {
+		return nil, err
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, gs.timeout)
 	defer cancel()
 
-	provider := core.Provider{}
-	provider.SetName(req.SimData.GetProviderName())
-	sim := core.NewSim(0, req.SimData.Number, &provider, req.SimData.IsActivated, req.SimData.ActivateUntil, req.SimData.IsBlocked)
 	id, err := gs.simService.Add(ctx, &sim)
 	if err != nil {
 		if errors.Is(err, repoerrors.ErrAlreadyExists) {
-			return nil, status.Errorf(codes.AlreadyExists, "sim card with number %s already exists", sim.Number())
+			return nil, simAlreadyExists(sim.Number(), err)
+		}
+		if errors.Is(err, core.ErrProviderNotInferred) {
+			return nil, providerNotInferred(sim.Country())
 		}
 
 		gs.logger.Error("Failed to add sim card", slog.Any("sim", sim), "err", err)
@@ -77,19 +96,53 @@ func (gs GRPCSimService) AddSim(ctx context.Context, req *pb.AddSimRequest) (*pb
 	}, nil
 }
 
-// validatePhoneNumber checks if the phone number is within a valid length range.
-//
-// It takes a string parameter 'number' representing the phone number.
-// It returns a boolean value indicating if the phone number is valid.
-func validatePhoneNumber(number string) bool {
-	if len(number) < 8 || len(number) > 15 {
-		return false
+// simFromPB validates the sim data and converts it to a core.Sim.
+// It returns the InvalidArgument status if the data is not valid.
+// An empty provider name is left to the sim service, it infers the provider from the country of the number
+// if the country has exactly one provider.
+func simFromPB(data *pb.AddSimData) (core.Sim, error) {
+	number, err := parsePhoneNumber(data.GetNumber())
+	if err != nil {
+		return core.Sim{}, err
 	}
 
-	_, err := strconv.Atoi(number)
-	return err == nil
+	provider := core.Provider{}
+	provider.SetName(data.GetProviderName())
+	state := core.SimStateFromFlags(data.GetIsActivated(), data.GetIsBlocked())
+	sim := core.NewSim(0, number.E164(), &provider, state, data.GetActivateUntil())
+	sim.SetCountry(number.Country)
+	return sim, nil
+}
+
+// providerNotInferred returns the InvalidArgument status of a sim without a provider name
+// whose provider can not be inferred from the number.
+func providerNotInferred(country string) error {
+	return status.Errorf(codes.InvalidArgument, "Provider name is required. The provider can not be inferred from the number, %s must have exactly one provider", country)
 }
 
+// simAlreadyExists returns the AlreadyExists status of a duplicate sim number.
+// The status message holds the id of the existing sim if it is known.
+func simAlreadyExists(number string, err error) error {
+	var existsErr *repoerrors.AlreadyExistsError
+	if errors.As(err, &existsErr) {
+		return status.Errorf(codes.AlreadyExists, "sim card with number %s already exists. Sim id is `%d`", number, existsErr.ID)
+	}
+	return status.Errorf(codes.AlreadyExists, "sim card with number %s already exists", number)
+}
+
+// parsePhoneNumber parses the phone number in one of the common international formats.
+// It returns the InvalidArgument status if the number is not valid.
+// Example: +7 999 888-77-66
+func parsePhoneNumber(number string) (phone.Number, error) {
+	n, err := phone.Parse(number)
+	if err != nil {
+		return phone.Number{}, status.Errorf(codes.InvalidArgument, "Bad phone number. %v. Please use correct phone number. Example: +7 999 888-77-66", err)
+	}
+	return n, nil
+}
+
+// DeleteSim marks a sim as deleted. It is skipped by the lists and lookups,
+// but keeps its used services and activations until it is purged.
 func (gs GRPCSimService) DeleteSim(ctx context.Context, req *pb.DeleteSimRequest) (*pb.DeleteSimResponse, error) {
 
 	if req.GetId() == 0 {
@@ -109,6 +162,100 @@ func (gs GRPCSimService) DeleteSim(ctx context.Context, req *pb.DeleteSimRequest
 		Id: req.GetId(),
 	}, nil
 }
+
+// RestoreSim restores a deleted sim that is not purged yet.
+func (gs GRPCSimService) RestoreSim(ctx context.Context, req *pb.RestoreSimRequest) (*pb.GetSimResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id, id must be greater than 0")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gs.timeout)
+	defer cancel()
+
+	sim, err := gs.simService.Restore(ctx, int(req.GetId()))
+	if err != nil {
+		var existsErr *repoerrors.AlreadyExistsError
+		switch {
+		case errors.Is(err, repoerrors.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "deleted sim card with id %d not found", req.GetId())
+		case errors.As(err, &existsErr):
+			return nil, status.Errorf(codes.AlreadyExists, "number of sim card with id %d is taken by sim card with id %d", req.GetId(), existsErr.ID)
+		case errors.Is(err, repoerrors.ErrAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "number of sim card with id %d is taken by another sim card", req.GetId())
+		}
+
+		gs.logger.Error("Failed to restore sim card", slog.Int("sim id", int(req.GetId())), "err", err)
+		return nil, ErrInternal
+	}
+
+	return &pb.GetSimResponse{
+		Sim: simToPB(sim),
+	}, nil
+}
+
+// UpdateSim changes the number, provider or activate until of a sim.
+// Only the fields listed in the update mask are changed, an unknown provider is added.
+func (gs GRPCSimService) UpdateSim(ctx context.Context, req *pb.UpdateSimRequest) (*pb.UpdateSimResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id, id must be greater than 0")
+	}
+
+	mask := req.GetUpdateMask()
+	if len(mask.GetPaths()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Update mask is required. Paths: number, provider_name, activate_until")
+	}
+	if !mask.IsValid(&pb.UpdateSimData{}) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid update mask %v. Paths: number, provider_name, activate_until", mask.GetPaths())
+	}
+	mask.Normalize()
+
+	data := req.GetSim()
+	var update core.SimUpdate
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "number":
+			number, err := parsePhoneNumber(data.GetNumber())
+			if err != nil {
+				return nil, err
+			}
+			e164 := number.E164()
+			update.Number = &e164
+			update.Country = &number.Country
+		case "provider_name":
+			providerName := data.GetProviderName()
+			if providerName == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "Provider name is required. Example: Vodafone, Beeline, Tele2, etc.")
+			}
+			update.ProviderName = &providerName
+		case "activate_until":
+			activateUntil := data.GetActivateUntil()
+			if activateUntil < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "Invalid activate until, activate until must not be negative")
+			}
+			update.ActivateUntil = &activateUntil
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gs.timeout)
+	defer cancel()
+
+	sim, err := gs.simService.UpdateSim(ctx, int(req.GetId()), update)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "sim card with id %d not found", req.GetId())
+		}
+		if errors.Is(err, repoerrors.ErrAlreadyExists) && update.Number != nil {
+			return nil, simAlreadyExists(*update.Number, err)
+		}
+
+		gs.logger.Error("Failed to update sim card", slog.Int("sim id", int(req.GetId())), "err", err)
+		return nil, ErrInternal
+	}
+
+	return &pb.UpdateSimResponse{
+		Sim: simToPB(sim),
+	}, nil
+}
 func (gs GRPCSimService) GetSimList(ctx context.Context, req *pb.Empty) (*pb.SimList, error) {
 	ctx, cancel := context.WithTimeout(ctx, gs.timeout)
 	defer cancel()
@@ -125,66 +272,479 @@ func (gs GRPCSimService) GetSimList(ctx context.Context, req *pb.Empty) (*pb.Sim
 	var response pb.SimList
 	response.SimList = make([]*pb.SimData, 0, len(*list))
 	for _, sim := range *list {
-		p := sim.Provider()
-		response.SimList = append(response.SimList, &pb.SimData{
-			ID:     int32(sim.Id()),
-			Number: sim.Number(),
-			Provider: &pb.ProviderData{
-				Id:   int32(p.Id()),
-				Name: p.Name(),
-			},
-			IsActivated:   sim.IsActivated(),
-			IsBlocked:     sim.IsBlocked(),
-			ActivateUntil: sim.ActivateUntil(),
-		})
+		response.SimList = append(response.SimList, simToPB(sim))
+	}
+
+	return &response, nil
+}
+
+// ListSims retrieves a filtered and sorted page of sims.
+// The next page is requested with the next page token and the same filter and sort.
+func (gs GRPCSimService) ListSims(ctx context.Context, req *pb.ListSimsRequest) (*pb.ListSimsResponse, error) {
+	q, err := simQueryFromPB(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gs.timeout)
+	defer cancel()
+
+	sims, cursor, err := gs.simService.ListSims(ctx, q)
+	if err != nil {
+		gs.logger.Error("Failed to list sim cards", slog.Any("req", req), "err", err)
+		return nil, ErrInternal
+	}
+
+	response := pb.ListSimsResponse{
+		Sims: make([]*pb.SimData, 0, len(sims)),
+	}
+	for _, sim := range sims {
+		response.Sims = append(response.Sims, simToPB(sim))
+	}
+	if cursor != nil {
+		response.NextPageToken = encodeSimPageToken(q, *cursor)
 	}
 
 	return &response, nil
 }
+
+// simQueryFromPB validates the request and converts it to a core.SimQuery.
+func simQueryFromPB(req *pb.ListSimsRequest) (core.SimQuery, error) {
+	var q core.SimQuery
+
+	switch req.GetSortBy() {
+	case pb.SimSortKey_SIM_SORT_KEY_ID:
+		q.SortBy = core.SimSortByID
+	case pb.SimSortKey_SIM_SORT_KEY_NUMBER:
+		q.SortBy = core.SimSortByNumber
+	case pb.SimSortKey_SIM_SORT_KEY_ACTIVATE_UNTIL:
+		q.SortBy = core.SimSortByActivateUntil
+	default:
+		return q, status.Errorf(codes.InvalidArgument, "Invalid sort key %v", req.GetSortBy())
+	}
+	q.Descending = req.GetDescending()
+
+	switch size := req.GetPageSize(); {
+	case size < 0:
+		return q, status.Errorf(codes.InvalidArgument, "Invalid page size, page size must not be negative")
+	case size == 0:
+		q.Limit = defaultSimPageSize
+	case size > maxSimPageSize:
+		q.Limit = maxSimPageSize
+	default:
+		q.Limit = int(size)
+	}
+
+	filter, err := simFilterFromPB(req.GetFilter())
+	if err != nil {
+		return q, err
+	}
+	q.Filter = filter
+
+	if token := req.GetPageToken(); token != "" {
+		cursor, err := decodeSimPageToken(q, token)
+		if err != nil {
+			return q, status.Errorf(codes.InvalidArgument, "Invalid page token")
+		}
+		q.After = &cursor
+	}
+
+	return q, nil
+}
+
+// simFilterFromPB converts and validates a pb.SimFilter, a nil filter selects all sims.
+func simFilterFromPB(f *pb.SimFilter) (core.SimFilter, error) {
+	if f == nil {
+		return core.SimFilter{}, nil
+	}
+	if f.GetProviderId() < 0 {
+		return core.SimFilter{}, status.Errorf(codes.InvalidArgument, "Invalid provider id, provider id must not be negative")
+	}
+	if f.GetActivateUntilBefore() < 0 || f.GetActivateUntilAfter() < 0 {
+		return core.SimFilter{}, status.Errorf(codes.InvalidArgument, "Invalid activate until, activate until must not be negative")
+	}
+	// numbers are stored in the E.164 format, so the prefix always starts with +
+	var numberPrefix string
+	if prefix := strings.TrimPrefix(f.GetNumberPrefix(), "+"); prefix != "" {
+		if _, err := strconv.ParseUint(prefix, 10, 64); err != nil || len(prefix) > 15 {
+			return core.SimFilter{}, status.Errorf(codes.InvalidArgument, "Invalid number prefix, number prefix must contain up to 15 digits")
+		}
+		numberPrefix = "+" + prefix
+	}
+
+	filter := core.SimFilter{
+		ProviderID:          int(f.GetProviderId()),
+		ProviderName:        f.GetProviderName(),
+		IsActivated:         f.IsActivated,
+		IsBlocked:           f.IsBlocked,
+		ActivateUntilBefore: f.GetActivateUntilBefore(),
+		ActivateUntilAfter:  f.GetActivateUntilAfter(),
+		NumberPrefix:        numberPrefix,
+		Country:             strings.ToUpper(f.GetCountry()),
+	}
+	for _, state := range f.GetStates() {
+		s, ok := simStateFromPB(state)
+		if !ok {
+			return core.SimFilter{}, status.Errorf(codes.InvalidArgument, "Invalid sim state %v", state)
+		}
+		filter.States = append(filter.States, s)
+	}
+
+	return filter, nil
+}
+
+// simPageToken is the content of a ListSims page token.
+// The sort of the query is kept to reject tokens of a differently sorted listing.
+type simPageToken struct {
+	SortBy        core.SimSortKey `json:"s"`
+	Descending    bool            `json:"d,omitempty"`
+	ID            int             `json:"i"`
+	Number        string          `json:"n,omitempty"`
+	ActivateUntil int64           `json:"a,omitempty"`
+}
+
+func encodeSimPageToken(q core.SimQuery, c core.SimCursor) string {
+	b, _ := json.Marshal(simPageToken{
+		SortBy:        q.SortBy,
+		Descending:    q.Descending,
+		ID:            c.ID,
+		Number:        c.Number,
+		ActivateUntil: c.ActivateUntil,
+	})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeSimPageToken(q core.SimQuery, token string) (core.SimCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return core.SimCursor{}, err
+	}
+
+	var t simPageToken
+	if err := json.Unmarshal(b, &t); err != nil {
+		return core.SimCursor{}, err
+	}
+	if t.SortBy != q.SortBy || t.Descending != q.Descending {
+		return core.SimCursor{}, fmt.Errorf("page token of another sort")
+	}
+
+	return core.SimCursor{
+		ID:            t.ID,
+		Number:        t.Number,
+		ActivateUntil: t.ActivateUntil,
+	}, nil
+}
+
+// GetSim retrieves a single sim by its id.
+func (gs GRPCSimService) GetSim(ctx context.Context, req *pb.GetSimRequest) (*pb.GetSimResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id, id must be greater than 0")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gs.timeout)
+	defer cancel()
+
+	sim, err := gs.simService.GetSim(ctx, int(req.GetId()))
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "sim card with id %d not found", req.GetId())
+		}
+
+		gs.logger.Error("Failed to get sim card", slog.Int("sim id", int(req.GetId())), "err", err)
+		return nil, ErrInternal
+	}
+
+	return &pb.GetSimResponse{
+		Sim: simToPB(sim),
+	}, nil
+}
+
+// GetSimByNumber retrieves a single sim by its phone number.
+func (gs GRPCSimService) GetSimByNumber(ctx context.Context, req *pb.GetSimByNumberRequest) (*pb.GetSimResponse, error) {
+	parsed, err := parsePhoneNumber(req.GetNumber())
+	if err != nil {
+		return nil, err
+	}
+	number := parsed.E164()
+
+	ctx, cancel := context.WithTimeout(ctx, gs.timeout)
+	defer cancel()
+
+	sim, err := gs.simService.GetSimByNumber(ctx, number)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "sim card with number %s not found", number)
+		}
+
+		gs.logger.Error("Failed to get sim card", slog.String("number", number), "err", err)
+		return nil, ErrInternal
+	}
+
+	return &pb.GetSimResponse{
+		Sim: simToPB(sim),
+	}, nil
+}
+
+// simToPB converts a core.Sim to a pb.SimData.
+//
+// s *core.Sim - input core.Sim
+// *pb.SimData - returned pb.SimData
+func simToPB(s *core.Sim) *pb.SimData {
+	p := s.Provider()
+	return &pb.SimData{
+		ID:            int32(s.Id()),
+		Number:        s.Number(),
+		Country:       s.Country(),
+		Provider:      providerToPB(p),
+		IsActivated:   s.IsActivated(),
+		IsBlocked:     s.IsBlocked(),
+		ActivateUntil: s.ActivateUntil(),
+		State:         simStateToPB(s.State()),
+		StateReason:   s.StateReason(),
+	}
+}
+
+// simStateToPB converts a core.SimState to a pb.SimState.
+func simStateToPB(state core.SimState) pb.SimState {
+	switch state {
+	case core.SimStateNew:
+		return pb.SimState_SIM_STATE_NEW
+	case core.SimStateActive:
+		return pb.SimState_SIM_STATE_ACTIVE
+	case core.SimStateExpired:
+		return pb.SimState_SIM_STATE_EXPIRED
+	case core.SimStateBlocked:
+		return pb.SimState_SIM_STATE_BLOCKED
+	case core.SimStateRetired:
+		return pb.SimState_SIM_STATE_RETIRED
+	default:
+		return pb.SimState_SIM_STATE_UNSPECIFIED
+	}
+}
+
+// simStateFromPB converts a pb.SimState to a core.SimState, reports false for an unspecified state.
+func simStateFromPB(state pb.SimState) (core.SimState, bool) {
+	switch state {
+	case pb.SimState_SIM_STATE_NEW:
+		return core.SimStateNew, true
+	case pb.SimState_SIM_STATE_ACTIVE:
+		return core.SimStateActive, true
+	case pb.SimState_SIM_STATE_EXPIRED:
+		return core.SimStateExpired, true
+	case pb.SimState_SIM_STATE_BLOCKED:
+		return core.SimStateBlocked, true
+	case pb.SimState_SIM_STATE_RETIRED:
+		return core.SimStateRetired, true
+	default:
+		return "", false
+	}
+}
 func (gs GRPCSimService) ActivateSim(ctx context.Context, req *pb.ActivateSimRequest) (*pb.ActivateSimResponse, error) {
 
 	if req.GetId() == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid id, id must be greater than 0")
 	}
 
+	if len(req.GetReason()) > maxReasonLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Reason cannot be longer than %d characters", maxReasonLength)
+	}
+
+	var (
+		until  int64
+		period time.Duration
+	)
+	switch p := req.GetPeriod().(type) {
+	case *pb.ActivateSimRequest_ActivateUntil:
+		if p.ActivateUntil <= time.Now().Unix() {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid activate until, activate until must be in the future")
+		}
+		until = p.ActivateUntil
+	case *pb.ActivateSimRequest_Duration:
+		if p.Duration <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid duration, duration must be greater than 0")
+		}
+		period = time.Duration(p.Duration) * time.Second
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, gs.timeout)
 	defer cancel()
 
-	if err := gs.simService.ActivateSim(ctx, int(req.Id)); err != nil {
-		fmt.Println("ERROR FROM UPDATE", err)
+	activateUntil, err := gs.simService.ActivateSim(ctx, int(req.Id), until, period, req.GetReason())
+	if err != nil {
 		if errors.Is(err, repoerrors.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "sim card with id %d not found", req.Id)
 		}
+		if errors.Is(err, core.ErrIllegalTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 
+		gs.logger.Error("Failed to activate sim card", slog.Int("sim id", int(req.Id)), "err", err)
 		return nil, ErrInternal
 	}
 
 	return &pb.ActivateSimResponse{
-		IsActivated: true,
+		IsActivated:   true,
+		ActivateUntil: activateUntil,
 	}, nil
 }
-func (gs GRPCSimService) SetSimBlocked(ctx context.Context, req *pb.SSBRequest) (*pb.SSBResponse, error) {
-	if req.GetId() == 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid id, id must be greater than 0")
+
+// GetActivationHistory retrieves all activations and extensions of a sim, oldest first.
+func (gs GRPCSimService) GetActivationHistory(ctx context.Context, req *pb.GAHRequest) (*pb.GAHResponse, error) {
+	if req.GetSimId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid sim id, sim id must be greater than 0")
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, gs.timeout)
 	defer cancel()
 
-	if err := gs.simService.BlockSim(ctx, int(req.Id)); err != nil {
+	list, err := gs.simService.GetActivationHistory(ctx, int(req.GetSimId()))
+	if err != nil {
 		if errors.Is(err, repoerrors.ErrNotFound) {
-			return nil, status.Errorf(codes.NotFound, "sim card with id %d not found", req.Id)
+			return nil, status.Errorf(codes.NotFound, "sim card with id %d not found", req.GetSimId())
 		}
+
+		gs.logger.Error("Failed to get activation history", slog.Int("sim id", int(req.GetSimId())), "err", err)
 		return nil, ErrInternal
 	}
 
+	response := pb.GAHResponse{
+		Activations: make([]*pb.ActivationData, 0, len(list)),
+	}
+	for _, a := range list {
+		kind := pb.ActivationKind_ACTIVATION_KIND_ACTIVATE
+		if a.Kind() == core.ActivationKindExtend {
+			kind = pb.ActivationKind_ACTIVATION_KIND_EXTEND
+		}
+
+		response.Activations = append(response.Activations, &pb.ActivationData{
+			Id:            int32(a.Id()),
+			SimId:         int32(a.SimID()),
+			Kind:          kind,
+			ActivatedAt:   a.ActivatedAt(),
+			PreviousUntil: a.PreviousUntil(),
+			ActivateUntil: a.ActivateUntil(),
+		})
+	}
+	slices.SortFunc(response.Activations, func(a, b *pb.ActivationData) int {
+		return int(a.Id - b.Id)
+	})
+
+	return &response, nil
+}
+func (gs GRPCSimService) SetSimBlocked(ctx context.Context, req *pb.SSBRequest) (*pb.SSBResponse, error) {
+	if req.GetId() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id, id must be greater than 0")
+	}
+
+	if len(req.GetReason()) > maxReasonLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Reason cannot be longer than %d characters", maxReasonLength)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gs.timeout)
+	defer cancel()
+
+	if _, err := gs.simService.BlockSim(ctx, int(req.Id), req.GetReason()); err != nil {
+		return nil, gs.transitionError(err, int(req.Id))
+	}
+
 	return &pb.SSBResponse{
 		IsBlocked: true,
 	}, nil
 }
 
+// UnblockSim unblocks a blocked sim. The sim gets active if its activation period has not ended yet, otherwise expired.
+func (gs GRPCSimService) UnblockSim(ctx context.Context, req *pb.SimTransitionRequest) (*pb.SimTransitionResponse, error) {
+	return gs.transition(ctx, req, gs.simService.UnblockSim)
+}
+
+// DeactivateSim deactivates an active sim before its activation period ends.
+func (gs GRPCSimService) DeactivateSim(ctx context.Context, req *pb.SimTransitionRequest) (*pb.SimTransitionResponse, error) {
+	return gs.transition(ctx, req, gs.simService.DeactivateSim)
+}
+
+// RetireSim takes a sim out of service for good. A retired sim can not change its state anymore.
+func (gs GRPCSimService) RetireSim(ctx context.Context, req *pb.SimTransitionRequest) (*pb.SimTransitionResponse, error) {
+	return gs.transition(ctx, req, gs.simService.RetireSim)
+}
+
+// transition validates the request and applies the state transition of the sim.
+func (gs GRPCSimService) transition(
+	ctx context.Context,
+	req *pb.SimTransitionRequest,
+	apply func(ctx context.Context, id int, reason string) (*core.Sim, error),
+) (*pb.SimTransitionResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id, id must be greater than 0")
+	}
+
+	if len(req.GetReason()) > maxReasonLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Reason cannot be longer than %d characters", maxReasonLength)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gs.timeout)
+	defer cancel()
+
+	sim, err := apply(ctx, int(req.GetId()), req.GetReason())
+	if err != nil {
+		return nil, gs.transitionError(err, int(req.GetId()))
+	}
+
+	return &pb.SimTransitionResponse{
+		Sim: simToPB(sim),
+	}, nil
+}
+
+// transitionError maps an error of a sim state transition to a gRPC status error.
+func (gs GRPCSimService) transitionError(err error, id int) error {
+	if errors.Is(err, repoerrors.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "sim card with id %d not found", id)
+	}
+	if errors.Is(err, core.ErrIllegalTransition) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	gs.logger.Error("Failed to change sim card state", slog.Int("sim id", id), "err", err)
+	return ErrInternal
+}
+
+// GetFreeServices retrieves the services the sim with the given number has not been used for yet.
+// Services the sim is blocked on are not free. Services are ordered by id.
 func (gs GRPCSimService) GetFreeServices(ctx context.Context, req *pb.GetFreeServRequest) (*pb.GetFreeServResponse, error) {
-	panic("implement")
+	parsed, err := parsePhoneNumber(req.GetNumber())
+	if err != nil {
+		return nil, err
+	}
+	number := parsed.E164()
+
+	ctx, cancel := context.WithTimeout(ctx, gs.timeout)
+	defer cancel()
+
+	list, err := gs.simService.GetFreeServiceList(ctx, number)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "sim card with number %s not found", number)
+		}
+
+		gs.logger.Error("Failed to get free services", slog.String("number", number), "err", err)
+		return nil, ErrInternal
+	}
+
+	ids := make([]int, 0, len(list))
+	for id := range list {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	response := pb.GetFreeServResponse{
+		FreeServiceIds: make([]int32, 0, len(ids)),
+		FreeServices:   make([]*pb.ServiceData, 0, len(ids)),
+	}
+	for _, id := range ids {
+		response.FreeServiceIds = append(response.FreeServiceIds, int32(id))
+		response.FreeServices = append(response.FreeServices, serviceToPB(list[id]))
+	}
+
+	return &response, nil
 }
 
 func (gs GRPCSimService) GetUsedServices(ctx context.Context, req *pb.GetUsedServRequest) (*pb.GetUsedServResponse, error) {
@@ -198,25 +758,44 @@ func (gs GRPCSimService) GetUsedServices(ctx context.Context, req *pb.GetUsedSer
 
 	list, err := gs.simService.GetUsedServiceList(ctx, int(req.GetSimId()))
 	if err != nil {
-		if err == repoerrors.ErrNotFound {
+		if errors.Is(err, repoerrors.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "sim card with id %d not found", req.GetSimId())
 		}
+		gs.logger.Error("Failed to get used services", slog.Int("sim id", int(req.GetSimId())), "err", err)
 		return nil, ErrInternal
 	}
 
-	if list == nil {
+	if len(list) == 0 {
 		return &pb.GetUsedServResponse{}, nil
 	}
 
+	// only the services of the used records are looked up, a sim is used for a few services
+	names := make(map[int]string, len(list))
+	for _, used := range list {
+		if _, ok := names[used.ServiceID()]; ok {
+			continue
+		}
+		service, err := gs.serviceService.ByID(ctx, used.ServiceID())
+		if err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+			gs.logger.Error("Failed to get service", slog.Int("service id", used.ServiceID()), "err", err)
+			return nil, ErrInternal
+		}
+		// the name of a deleted service is left empty
+		var name string
+		if service != nil {
+			name = service.Name()
+		}
+		names[used.ServiceID()] = name
+	}
+
 	var response pb.GetUsedServResponse
 	response.UsedServices = make([]*pb.UsedService, 0, len(list))
 	for _, used := range list {
-		response.UsedServices = append(response.UsedServices, &pb.UsedService{
-			ServiceId:   int32(used.ServiceID()),
-			IsBlocked:   used.IsBlocked(),
-			BlockedInfo: used.BlockedInfo(),
-		})
+		response.UsedServices = append(response.UsedServices, usedToPB(used, names[used.ServiceID()]))
 	}
+	slices.SortFunc(response.UsedServices, func(a, b *pb.UsedService) int {
+		return int(a.ServiceId - b.ServiceId)
+	})
 
 	return &response, nil
 }
@@ -0,0 +1,235 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"log/slog"
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+	"simactive/internal/core"
+	"simactive/internal/infrastructure/repoerrors"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxImportRows    = 10000
+	maxImportCSVSize = 4 << 20
+	// importRowsPerTimeout is the number of rows imported within one request timeout
+	importRowsPerTimeout = 100
+)
+
+// importRow is a row of an import stream, err is set if the row can not be read.
+type importRow struct {
+	data *pb.AddSimData
+	err  error
+}
+
+// ImportSims adds the sims of a client stream. The stream has either sim messages or chunks of a csv file.
+// Every row is validated the same way as in AddSim and reported as created, duplicate or invalid.
+// In the all-or-nothing mode no sim is stored if any row is a duplicate or invalid.
+func (gs GRPCSimService) ImportSims(stream pb.Sim_ImportSimsServer) error {
+	var (
+		allOrNothing bool
+		rows         []importRow
+		csvData      bytes.Buffer
+	)
+
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if first {
+			allOrNothing = req.GetAllOrNothing()
+		}
+
+		switch p := req.GetPayload().(type) {
+		case *pb.ImportSimsRequest_Sim:
+			if csvData.Len() != 0 {
+				return status.Errorf(codes.InvalidArgument, "Sims and csv chunks can not be mixed in one import")
+			}
+			rows = append(rows, importRow{data: p.Sim})
+			if len(rows) > maxImportRows {
+				return status.Errorf(codes.InvalidArgument, "Too many rows, an import can have up to %d rows", maxImportRows)
+			}
+		case *pb.ImportSimsRequest_CsvChunk:
+			if len(rows) != 0 {
+				return status.Errorf(codes.InvalidArgument, "Sims and csv chunks can not be mixed in one import")
+			}
+			csvData.Write(p.CsvChunk)
+			if csvData.Len() > maxImportCSVSize {
+				return status.Errorf(codes.InvalidArgument, "Csv file is too large, an import can have up to %d bytes", maxImportCSVSize)
+			}
+		}
+	}
+
+	if csvData.Len() != 0 {
+		var err error
+		if rows, err = parseImportCSV(&csvData); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Bad csv file. %v", err)
+		}
+		if len(rows) > maxImportRows {
+			return status.Errorf(codes.InvalidArgument, "Too many rows, an import can have up to %d rows", maxImportRows)
+		}
+	}
+
+	response := pb.ImportSimsResponse{
+		Results: make([]*pb.ImportRowResult, len(rows)),
+	}
+
+	// valid rows are imported, index maps them back to their results
+	var (
+		sims  []*core.Sim
+		index []int
+	)
+	for i, row := range rows {
+		result := &pb.ImportRowResult{
+			Row:    int32(i + 1),
+			Number: row.data.GetNumber(),
+		}
+		response.Results[i] = result
+
+		err := row.err
+		if err == nil {
+			var sim core.Sim
+			if sim, err = simFromPB(row.data); err == nil {
+				result.Number = sim.Number()
+				sims = append(sims, &sim)
+				index = append(index, i)
+				continue
+			}
+		}
+
+		result.Status = pb.ImportRowStatus_IMPORT_ROW_STATUS_INVALID
+		result.Error = status.Convert(err).Message()
+		response.Invalid++
+	}
+
+	if allOrNothing && response.Invalid != 0 {
+		for _, i := range index {
+			response.Results[i].Status = pb.ImportRowStatus_IMPORT_ROW_STATUS_ROLLED_BACK
+		}
+		return stream.SendAndClose(&response)
+	}
+
+	// a large import gets more time than a single request
+	timeout := gs.timeout * time.Duration(1+len(sims)/importRowsPerTimeout)
+	ctx, cancel := context.WithTimeout(stream.Context(), timeout)
+	defer cancel()
+
+	results, committed, err := gs.simService.ImportSims(ctx, sims, allOrNothing)
+	if err != nil {
+		gs.logger.Error("Failed to import sim cards", slog.Int("rows", len(rows)), "err", err)
+		return ErrInternal
+	}
+
+	for j, r := range results {
+		result := response.Results[index[j]]
+		result.SimId = int32(r.ID)
+
+		switch {
+		case errors.Is(r.Err, repoerrors.ErrAlreadyExists):
+			result.Status = pb.ImportRowStatus_IMPORT_ROW_STATUS_DUPLICATE
+			result.Error = status.Convert(simAlreadyExists(result.Number, r.Err)).Message()
+			response.Duplicates++
+		case errors.Is(r.Err, core.ErrProviderNotInferred):
+			result.Status = pb.ImportRowStatus_IMPORT_ROW_STATUS_INVALID
+			result.Error = status.Convert(providerNotInferred(sims[j].Country())).Message()
+			response.Invalid++
+		case !committed:
+			result.Status = pb.ImportRowStatus_IMPORT_ROW_STATUS_ROLLED_BACK
+		default:
+			result.Status = pb.ImportRowStatus_IMPORT_ROW_STATUS_CREATED
+			response.Created++
+		}
+	}
+	response.Committed = committed
+
+	return stream.SendAndClose(&response)
+}
+
+// parseImportCSV reads the rows of an import csv file with the columns
+// number, provider_name, is_activated, activate_until, is_blocked. The last three columns are optional.
+// A first row starting with "number" is a header and skipped.
+// A row that can not be read is returned with an error, so that it is reported as invalid.
+func parseImportCSV(r io.Reader) ([]importRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows []importRow
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rows = append(rows, importRow{err: status.Errorf(codes.InvalidArgument, "%v", parseErr.Err)})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if first && strings.EqualFold(strings.TrimSpace(record[0]), "number") {
+			continue
+		}
+
+		data, err := importRowFromCSV(record)
+		rows = append(rows, importRow{data: data, err: err})
+	}
+}
+
+// importRowFromCSV converts a csv record to the sim data.
+func importRowFromCSV(record []string) (*pb.AddSimData, error) {
+	if len(record) < 2 || len(record) > 5 {
+		return nil, status.Errorf(codes.InvalidArgument, "Row has %d columns, expected from 2 to 5", len(record))
+	}
+
+	data := &pb.AddSimData{
+		Number:       strings.TrimSpace(record[0]),
+		ProviderName: strings.TrimSpace(record[1]),
+	}
+
+	columns := []struct {
+		name  string
+		parse func(value string) error
+	}{
+		{"is_activated", func(value string) (err error) {
+			data.IsActivated, err = strconv.ParseBool(value)
+			return err
+		}},
+		{"activate_until", func(value string) (err error) {
+			data.ActivateUntil, err = strconv.ParseInt(value, 10, 64)
+			return err
+		}},
+		{"is_blocked", func(value string) (err error) {
+			data.IsBlocked, err = strconv.ParseBool(value)
+			return err
+		}},
+	}
+	for i, value := range record[2:] {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if err := columns[i].parse(value); err != nil {
+			return data, status.Errorf(codes.InvalidArgument, "Bad %s value %q", columns[i].name, value)
+		}
+	}
+
+	return data, nil
+}
@@ -2,25 +2,53 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+	"simactive/internal/core"
+	"simactive/internal/infrastructure/repoerrors"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxLeaseTTL limits how long a client may hold a sim without confirming it.
+	maxLeaseTTL = time.Hour
+	// maxBlockReasonLength is the size of the blocked_info column
+	maxBlockReasonLength = 64
 )
 
 type UsedService interface {
 	UseSimForService(ctx context.Context, simId int, serviceId int) error
+	AcquireSim(ctx context.Context, serviceId int, ttl time.Duration) (core.Lease, *core.Sim, error)
+	ConfirmLease(ctx context.Context, leaseId string) (int, error)
+	ReleaseLease(ctx context.Context, leaseId string) error
+	BlockUsedService(ctx context.Context, simId, serviceId int, reason string) (*core.Used, error)
+	UnblockUsedService(ctx context.Context, simId, serviceId int) (*core.Used, error)
+	ReleaseUsedService(ctx context.Context, simId, serviceId int) (*core.Used, error)
+	CheckEligibility(ctx context.Context, simId, serviceId int) (core.Eligibility, error)
 }
 
 type GRPCUsedService struct {
 	pb.UnimplementedUsedServer
 
-	timeout     time.Duration
-	usedService UsedService
+	logger         *slog.Logger
+	timeout        time.Duration
+	usedService    UsedService
+	serviceService ServiceService
 }
 
-func NewGRPCUsedService(ss UsedService, timeout time.Duration) GRPCUsedService {
+func NewGRPCUsedService(logger *slog.Logger, us UsedService, srv ServiceService, timeout time.Duration) GRPCUsedService {
 	return GRPCUsedService{
-		usedService: ss,
-		timeout:     timeout,
+		logger:         logger,
+		usedService:    us,
+		serviceService: srv,
+		timeout:        timeout,
 	}
 }
 
@@ -29,15 +57,300 @@ func NewGRPCUsedService(ss UsedService, timeout time.Duration) GRPCUsedService {
 // ctx - The context in which the function operates.
 // req - The request containing information about the simulated service.
 // Returns a response indicating if the service is used successfully, otherwise an error.
+// A sim that is not eligible for the service is rejected with the code of the first reason,
+// the EligibilityResponse with all reasons is attached to the status details.
 func (gus GRPCUsedService) UseSimForService(ctx context.Context, req *pb.USFSRequest) (*pb.USFSResponse, error) {
+	if err := validateUsedServiceIDs(req.GetSimID(), req.GetServiceID()); err != nil {
+		return nil, err
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, gus.timeout)
 	defer cancel()
 
 	if err := gus.usedService.UseSimForService(ctx, int(req.GetSimID()), int(req.GetServiceID())); err != nil {
-		return nil, err
+		var notEligible *core.NotEligibleError
+		if errors.As(err, &notEligible) {
+			return nil, notEligibleStatus(req.GetSimID(), req.GetServiceID(), notEligible.Eligibility)
+		}
+		gus.logger.Error("Failed to use sim for service", slog.Int("sim id", int(req.GetSimID())), slog.Int("service id", int(req.GetServiceID())), "err", err)
+		return nil, ErrInternal
 	}
 
 	return &pb.USFSResponse{
 		IsUsed: true,
 	}, nil
 }
+
+// AcquireSim reserves a free sim for a service under a lease.
+// The lease has to be confirmed with ConfirmLease or released with ReleaseLease before it expires.
+func (gus GRPCUsedService) AcquireSim(ctx context.Context, req *pb.AcquireSimRequest) (*pb.AcquireSimResponse, error) {
+	if req.GetServiceID() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid service id, service id must be greater than 0")
+	}
+
+	ttl := time.Duration(req.GetLeaseTTL()) * time.Second
+	if ttl < 0 || ttl > maxLeaseTTL {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid lease ttl, lease ttl must be in range from 0 to %d seconds", int(maxLeaseTTL.Seconds()))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gus.timeout)
+	defer cancel()
+
+	lease, sim, err := gus.usedService.AcquireSim(ctx, int(req.GetServiceID()), ttl)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "service with id %d not found", req.GetServiceID())
+		}
+		if errors.Is(err, core.ErrServiceDisabled) {
+			return nil, status.Errorf(codes.FailedPrecondition, "service with id %d is disabled", req.GetServiceID())
+		}
+		if errors.Is(err, core.ErrNoFreeSim) {
+			return nil, status.Errorf(codes.ResourceExhausted, "no free sim for service with id %d", req.GetServiceID())
+		}
+		return nil, ErrInternal
+	}
+
+	return &pb.AcquireSimResponse{
+		LeaseID:   lease.Id(),
+		Sim:       simToPB(sim),
+		ExpiresAt: lease.ExpiresAt(),
+	}, nil
+}
+
+// ConfirmLease marks the leased sim as used for the leased service.
+func (gus GRPCUsedService) ConfirmLease(ctx context.Context, req *pb.LeaseRequest) (*pb.ConfirmLeaseResponse, error) {
+	if req.GetLeaseID() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Lease id is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gus.timeout)
+	defer cancel()
+
+	id, err := gus.usedService.ConfirmLease(ctx, req.GetLeaseID())
+	if err != nil {
+		if errors.Is(err, core.ErrLeaseNotFound) {
+			return nil, status.Errorf(codes.NotFound, "lease %s not found or expired", req.GetLeaseID())
+		}
+		var notEligible *core.NotEligibleError
+		if errors.As(err, &notEligible) {
+			return nil, notEligibleStatus(0, 0, notEligible.Eligibility)
+		}
+		return nil, ErrInternal
+	}
+
+	return &pb.ConfirmLeaseResponse{
+		UsedID: int32(id),
+	}, nil
+}
+
+// ReleaseLease returns the leased sim back to the pool without using it.
+func (gus GRPCUsedService) ReleaseLease(ctx context.Context, req *pb.LeaseRequest) (*pb.ReleaseLeaseResponse, error) {
+	if req.GetLeaseID() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Lease id is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gus.timeout)
+	defer cancel()
+
+	if err := gus.usedService.ReleaseLease(ctx, req.GetLeaseID()); err != nil {
+		if errors.Is(err, core.ErrLeaseNotFound) {
+			return nil, status.Errorf(codes.NotFound, "lease %s not found or expired", req.GetLeaseID())
+		}
+		return nil, ErrInternal
+	}
+
+	return &pb.ReleaseLeaseResponse{
+		IsReleased: true,
+	}, nil
+}
+
+// BlockUsedService marks the sim as blocked on the service, e.g. after the service banned the number.
+// A sim blocked on a service is not offered for it by GetFreeServices and AcquireSim.
+func (gus GRPCUsedService) BlockUsedService(ctx context.Context, req *pb.BlockUsedServiceRequest) (*pb.UsedServiceResponse, error) {
+	if err := validateUsedServiceIDs(req.GetSimID(), req.GetServiceID()); err != nil {
+		return nil, err
+	}
+
+	reason := strings.TrimSpace(req.GetReason())
+	if utf8.RuneCountInString(reason) > maxBlockReasonLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Reason is too long, reason can have up to %d characters", maxBlockReasonLength)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gus.timeout)
+	defer cancel()
+
+	used, err := gus.usedService.BlockUsedService(ctx, int(req.GetSimID()), int(req.GetServiceID()), reason)
+	if err != nil {
+		if errors.Is(err, core.ErrSimNotFound) {
+			return nil, status.Errorf(codes.NotFound, "sim card with id %d not found", req.GetSimID())
+		}
+		if errors.Is(err, core.ErrServiceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "service with id %d not found", req.GetServiceID())
+		}
+		gus.logger.Error("Failed to block used service", slog.Int("sim id", int(req.GetSimID())), slog.Int("service id", int(req.GetServiceID())), "err", err)
+		return nil, ErrInternal
+	}
+
+	return gus.usedServiceResponse(ctx, used), nil
+}
+
+// UnblockUsedService clears the block of the sim on the service.
+// The sim stays used for the service, so the service is still not offered for it.
+func (gus GRPCUsedService) UnblockUsedService(ctx context.Context, req *pb.UnblockUsedServiceRequest) (*pb.UsedServiceResponse, error) {
+	if err := validateUsedServiceIDs(req.GetSimID(), req.GetServiceID()); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gus.timeout)
+	defer cancel()
+
+	used, err := gus.usedService.UnblockUsedService(ctx, int(req.GetSimID()), int(req.GetServiceID()))
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "sim card with id %d is not used for service with id %d", req.GetSimID(), req.GetServiceID())
+		}
+		gus.logger.Error("Failed to unblock used service", slog.Int("sim id", int(req.GetSimID())), slog.Int("service id", int(req.GetServiceID())), "err", err)
+		return nil, ErrInternal
+	}
+
+	return gus.usedServiceResponse(ctx, used), nil
+}
+
+// ReleaseUsedService marks the sim as released from the service.
+// The sim can be used for the service again after the cooldown of the service use policy.
+func (gus GRPCUsedService) ReleaseUsedService(ctx context.Context, req *pb.ReleaseUsedServiceRequest) (*pb.UsedServiceResponse, error) {
+	if err := validateUsedServiceIDs(req.GetSimID(), req.GetServiceID()); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gus.timeout)
+	defer cancel()
+
+	used, err := gus.usedService.ReleaseUsedService(ctx, int(req.GetSimID()), int(req.GetServiceID()))
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "sim card with id %d is not used for service with id %d", req.GetSimID(), req.GetServiceID())
+		}
+		gus.logger.Error("Failed to release used service", slog.Int("sim id", int(req.GetSimID())), slog.Int("service id", int(req.GetServiceID())), "err", err)
+		return nil, ErrInternal
+	}
+
+	return gus.usedServiceResponse(ctx, used), nil
+}
+
+// CheckEligibility answers whether the sim can be used for the service now, the same way UseSimForService decides.
+// Nothing is written, a not eligible sim is a successful response with the reasons.
+func (gus GRPCUsedService) CheckEligibility(ctx context.Context, req *pb.EligibilityRequest) (*pb.EligibilityResponse, error) {
+	if err := validateUsedServiceIDs(req.GetSimID(), req.GetServiceID()); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gus.timeout)
+	defer cancel()
+
+	eligibility, err := gus.usedService.CheckEligibility(ctx, int(req.GetSimID()), int(req.GetServiceID()))
+	if err != nil {
+		gus.logger.Error("Failed to check eligibility", slog.Int("sim id", int(req.GetSimID())), slog.Int("service id", int(req.GetServiceID())), "err", err)
+		return nil, ErrInternal
+	}
+
+	return eligibilityToPB(eligibility), nil
+}
+
+// eligibilityReasons maps the eligibility reasons to their pb reasons and status codes.
+var eligibilityReasons = map[core.EligibilityReason]struct {
+	reason pb.EligibilityReason
+	code   codes.Code
+}{
+	core.ReasonSimNotFound:         {pb.EligibilityReason_ELIGIBILITY_REASON_SIM_NOT_FOUND, codes.NotFound},
+	core.ReasonSimBlocked:          {pb.EligibilityReason_ELIGIBILITY_REASON_SIM_BLOCKED, codes.FailedPrecondition},
+	core.ReasonSimExpired:          {pb.EligibilityReason_ELIGIBILITY_REASON_SIM_EXPIRED, codes.FailedPrecondition},
+	core.ReasonSimNotActivated:     {pb.EligibilityReason_ELIGIBILITY_REASON_SIM_NOT_ACTIVATED, codes.FailedPrecondition},
+	core.ReasonServiceNotFound:     {pb.EligibilityReason_ELIGIBILITY_REASON_SERVICE_NOT_FOUND, codes.NotFound},
+	core.ReasonServiceDisabled:     {pb.EligibilityReason_ELIGIBILITY_REASON_SERVICE_DISABLED, codes.FailedPrecondition},
+	core.ReasonCountryNotSupported: {pb.EligibilityReason_ELIGIBILITY_REASON_COUNTRY_NOT_SUPPORTED, codes.FailedPrecondition},
+	core.ReasonAlreadyUsed:         {pb.EligibilityReason_ELIGIBILITY_REASON_ALREADY_USED, codes.AlreadyExists},
+	core.ReasonBlockedOnService:    {pb.EligibilityReason_ELIGIBILITY_REASON_BLOCKED_ON_SERVICE, codes.FailedPrecondition},
+	core.ReasonCooldown:            {pb.EligibilityReason_ELIGIBILITY_REASON_COOLDOWN, codes.FailedPrecondition},
+	core.ReasonLeased:              {pb.EligibilityReason_ELIGIBILITY_REASON_LEASED, codes.FailedPrecondition},
+}
+
+func eligibilityToPB(e core.Eligibility) *pb.EligibilityResponse {
+	response := &pb.EligibilityResponse{
+		Eligible:   e.Eligible(),
+		Reasons:    make([]pb.EligibilityReason, 0, len(e.Reasons)),
+		EligibleAt: e.EligibleAt,
+	}
+	for _, r := range e.Reasons {
+		response.Reasons = append(response.Reasons, eligibilityReasons[r].reason)
+	}
+	return response
+}
+
+// notEligibleStatus converts the reasons a sim is not eligible for to a status error with the code of the first reason.
+// The ids are only used in the message, they are left out if 0.
+func notEligibleStatus(simId, serviceId int32, e core.Eligibility) error {
+	var (
+		code    = codes.FailedPrecondition
+		reasons = make([]string, 0, len(e.Reasons))
+	)
+	for i, r := range e.Reasons {
+		if i == 0 {
+			code = eligibilityReasons[r].code
+		}
+		reasons = append(reasons, string(r))
+	}
+
+	msg := "sim card is not eligible for the service"
+	if simId != 0 && serviceId != 0 {
+		msg = fmt.Sprintf("sim card with id %d is not eligible for service with id %d", simId, serviceId)
+	}
+	msg += ": " + strings.Join(reasons, ", ")
+	if e.EligibleAt != 0 {
+		msg += fmt.Sprintf(". Eligible again at %s (%d)", time.Unix(e.EligibleAt, 0).UTC().Format(time.RFC3339), e.EligibleAt)
+	}
+
+	st := status.New(code, msg)
+	if detailed, err := st.WithDetails(eligibilityToPB(e)); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// usedServiceResponse converts the used record to a response with the name of its service.
+// The name is left empty if the service list can not be read, the record itself is already stored.
+func (gus GRPCUsedService) usedServiceResponse(ctx context.Context, used *core.Used) *pb.UsedServiceResponse {
+	var serviceName string
+	if services, err := gus.serviceService.GetServiceList(ctx); err == nil {
+		if service, err := services.ByID(used.ServiceID()); err == nil {
+			serviceName = service.Name()
+		}
+	}
+
+	return &pb.UsedServiceResponse{
+		UsedService: usedToPB(used, serviceName),
+	}
+}
+
+func validateUsedServiceIDs(simId, serviceId int32) error {
+	if simId <= 0 {
+		return status.Errorf(codes.InvalidArgument, "Invalid sim id, sim id must be greater than 0")
+	}
+	if serviceId <= 0 {
+		return status.Errorf(codes.InvalidArgument, "Invalid service id, service id must be greater than 0")
+	}
+	return nil
+}
+
+// usedToPB converts a core.Used to a pb.UsedService.
+func usedToPB(used *core.Used, serviceName string) *pb.UsedService {
+	return &pb.UsedService{
+		ServiceId:   int32(used.ServiceID()),
+		ServiceName: serviceName,
+		IsBlocked:   used.IsBlocked(),
+		BlockedInfo: used.BlockedInfo(),
+		BlockedAt:   used.BlockedAt(),
+		UsedAt:      used.UsedAt(),
+		ReleasedAt:  used.ReleasedAt(),
+	}
+}
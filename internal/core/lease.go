@@ -0,0 +1,37 @@
+package core
+
+// Lease is a temporary reservation of a sim for a service.
+// Until the lease expires, the sim is not handed out for the same service again.
+type Lease struct {
+	id        string
+	simId     int
+	serviceId int
+	expiresAt int64
+}
+
+// NewLease creates a new Lease object with the given parameters.
+// Parameters:
+//   - id: the id of the Lease.
+//   - simId: the id of the reserved Sim.
+//   - serviceId: the id of the Service the Sim is reserved for.
+//   - expiresAt: the unix timestamp when the Lease expires.
+func NewLease(id string, simId, serviceId int, expiresAt int64) Lease {
+	return Lease{
+		id:        id,
+		simId:     simId,
+		serviceId: serviceId,
+		expiresAt: expiresAt,
+	}
+}
+
+// Getters
+
+func (l Lease) Id() string       { return l.id }
+func (l Lease) SimID() int       { return l.simId }
+func (l Lease) ServiceID() int   { return l.serviceId }
+func (l Lease) ExpiresAt() int64 { return l.expiresAt }
+
+// IsExpired reports whether the Lease is expired at the given unix timestamp.
+func (l Lease) IsExpired(now int64) bool {
+	return now >= l.expiresAt
+}
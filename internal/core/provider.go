@@ -1,10 +1,69 @@
 package core
 
-import "database/sql"
+import (
+	"database/sql"
+	"strings"
+	"unicode"
+)
 
 type Provider struct {
 	id   int
 	name string
+	// defaultActivationPeriod is the activation period of the provider sims in seconds, 0 means no default
+	defaultActivationPeriod int64
+	// country is the ISO 3166-1 alpha-2 code of the provider country, empty if unknown
+	country string
+	// mcc and mnc are the mobile country and network codes of the provider, empty if unknown
+	mcc string
+	mnc string
+	// monthlyCost is the monthly tariff of a provider sim in minor units of currency, e.g. cents
+	monthlyCost int64
+	// currency is the ISO 4217 code of monthlyCost
+	currency string
+}
+
+// ProviderNameKey normalizes a provider name or alias for matching,
+// so "Tele2", "tele2" and "TELE-2" are the same provider.
+// Letters are lowercased, everything but letters and digits is dropped.
+func ProviderNameKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+// ProviderUpdate lists the provider metadata to change, nil fields are left as they are.
+type ProviderUpdate struct {
+	Country                 *string
+	MCC                     *string
+	MNC                     *string
+	DefaultActivationPeriod *int64
+	MonthlyCost             *int64
+	Currency                *string
+}
+
+// Apply changes the fields of the provider that are set in the update.
+func (u ProviderUpdate) Apply(p *Provider) {
+	if u.Country != nil {
+		p.country = *u.Country
+	}
+	if u.MCC != nil {
+		p.mcc = *u.MCC
+	}
+	if u.MNC != nil {
+		p.mnc = *u.MNC
+	}
+	if u.DefaultActivationPeriod != nil {
+		p.defaultActivationPeriod = *u.DefaultActivationPeriod
+	}
+	if u.MonthlyCost != nil {
+		p.monthlyCost = *u.MonthlyCost
+	}
+	if u.Currency != nil {
+		p.currency = *u.Currency
+	}
 }
 
 func NewProvider(id int, name string) Provider {
@@ -24,6 +83,16 @@ func (p Provider) Name() string {
 	return p.name
 }
 
+func (p Provider) DefaultActivationPeriod() int64 {
+	return p.defaultActivationPeriod
+}
+
+func (p Provider) Country() string    { return p.country }
+func (p Provider) MCC() string        { return p.mcc }
+func (p Provider) MNC() string        { return p.mnc }
+func (p Provider) MonthlyCost() int64 { return p.monthlyCost }
+func (p Provider) Currency() string   { return p.currency }
+
 /// Setters
 
 func (p *Provider) SetId(id int) {
@@ -34,18 +103,42 @@ func (p *Provider) SetName(name string) {
 	p.name = name
 }
 
+func (p *Provider) SetDefaultActivationPeriod(seconds int64) {
+	p.defaultActivationPeriod = seconds
+}
+
+func (p *Provider) SetCountry(country string) { p.country = country }
+
+// SetNetwork sets the mobile country and network codes of the provider.
+func (p *Provider) SetNetwork(mcc, mnc string) {
+	p.mcc = mcc
+	p.mnc = mnc
+}
+
+// SetTariff sets the monthly cost of a provider sim in minor units of the currency.
+func (p *Provider) SetTariff(monthlyCost int64, currency string) {
+	p.monthlyCost = monthlyCost
+	p.currency = currency
+}
+
 // [Scan] return object of [Sim] whitch is [Scannable], and map index [int]
 // If any errors ocured while scanning it will be in [error]
+// The columns are the ones of selectProvider of the provider repository.
 func (p *Provider) ScanRows(row *sql.Rows) (int, error) {
-	err := row.Scan(&p.id, &p.name)
+	err := row.Scan(p.fields()...)
 	return p.id, err
 }
 
 func (p *Provider) ScanRow(row *sql.Row) error {
-	err := row.Scan(&p.id, &p.name)
+	err := row.Scan(p.fields()...)
 	return err
 }
 
+// fields returns the scan destinations of the provider columns.
+func (p *Provider) fields() []any {
+	return []any{&p.id, &p.name, &p.defaultActivationPeriod, &p.country, &p.mcc, &p.mnc, &p.monthlyCost, &p.currency}
+}
+
 func (p *Provider) GetKey() int {
 	return p.Id()
 }
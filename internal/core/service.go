@@ -1,16 +1,80 @@
 package core
 
-import "database/sql"
+import (
+	"database/sql"
+	"slices"
+	"strings"
+)
+
+// VerificationType is the way a service verifies a number.
+type VerificationType string
+
+const (
+	VerificationSMS  VerificationType = "sms"
+	VerificationCall VerificationType = "call"
+	// VerificationBoth is a service that verifies by sms or by call
+	VerificationBoth VerificationType = "both"
+)
 
 type Service struct {
 	id   int
 	name string
+	// url is the address of the service, empty if unknown
+	url string
+	// category groups the services, e.g. messenger or marketplace, empty if unknown
+	category string
+	// verificationType is empty if unknown
+	verificationType VerificationType
+	// countries are the ISO 3166-1 alpha-2 codes of the numbers the service accepts, empty means any country
+	countries []string
+	// enabled is false for a service the sims are not used for anymore
+	enabled bool
+	// parentId is the id of the top level service of the group of the service, 0 for a top level service
+	parentId int
 }
 
 func NewService(id int, name string) Service {
 	return Service{
-		id:   id,
-		name: name,
+		id:      id,
+		name:    name,
+		enabled: true,
+	}
+}
+
+// ServiceUpdate lists the service fields to change, nil fields are left as they are.
+type ServiceUpdate struct {
+	Name             *string
+	URL              *string
+	Category         *string
+	VerificationType *VerificationType
+	Countries        *[]string
+	Enabled          *bool
+	// ParentID 0 makes the service a top level service
+	ParentID *int
+}
+
+// Apply changes the fields of the service that are set in the update.
+func (u ServiceUpdate) Apply(s *Service) {
+	if u.Name != nil {
+		s.name = *u.Name
+	}
+	if u.URL != nil {
+		s.url = *u.URL
+	}
+	if u.Category != nil {
+		s.category = *u.Category
+	}
+	if u.VerificationType != nil {
+		s.verificationType = *u.VerificationType
+	}
+	if u.Countries != nil {
+		s.countries = slices.Clone(*u.Countries)
+	}
+	if u.Enabled != nil {
+		s.enabled = *u.Enabled
+	}
+	if u.ParentID != nil {
+		s.parentId = *u.ParentID
 	}
 }
 
@@ -24,6 +88,31 @@ func (s *Service) Name() string {
 	return s.name
 }
 
+func (s *Service) URL() string                        { return s.url }
+func (s *Service) Category() string                   { return s.category }
+func (s *Service) VerificationType() VerificationType { return s.verificationType }
+func (s *Service) Enabled() bool                      { return s.enabled }
+func (s *Service) ParentID() int                      { return s.parentId }
+
+// GroupID returns the id of the top level service of the service group, the service id for a top level service.
+func (s *Service) GroupID() int {
+	if s.parentId != 0 {
+		return s.parentId
+	}
+	return s.id
+}
+
+// Countries returns a copy of the service countries.
+func (s *Service) Countries() []string {
+	return slices.Clone(s.countries)
+}
+
+// SupportsCountry reports whether the service accepts numbers of the country.
+// A service without countries accepts any country, so does an unknown country.
+func (s *Service) SupportsCountry(country string) bool {
+	return len(s.countries) == 0 || country == "" || slices.Contains(s.countries, country)
+}
+
 // SetID sets service ID.
 func (s *Service) SetID(id int) {
 	s.id = id
@@ -34,6 +123,11 @@ func (s *Service) SetName(name string) {
 	s.name = name
 }
 
+// SetEnabled enables or disables the service.
+func (s *Service) SetEnabled(enabled bool) {
+	s.enabled = enabled
+}
+
 // WithName sets the name of the service.
 //
 // name: the name to set for the service.
@@ -45,12 +139,17 @@ func (s Service) WithName(name string) Service {
 
 // ScanRow scans the row into the service struct.
 // It returns an error if the scan fails.
+// The columns are the ones of selectService of the service repository.
 //
 // row: the sql rows to scan.
 // error: the error, if any, that occurred during the scan.
 func (s *Service) ScanRow(row *sql.Row) error {
-	err := row.Scan(&s.id, &s.name)
-	return err
+	var countries string
+	if err := row.Scan(s.fields(&countries)...); err != nil {
+		return err
+	}
+	s.countries = SplitCountries(countries)
+	return nil
 }
 
 // ScanRows scans the rows into the service struct.
@@ -60,8 +159,30 @@ func (s *Service) ScanRow(row *sql.Row) error {
 // id: the id of the service.
 // error: the error, if any, that occurred during the scan.
 func (s *Service) ScanRows(rows *sql.Rows) (int, error) {
-	err := rows.Scan(&s.id, &s.name)
-	return s.id, err
+	var countries string
+	if err := rows.Scan(s.fields(&countries)...); err != nil {
+		return s.id, err
+	}
+	s.countries = SplitCountries(countries)
+	return s.id, nil
+}
+
+// fields returns the scan destinations of the service columns, the countries column is scanned into countries.
+func (s *Service) fields(countries *string) []any {
+	return []any{&s.id, &s.name, &s.url, &s.category, &s.verificationType, countries, &s.enabled, &s.parentId}
+}
+
+// JoinCountries joins the country codes into the value of the countries column.
+func JoinCountries(countries []string) string {
+	return strings.Join(countries, ",")
+}
+
+// SplitCountries splits the value of the countries column into the country codes.
+func SplitCountries(countries string) []string {
+	if countries == "" {
+		return nil
+	}
+	return strings.Split(countries, ",")
 }
 
 // GetKey returns the map key of the Service that used in the List.
@@ -0,0 +1,68 @@
+package core
+
+import (
+	"slices"
+	"strings"
+)
+
+// ServiceGroup is a top level service with its child services, e.g. services sharing one account backend.
+// A sim is used for the services of a group as one unit, see UsePolicy.CheckGroup.
+type ServiceGroup struct {
+	// ID is the id of the top level service
+	ID int
+	// Name is the name of the top level service
+	Name string
+	// ServiceIDs are the ids of the group services in ascending order, the top level service included
+	ServiceIDs []int
+}
+
+// ServiceGroupOf returns the group of the service, services must contain the services of the group.
+// A service without parent and children is a group of its own.
+func ServiceGroupOf(services List[*Service], s *Service) ServiceGroup {
+	group := ServiceGroup{ID: s.GroupID()}
+	for _, service := range services {
+		if service.GroupID() != group.ID {
+			continue
+		}
+		if service.Id() == group.ID {
+			group.Name = service.Name()
+		}
+		group.ServiceIDs = append(group.ServiceIDs, service.Id())
+	}
+	if !slices.Contains(group.ServiceIDs, s.Id()) {
+		group.ServiceIDs = append(group.ServiceIDs, s.Id())
+	}
+	if group.Name == "" && s.Id() == group.ID {
+		group.Name = s.Name()
+	}
+	slices.Sort(group.ServiceIDs)
+	return group
+}
+
+// Linked returns the ids of the group services other than the service with the id.
+func (g ServiceGroup) Linked(serviceId int) []int {
+	linked := make([]int, 0, len(g.ServiceIDs))
+	for _, id := range g.ServiceIDs {
+		if id != serviceId {
+			linked = append(linked, id)
+		}
+	}
+	return linked
+}
+
+// BlockPropagation tells whether blocking a sim on a service of a group blocks it on every service of the group.
+type BlockPropagation struct {
+	Default bool
+	// ByGroup overrides the default by the group name, names are compared case-insensitively
+	ByGroup map[string]bool
+}
+
+// For reports whether the blocks propagate in the group with the name.
+func (p BlockPropagation) For(groupName string) bool {
+	for name, propagate := range p.ByGroup {
+		if strings.EqualFold(name, groupName) {
+			return propagate
+		}
+	}
+	return p.Default
+}
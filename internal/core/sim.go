@@ -5,35 +5,93 @@ import (
 	"fmt"
 )
 
+// SimState is the lifecycle state of a Sim.
+//
+// A Sim starts as new, gets active on activation and expired when its activation period ends.
+// Active, expired and new sims may be blocked, blocked sims may be unblocked.
+// Any sim may be retired, a retired sim never changes its state again.
+type SimState string
+
+const (
+	SimStateNew     SimState = "new"
+	SimStateActive  SimState = "active"
+	SimStateExpired SimState = "expired"
+	SimStateBlocked SimState = "blocked"
+	SimStateRetired SimState = "retired"
+)
+
+// simTransitions lists the states a Sim may move to from each state.
+var simTransitions = map[SimState][]SimState{
+	SimStateNew:     {SimStateActive, SimStateBlocked, SimStateRetired},
+	SimStateActive:  {SimStateActive, SimStateExpired, SimStateBlocked, SimStateRetired},
+	SimStateExpired: {SimStateActive, SimStateBlocked, SimStateRetired},
+	// a blocked Sim leaves the blocked state through Unblock only, see Sim.Unblock
+	SimStateBlocked: {SimStateRetired},
+	SimStateRetired: {},
+}
+
+// CanTransition reports whether a Sim may move from one state to another.
+func CanTransition(from, to SimState) bool {
+	for _, s := range simTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// SimStateFromFlags maps the legacy activated and blocked flags to a SimState.
+// Blocked wins over activated.
+func SimStateFromFlags(isActivated, isBlocked bool) SimState {
+	switch {
+	case isBlocked:
+		return SimStateBlocked
+	case isActivated:
+		return SimStateActive
+	default:
+		return SimStateNew
+	}
+}
+
 type Sim struct {
-	id            int
-	number        string
+	id int
+	// number is the phone number in the E.164 format
+	number string
+	// country is the ISO 3166-1 alpha-2 code of the number country, empty if unknown
+	country       string
 	provider      *Provider
-	isActivated   bool
-	isBlocked     bool
+	state         SimState
+	stateReason   string
 	activateUntil int64
 }
 
+// SimUpdate holds the new values of the Sim fields to change.
+// Nil fields are left as they are, Country is changed together with Number.
+type SimUpdate struct {
+	Number        *string
+	Country       *string
+	ProviderName  *string
+	ActivateUntil *int64
+}
+
 // NewSim creates a new Sim object with the given parameters.
 // Parameters:
 //   - id: the id of the Sim.
 //   - number: the number of the Sim.
 //   - provider: the provider data (id, name) of the Sim.
-//   - isActivated: a boolean indicating if the Sim is activated.
+//   - state: the lifecycle state of the Sim.
 //   - activateUntil: the timestamp until when the Sim is activated.
-//   - isBlocked: a boolean indicating if the Sim is blocked.
 //
 // Returns:
 //   - Sim: the newly created Sim object.
-func NewSim(id int, number string, provider *Provider, isActivated bool, activateUntil int64, isBlocked bool) Sim {
+func NewSim(id int, number string, provider *Provider, state SimState, activateUntil int64) Sim {
 	// Create and return a new Sim object with the given parameters.
 	return Sim{
 		id:            id,            // Set the id of the Sim.
 		number:        number,        // Set the number of the Sim.
 		provider:      provider,      // Set the provider id of the Sim.
-		isActivated:   isActivated,   // Set if the Sim is activated.
+		state:         state,         // Set the lifecycle state of the Sim.
 		activateUntil: activateUntil, // Set the timestamp until when the Sim is activated.
-		isBlocked:     isBlocked,     // Set if the Sim is blocked.
 	}
 }
 
@@ -41,26 +99,88 @@ func NewSim(id int, number string, provider *Provider, isActivated bool, activat
 
 func (s Sim) Id() int              { return s.id }
 func (s Sim) Number() string       { return s.number }
+func (s Sim) Country() string      { return s.country }
 func (s Sim) Provider() *Provider  { return s.provider }
-func (s Sim) IsBlocked() bool      { return s.isBlocked }
-func (s Sim) IsActivated() bool    { return s.isActivated }
+func (s Sim) State() SimState      { return s.state }
+func (s Sim) StateReason() string  { return s.stateReason }
+func (s Sim) IsBlocked() bool      { return s.state == SimStateBlocked }
+func (s Sim) IsActivated() bool    { return s.state == SimStateActive }
 func (s Sim) ActivateUntil() int64 { return s.activateUntil }
 
 // Setters
 
-func (s *Sim) SetID(id int)                { s.id = id }
-func (s *Sim) SetNumber(number string)     { s.number = number }
-func (s *Sim) SetProvider(pid *Provider)   { s.provider = pid }
-func (s *Sim) SetBlocked(status bool)      { s.isBlocked = status }
-func (s *Sim) SetActivated(status bool)    { s.isActivated = status }
-func (s *Sim) SetActivateUntil(aunt int64) { s.activateUntil = aunt }
+func (s *Sim) SetID(id int)                 { s.id = id }
+func (s *Sim) SetNumber(number string)      { s.number = number }
+func (s *Sim) SetCountry(country string)    { s.country = country }
+func (s *Sim) SetProvider(pid *Provider)    { s.provider = pid }
+func (s *Sim) SetActivateUntil(aunt int64)  { s.activateUntil = aunt }
+func (s *Sim) SetStateReason(reason string) { s.stateReason = reason }
+
+// Transitions
+
+// Activate activates the Sim until the given timestamp, an active Sim gets its activation extended.
+func (s *Sim) Activate(until int64, reason string) error {
+	if err := s.transition(SimStateActive, reason); err != nil {
+		return err
+	}
+	s.activateUntil = until
+	return nil
+}
+
+// Expire deactivates an active Sim.
+func (s *Sim) Expire(reason string) error {
+	if s.state != SimStateActive {
+		return fmt.Errorf("%w: sim is %s, only an active sim can be deactivated", ErrIllegalTransition, s.state)
+	}
+	return s.transition(SimStateExpired, reason)
+}
+
+// Block blocks the Sim. Blocking an already blocked Sim changes nothing.
+func (s *Sim) Block(reason string) error {
+	if s.state == SimStateBlocked {
+		return nil
+	}
+	return s.transition(SimStateBlocked, reason)
+}
+
+// Unblock unblocks a blocked Sim. The Sim gets active again if its activation period
+// has not ended at the given timestamp, otherwise it gets expired.
+func (s *Sim) Unblock(now int64, reason string) error {
+	if s.state != SimStateBlocked {
+		return fmt.Errorf("%w: sim is %s, only a blocked sim can be unblocked", ErrIllegalTransition, s.state)
+	}
+
+	// the lifecycle does not let a blocked Sim move on by itself, so the state is set here
+	s.state = SimStateExpired
+	if s.activateUntil > now {
+		s.state = SimStateActive
+	}
+	s.stateReason = reason
+	return nil
+}
+
+// Retire takes the Sim out of service for good.
+func (s *Sim) Retire(reason string) error {
+	return s.transition(SimStateRetired, reason)
+}
+
+// transition moves the Sim to the given state if the lifecycle allows it.
+func (s *Sim) transition(to SimState, reason string) error {
+	if !CanTransition(s.state, to) {
+		return fmt.Errorf("%w: sim can not move from %s to %s", ErrIllegalTransition, s.state, to)
+	}
+
+	s.state = to
+	s.stateReason = reason
+	return nil
+}
 
 // ScanRow scans the values from the given sql.Rows into the fields of the Sim struct.
 //
 // It takes a pointer to a sql.Row as parameter and returns an error.
 // Scanned values are stored in the fields of the pointer to the Sim struct.
 func (s *Sim) ScanRows(row *sql.Rows) (int, error) {
-	err := row.Scan(&s.id, &s.number, &s.provider.id, &s.provider.name, &s.isActivated, &s.activateUntil, &s.isBlocked)
+	err := row.Scan(&s.id, &s.number, &s.country, &s.provider.id, &s.provider.name, &s.state, &s.stateReason, &s.activateUntil)
 	return s.id, err
 }
 
@@ -69,9 +189,7 @@ func (s *Sim) ScanRows(row *sql.Rows) (int, error) {
 // It takes a pointer to a sql.Row as parameter and returns an error.
 // Scanned values are stored in the fields of the pointer to the Sim struct.
 func (s *Sim) ScanRow(row *sql.Row) error {
-	err := row.Scan(&s.id, &s.number, &s.provider.id, &s.provider.name, &s.isActivated, &s.activateUntil, &s.isBlocked)
-	fmt.Println("ERROR ON SCAN: ", err)
-	return err
+	return row.Scan(&s.id, &s.number, &s.country, &s.provider.id, &s.provider.name, &s.state, &s.stateReason, &s.activateUntil)
 }
 
 // GetKey returns the map key of the Sim that used in the List.
@@ -0,0 +1,10 @@
+package core
+
+// SimImportResult is the result of the import of one sim.
+type SimImportResult struct {
+	// ID is the id of the created sim, or of the existing sim if the number is a duplicate.
+	// It is 0 for the sims of a rolled back import.
+	ID int
+	// Err is nil for a created sim, repository.ErrAlreadyExists for a duplicate number
+	Err error
+}
@@ -0,0 +1,128 @@
+package core
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// SimSortKey is the field sims are ordered by. Sims with equal keys are ordered by id.
+type SimSortKey string
+
+const (
+	SimSortByID            SimSortKey = "id"
+	SimSortByNumber        SimSortKey = "number"
+	SimSortByActivateUntil SimSortKey = "activate_until"
+)
+
+// SimFilter selects sims by their fields. Zero fields do not filter.
+type SimFilter struct {
+	ProviderID   int
+	ProviderName string
+	IsActivated  *bool
+	IsBlocked    *bool
+	States       []SimState
+	// ActivateUntilBefore and ActivateUntilAfter are exclusive unix timestamps
+	ActivateUntilBefore int64
+	ActivateUntilAfter  int64
+	NumberPrefix        string
+	Country             string
+	// Countries selects the sims of the countries, sims of an unknown country pass too, see Service.SupportsCountry
+	Countries []string
+}
+
+// SimCursor points at the last sim of a page. The next page starts right after it.
+type SimCursor struct {
+	ID            int
+	Number        string
+	ActivateUntil int64
+}
+
+// SimQuery is a filtered, sorted and paginated request for sims.
+type SimQuery struct {
+	Filter     SimFilter
+	SortBy     SimSortKey
+	Descending bool
+	// Limit is the max number of sims to return, 0 means no limit
+	Limit int
+	// After is the cursor of the previous page, nil for the first page
+	After *SimCursor
+}
+
+// Match reports whether the sim passes the filter.
+// Provider names are compared case-insensitively, the same way as in sql.
+func (f SimFilter) Match(s *Sim) bool {
+	if f.ProviderID != 0 && s.Provider().Id() != f.ProviderID {
+		return false
+	}
+	if f.ProviderName != "" && !strings.EqualFold(s.Provider().Name(), f.ProviderName) {
+		return false
+	}
+	if f.IsActivated != nil && s.IsActivated() != *f.IsActivated {
+		return false
+	}
+	if f.IsBlocked != nil && s.IsBlocked() != *f.IsBlocked {
+		return false
+	}
+	if len(f.States) != 0 && !slices.Contains(f.States, s.State()) {
+		return false
+	}
+	if f.ActivateUntilBefore != 0 && s.ActivateUntil() >= f.ActivateUntilBefore {
+		return false
+	}
+	if f.ActivateUntilAfter != 0 && s.ActivateUntil() <= f.ActivateUntilAfter {
+		return false
+	}
+	if f.NumberPrefix != "" && !strings.HasPrefix(s.Number(), f.NumberPrefix) {
+		return false
+	}
+	if f.Country != "" && s.Country() != f.Country {
+		return false
+	}
+	if len(f.Countries) != 0 && s.Country() != "" && !slices.Contains(f.Countries, s.Country()) {
+		return false
+	}
+	return true
+}
+
+// Compare returns a negative number if a goes before b in the query order,
+// a positive number if a goes after b and 0 if they are the same sim.
+func (q SimQuery) Compare(a, b *Sim) int {
+	return q.compareCursor(CursorOf(a), CursorOf(b))
+}
+
+// IsAfterCursor reports whether the sim goes after the cursor of the query.
+// Every sim goes after a nil cursor.
+func (q SimQuery) IsAfterCursor(s *Sim) bool {
+	if q.After == nil {
+		return true
+	}
+	return q.compareCursor(CursorOf(s), *q.After) > 0
+}
+
+func (q SimQuery) compareCursor(a, b SimCursor) int {
+	var c int
+	switch q.SortBy {
+	case SimSortByNumber:
+		c = strings.Compare(a.Number, b.Number)
+	case SimSortByActivateUntil:
+		c = cmp.Compare(a.ActivateUntil, b.ActivateUntil)
+	}
+	if c == 0 {
+		c = cmp.Compare(a.ID, b.ID)
+	}
+
+	if q.Descending {
+		return -c
+	}
+	return c
+}
+
+// CursorOf returns the cursor pointing at the sim.
+func CursorOf(s *Sim) SimCursor {
+	return SimCursor{
+		ID:            s.Id(),
+		Number:        s.Number(),
+		ActivateUntil: s.ActivateUntil(),
+	}
+}
@@ -0,0 +1,116 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// day is the window of UsePolicy.MaxPerDay in seconds
+const day = int64(24 * time.Hour / time.Second)
+
+var (
+	ErrAlreadyUsed   = errors.New("Sim is already used for the service")
+	ErrBlockedOnUse  = errors.New("Sim is blocked on the service")
+	ErrUseNotAllowed = errors.New("Sim can not be used for the service yet")
+)
+
+// UseNotAllowedError is ErrUseNotAllowed with the time the sim can be used for the service.
+// errors.Is(err, ErrUseNotAllowed) reports true for it.
+type UseNotAllowedError struct {
+	// EligibleAt is the unix timestamp the sim can be used for the service at
+	EligibleAt int64
+}
+
+func (e *UseNotAllowedError) Error() string {
+	return fmt.Sprintf("%s, eligible at %d", ErrUseNotAllowed, e.EligibleAt)
+}
+
+func (e *UseNotAllowedError) Unwrap() error {
+	return ErrUseNotAllowed
+}
+
+// UsePolicy limits how often a sim is used for a service.
+type UsePolicy struct {
+	// Cooldown is the time after the release of a sim from the service
+	// before the sim can be used for the service again
+	Cooldown time.Duration
+	// MaxPerDay is the max number of services a sim is registered with within 24 hours, 0 means no limit
+	MaxPerDay int
+}
+
+// Check reports whether the sim with the used records can be used for the service at now.
+// A sim that is still used for the service or blocked on it is never allowed,
+// otherwise the cooldown after the last release and the daily limit are checked.
+//
+// Possibly errors: ErrAlreadyUsed, ErrBlockedOnUse, *UseNotAllowedError.
+func (p UsePolicy) Check(serviceId int, records List[*Used], now int64) error {
+	return p.CheckGroup(serviceId, nil, records, now)
+}
+
+// CheckGroup is Check for a service of a group, the use of a linked service is a use of the service,
+// see ServiceGroup.Linked. A block is only a block on its own service, on a linked service it is a use.
+//
+// Possibly errors: ErrAlreadyUsed, ErrBlockedOnUse, *UseNotAllowedError.
+func (p UsePolicy) CheckGroup(serviceId int, linked []int, records List[*Used], now int64) error {
+	var (
+		eligibleAt int64
+		usedToday  []int64
+		blocked    bool
+		used       bool
+	)
+	for _, u := range records {
+		if u.UsedAt() > now-day {
+			usedToday = append(usedToday, u.UsedAt())
+		}
+		if u.ServiceID() != serviceId && !slices.Contains(linked, u.ServiceID()) {
+			continue
+		}
+
+		switch {
+		case u.IsBlocked() && u.ServiceID() == serviceId:
+			blocked = true
+		case !u.IsReleased():
+			used = true
+		default:
+			eligibleAt = max(eligibleAt, u.ReleasedAt()+int64(p.Cooldown/time.Second))
+		}
+	}
+
+	if blocked {
+		return ErrBlockedOnUse
+	}
+	if used {
+		return ErrAlreadyUsed
+	}
+
+	if p.MaxPerDay > 0 && len(usedToday) >= p.MaxPerDay {
+		// the sim is allowed again when the oldest registration that exceeds the limit leaves the window
+		slices.Sort(usedToday)
+		eligibleAt = max(eligibleAt, usedToday[len(usedToday)-p.MaxPerDay]+day)
+	}
+
+	if eligibleAt > now {
+		return &UseNotAllowedError{EligibleAt: eligibleAt}
+	}
+	return nil
+}
+
+// UsePolicies are the use policies of the services.
+type UsePolicies struct {
+	Default UsePolicy
+	// ByService overrides the default policy by the service name, names are compared case-insensitively
+	ByService map[string]UsePolicy
+}
+
+// For returns the policy of the service with the name.
+func (p UsePolicies) For(serviceName string) UsePolicy {
+	for name, policy := range p.ByService {
+		if strings.EqualFold(name, serviceName) {
+			return policy
+		}
+	}
+	return p.Default
+}
@@ -10,6 +10,12 @@ type Used struct {
 	serviceId   int
 	isBlocked   bool
 	blockedInfo string
+	// blockedAt is the unix timestamp of the block, 0 if the sim is not blocked on the service
+	blockedAt int64
+	// usedAt is the unix timestamp the sim was registered with the service at
+	usedAt int64
+	// releasedAt is the unix timestamp the sim was released from the service at, 0 if it is still used
+	releasedAt int64
 }
 
 func NewUsed(id, simId, serviceId int, isBlocked bool, blockedInfo string) Used {
@@ -41,6 +47,42 @@ func (u *Used) BlockedInfo() string {
 	return u.blockedInfo
 }
 
+func (u *Used) BlockedAt() int64 {
+	return u.blockedAt
+}
+
+func (u *Used) UsedAt() int64 {
+	return u.usedAt
+}
+
+func (u *Used) ReleasedAt() int64 {
+	return u.releasedAt
+}
+
+// IsReleased reports whether the sim was released from the service.
+func (u *Used) IsReleased() bool {
+	return u.releasedAt != 0
+}
+
+// Release marks the sim as released from the service at the unix timestamp at.
+func (u *Used) Release(at int64) {
+	u.releasedAt = at
+}
+
+// Block marks the sim as blocked on the service at the unix timestamp at.
+func (u *Used) Block(reason string, at int64) {
+	u.isBlocked = true
+	u.blockedInfo = reason
+	u.blockedAt = at
+}
+
+// Unblock clears the block of the sim on the service.
+func (u *Used) Unblock() {
+	u.isBlocked = false
+	u.blockedInfo = ""
+	u.blockedAt = 0
+}
+
 // / With
 func (u Used) WithSimID(id int) Used {
 	u.simId = id
@@ -52,6 +94,11 @@ func (u Used) WithServiceID(id int) Used {
 	return u
 }
 
+func (u Used) WithUsedAt(at int64) Used {
+	u.usedAt = at
+	return u
+}
+
 // / Setters
 func (u *Used) SetId(id int) {
 	u.id = id
@@ -68,16 +115,25 @@ func (u *Used) SetIsBlocked(status bool) {
 func (u *Used) SetBlockedInfo(binfo string) {
 	u.blockedInfo = binfo
 }
+func (u *Used) SetBlockedAt(at int64) {
+	u.blockedAt = at
+}
+func (u *Used) SetUsedAt(at int64) {
+	u.usedAt = at
+}
+func (u *Used) SetReleasedAt(at int64) {
+	u.releasedAt = at
+}
 
 // [Scan] return object of [Sim] whitch is [Scannable], and map index [int]
 // If any errors ocured while scanning it will be in [error]
 func (u *Used) ScanRows(row *sql.Rows) (int, error) {
-	err := row.Scan(&u.id, &u.simId, &u.serviceId, &u.isBlocked, &u.blockedInfo)
+	err := row.Scan(&u.id, &u.simId, &u.serviceId, &u.isBlocked, &u.blockedInfo, &u.blockedAt, &u.usedAt, &u.releasedAt)
 	return u.id, err
 }
 
 func (u *Used) ScanRow(row *sql.Row) error {
-	err := row.Scan(&u.id, &u.simId, &u.serviceId, &u.isBlocked, &u.blockedInfo)
+	err := row.Scan(&u.id, &u.simId, &u.serviceId, &u.isBlocked, &u.blockedInfo, &u.blockedAt, &u.usedAt, &u.releasedAt)
 	return err
 }
 
@@ -0,0 +1,112 @@
+package activationrepository
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"simactive/internal/core"
+	"simactive/internal/infrastructure/sqltx"
+	"sync/atomic"
+)
+
+type ActivationInMemRepo interface {
+	SameRepoFuncs
+	Add(ctx context.Context, a *core.Activation) error
+	Load(ctx context.Context, list core.List[*core.Activation]) error
+	RemoveBySimID(ctx context.Context, simId int) error
+}
+
+type ActivationSQLRepo interface {
+	SameRepoFuncs
+	Add(ctx context.Context, a *core.Activation) (id int, err error)
+	GetList(ctx context.Context) (core.List[*core.Activation], error)
+}
+
+type SameRepoFuncs interface {
+	BySimID(ctx context.Context, simId int) (core.List[*core.Activation], error)
+}
+
+// ActivationRepository stores the activation history of sims.
+// The history is append-only, records are never updated or removed.
+type ActivationRepository struct {
+	logger   *slog.Logger
+	db       *sql.DB
+	inMemory ActivationInMemRepo
+	sql      ActivationSQLRepo
+	// loaded is set once the in-memory repository holds all records, see Load
+	loaded atomic.Bool
+}
+
+// NewActivationRepository initializes a new ActivationRepository with the given logger, database, in-memory repository, and SQL repository.
+func NewActivationRepository(logger *slog.Logger, db *sql.DB, inMemory ActivationInMemRepo, sql ActivationSQLRepo) *ActivationRepository {
+	const op = "repository.activation.NewActivationRepository"
+
+	logger.Info("Activation Repository initialized", slog.String("op", op))
+	return &ActivationRepository{
+		logger:   logger,
+		db:       db,
+		inMemory: inMemory,
+		sql:      sql,
+	}
+}
+
+// Add adds a new activation record into sql and into in-memory, after the commit if ctx has a transaction.
+// If errors not occured it will return [ID] of new record
+func (r *ActivationRepository) Add(ctx context.Context, simId int, kind core.ActivationKind, activatedAt, previousUntil, activateUntil int64) (int, error) {
+	a := core.NewActivation(0, simId, kind, activatedAt, previousUntil, activateUntil)
+
+	id, err := r.sql.Add(ctx, &a)
+	if err != nil {
+		return 0, err
+	}
+
+	a.SetKey(id)
+	err = sqltx.AfterCommit(ctx, func() error {
+		return r.inMemory.Add(ctx, &a)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Load caches all activation records of sql in memory, so the history is read from memory afterwards.
+// It is called at the start, before the repository is used.
+func (r *ActivationRepository) Load(ctx context.Context) error {
+	list, err := r.sql.GetList(ctx)
+	if err != nil {
+		return err
+	}
+	if err := r.inMemory.Load(ctx, list); err != nil {
+		return err
+	}
+
+	r.loaded.Store(true)
+	return nil
+}
+
+// BySimID retrieves the activation history of the sim with the given id,
+// from memory once the records are loaded and from sql before, see Load.
+//
+// ctx context.Context, simId int
+// core.List[*core.Activation], error. The list is empty if the sim was never activated.
+func (r *ActivationRepository) BySimID(ctx context.Context, simId int) (core.List[*core.Activation], error) {
+	if r.loaded.Load() {
+		return r.inMemory.BySimID(ctx, simId)
+	}
+	return r.sql.BySimID(ctx, simId)
+}
+
+// EvictSims removes the activation history of the sims from memory once the transaction is committed.
+// It is called for the sims whose history was deleted from sql along with the sims, see SimRepository.Purge.
+func (r *ActivationRepository) EvictSims(ctx context.Context, simIds []int) error {
+	return sqltx.AfterCommit(ctx, func() error {
+		for _, id := range simIds {
+			if err := r.inMemory.RemoveBySimID(ctx, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
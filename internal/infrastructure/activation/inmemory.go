@@ -0,0 +1,131 @@
+package activationrepository
+
+import (
+	"context"
+	"log/slog"
+	"simactive/internal/core"
+	"simactive/internal/infrastructure/repoerrors"
+	"sync"
+)
+
+type ActivationInMemory struct {
+	// mu guards bySim, the workers and the gRPC handlers use the repository concurrently
+	mu sync.RWMutex
+	// bySim indexes activation records by sim id
+	bySim  map[int]core.List[*core.Activation]
+	logger *slog.Logger
+}
+
+func NewActivationInMemoryRepository(logger *slog.Logger) *ActivationInMemory {
+	return &ActivationInMemory{
+		bySim:  make(map[int]core.List[*core.Activation]),
+		logger: logger,
+	}
+}
+
+// Add adds a new activation record to the ActivationInMemory instance.
+//
+// Return:
+//   - ErrAlreadyExists: if the record already exists
+func (i *ActivationInMemory) Add(ctx context.Context, a *core.Activation) error {
+	const op = "ActivationInMemory.Add"
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	simList, ok := i.bySim[a.SimID()]
+	if !ok {
+		simList = make(core.List[*core.Activation])
+		i.bySim[a.SimID()] = simList
+	}
+
+	if _, err := simList.ByID(a.Id()); err == nil {
+		i.logger.Info(
+			"Activation already exists",
+			slog.String("op", op),
+			slog.Int("activation id", a.Id()),
+			slog.Int("sim id", a.SimID()),
+		)
+		return repoerrors.ErrAlreadyExists
+	}
+
+	simList[a.Id()] = a
+
+	i.logger.Info(
+		"Activation successfully added",
+		slog.String("op", op),
+		slog.Int("activation id", a.Id()),
+		slog.Int("sim id", a.SimID()),
+		slog.String("kind", string(a.Kind())),
+		slog.Int64("activateUntil", a.ActivateUntil()),
+	)
+	return nil
+}
+
+// BySimID retrieves the activation records of the sim with the given id.
+//
+// ctx context.Context, simId int
+// core.List[*core.Activation], error
+func (i *ActivationInMemory) BySimID(ctx context.Context, simId int) (core.List[*core.Activation], error) {
+	const op = "ActivationInMemory.BySimID"
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	list := make(core.List[*core.Activation], len(i.bySim[simId]))
+	for id, a := range i.bySim[simId] {
+		list[id] = a
+	}
+
+	i.logger.Info(
+		"Activation list of sim successfully retrieved",
+		slog.String("op", op),
+		slog.Int("sim id", simId),
+		slog.Int("activation count", len(list)),
+	)
+	return list, nil
+}
+
+// Load replaces the cached activation records with the given ones, e.g. with all records of sql at the start.
+func (i *ActivationInMemory) Load(ctx context.Context, list core.List[*core.Activation]) error {
+	const op = "ActivationInMemory.Load"
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.bySim = make(map[int]core.List[*core.Activation])
+	for id, a := range list {
+		simList, ok := i.bySim[a.SimID()]
+		if !ok {
+			simList = make(core.List[*core.Activation])
+			i.bySim[a.SimID()] = simList
+		}
+		simList[id] = a
+	}
+
+	i.logger.Info(
+		"Activation list successfully loaded",
+		slog.String("op", op),
+		slog.Int("activation count", len(list)),
+	)
+	return nil
+}
+
+// RemoveBySimID removes all activation records of the sim with the given id.
+func (i *ActivationInMemory) RemoveBySimID(ctx context.Context, simId int) error {
+	const op = "ActivationInMemory.RemoveBySimID"
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	removed := len(i.bySim[simId])
+	delete(i.bySim, simId)
+
+	i.logger.Info(
+		"Activation list of sim successfully removed",
+		slog.String("op", op),
+		slog.Int("sim id", simId),
+		slog.Int("activation count", removed),
+	)
+	return nil
+}
@@ -0,0 +1,152 @@
+package activationrepository
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"simactive/internal/core"
+	"simactive/internal/infrastructure/sqltx"
+	"simactive/internal/lib/logger/sl"
+)
+
+type ActivationSQL struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewActivationSQLRepository(db *sql.DB, logger *slog.Logger) *ActivationSQL {
+	return &ActivationSQL{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Add adds a new activation record to the database.
+//
+// Returns the ID of the inserted record and an error, if any.
+func (as *ActivationSQL) Add(ctx context.Context, a *core.Activation) (int, error) {
+	const op = "ActivationSQL.Add"
+
+	query := "INSERT INTO sim_activation (sim_id, kind, activated_at, previous_until, activate_until) VALUES (?, ?, ?, ?, ?)"
+	res, err := sqltx.DB(ctx, as.db).ExecContext(ctx, query, a.SimID(), a.Kind(), a.ActivatedAt(), a.PreviousUntil(), a.ActivateUntil())
+	if err != nil {
+		as.logger.Warn(
+			"Failed to add activation",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.Int("sim id", a.SimID()),
+			sl.Err(err),
+		)
+		return 0, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		as.logger.Warn(
+			"Failed to receive last insert id after query",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.Int("sim id", a.SimID()),
+			sl.Err(err),
+		)
+		return 0, err
+	}
+
+	as.logger.Info(
+		"Activation successfully added",
+		slog.String("op", op),
+		slog.Int64("activation id", id),
+		slog.Int("sim id", a.SimID()),
+		slog.String("kind", string(a.Kind())),
+		slog.Int64("activateUntil", a.ActivateUntil()),
+	)
+	return int(id), nil
+}
+
+// BySimID retrieves the activation records of the sim with the given id from the database.
+//
+// ctx context.Context, simId int
+// core.List[*core.Activation], error
+func (as *ActivationSQL) BySimID(ctx context.Context, simId int) (core.List[*core.Activation], error) {
+	const op = "ActivationSQL.BySimID"
+
+	query := "SELECT id, sim_id, kind, activated_at, previous_until, activate_until FROM sim_activation WHERE sim_id = ?"
+	rows, err := sqltx.DB(ctx, as.db).QueryContext(ctx, query, simId)
+	if err != nil {
+		as.logger.Warn(
+			"Failed to get activation list",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.Int("sim id", simId),
+			sl.Err(err),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make(core.List[*core.Activation])
+	for rows.Next() {
+		a := core.Activation{}
+		if _, err = a.ScanRows(rows); err != nil {
+			as.logger.Warn(
+				"Failed to scan activation",
+				slog.String("op", op),
+				slog.String("query", query),
+				sl.Err(err),
+			)
+			return nil, err
+		}
+		list[a.Id()] = &a
+	}
+
+	as.logger.Info(
+		"Activation list successfully retrieved",
+		slog.String("op", op),
+		slog.Int("sim id", simId),
+		slog.Int("activation count", len(list)),
+	)
+	return list, nil
+}
+
+// GetList retrieves the activation records of all sims from the database.
+//
+// ctx context.Context
+// core.List[*core.Activation], error
+func (as *ActivationSQL) GetList(ctx context.Context) (core.List[*core.Activation], error) {
+	const op = "ActivationSQL.GetList"
+
+	query := "SELECT id, sim_id, kind, activated_at, previous_until, activate_until FROM sim_activation"
+	rows, err := sqltx.DB(ctx, as.db).QueryContext(ctx, query)
+	if err != nil {
+		as.logger.Warn(
+			"Failed to get activation list",
+			slog.String("op", op),
+			slog.String("query", query),
+			sl.Err(err),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make(core.List[*core.Activation])
+	for rows.Next() {
+		a := core.Activation{}
+		if _, err = a.ScanRows(rows); err != nil {
+			as.logger.Warn(
+				"Failed to scan activation",
+				slog.String("op", op),
+				slog.String("query", query),
+				sl.Err(err),
+			)
+			return nil, err
+		}
+		list[a.Id()] = &a
+	}
+
+	as.logger.Info(
+		"Activation list successfully retrieved",
+		slog.String("op", op),
+		slog.Int("activation count", len(list)),
+	)
+	return list, nil
+}
@@ -0,0 +1,41 @@
+package auditrepository
+
+import (
+	"context"
+	"log/slog"
+	"simactive/internal/core"
+)
+
+type AuditSQLRepo interface {
+	Add(ctx context.Context, e *core.AuditEntry) (int, error)
+	Query(ctx context.Context, q core.AuditQuery) ([]*core.AuditEntry, error)
+}
+
+// AuditRepository stores the audit log of the mutations.
+// The log is append-only and only read by queries, so it is kept in sql only.
+type AuditRepository struct {
+	logger *slog.Logger
+	sql    AuditSQLRepo
+}
+
+// NewAuditRepository initializes a new AuditRepository with the given logger and SQL repository.
+func NewAuditRepository(logger *slog.Logger, sql AuditSQLRepo) *AuditRepository {
+	const op = "repository.audit.NewAuditRepository"
+
+	logger.Info("Audit Repository initialized", slog.String("op", op))
+	return &AuditRepository{
+		logger: logger,
+		sql:    sql,
+	}
+}
+
+// Add appends the audit entry to the log.
+// If errors not occured it will return [ID] of new entry
+func (r *AuditRepository) Add(ctx context.Context, e *core.AuditEntry) (int, error) {
+	return r.sql.Add(ctx, e)
+}
+
+// Query retrieves the audit entries selected by the query, newest first.
+func (r *AuditRepository) Query(ctx context.Context, q core.AuditQuery) ([]*core.AuditEntry, error) {
+	return r.sql.Query(ctx, q)
+}
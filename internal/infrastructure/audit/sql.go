@@ -0,0 +1,157 @@
+package auditrepository
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"simactive/internal/core"
+	"simactive/internal/infrastructure/sqltx"
+	"simactive/internal/lib/logger/sl"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxActorLength is the length of the audit_log.actor column in characters.
+const maxActorLength = 64
+
+type AuditSQL struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewAuditSQLRepository(db *sql.DB, logger *slog.Logger) *AuditSQL {
+	return &AuditSQL{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Add appends the audit entry to the database.
+// Called with the ctx of a transaction, the entry is committed or rolled back together with the mutation.
+//
+// Returns the ID of the inserted entry and an error, if any.
+func (as *AuditSQL) Add(ctx context.Context, e *core.AuditEntry) (int, error) {
+	const op = "AuditSQL.Add"
+
+	actor := e.Actor()
+	// the column counts characters, the actor is cut on a rune boundary so it stays valid utf-8
+	if utf8.RuneCountInString(actor) > maxActorLength {
+		actor = string([]rune(actor)[:maxActorLength])
+	}
+
+	query := "INSERT INTO audit_log (actor, rpc, action, entity_type, entity_id, before_value, after_value, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	res, err := sqltx.DB(ctx, as.db).ExecContext(ctx, query, actor, e.RPC(), e.Action(), e.EntityType(), e.EntityID(), e.Before(), e.After(), e.CreatedAt())
+	if err != nil {
+		as.logger.Warn(
+			"Failed to add audit entry",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.String("action", e.Action()),
+			slog.Int("entity id", e.EntityID()),
+			sl.Err(err),
+		)
+		return 0, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		as.logger.Warn(
+			"Failed to receive last insert id after query",
+			slog.String("op", op),
+			slog.String("query", query),
+			sl.Err(err),
+		)
+		return 0, err
+	}
+
+	as.logger.Info(
+		"Audit entry successfully added",
+		slog.String("op", op),
+		slog.Int64("audit entry id", id),
+		slog.String("actor", actor),
+		slog.String("action", e.Action()),
+		slog.String("entity type", string(e.EntityType())),
+		slog.Int("entity id", e.EntityID()),
+	)
+	return int(id), nil
+}
+
+// Query retrieves the audit entries selected by the query from the database, newest first.
+//
+// ctx context.Context, q core.AuditQuery
+// []*core.AuditEntry, error
+func (as *AuditSQL) Query(ctx context.Context, q core.AuditQuery) ([]*core.AuditEntry, error) {
+	const op = "AuditSQL.Query"
+
+	var (
+		conditions []string
+		args       []any
+	)
+	if q.EntityType != "" {
+		conditions = append(conditions, "entity_type = ?")
+		args = append(args, q.EntityType)
+	}
+	if q.EntityID != 0 {
+		conditions = append(conditions, "entity_id = ?")
+		args = append(args, q.EntityID)
+	}
+	if q.Actor != "" {
+		conditions = append(conditions, "actor = ?")
+		args = append(args, q.Actor)
+	}
+	if q.From != 0 {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, q.From)
+	}
+	if q.To != 0 {
+		conditions = append(conditions, "created_at <= ?")
+		args = append(args, q.To)
+	}
+
+	query := "SELECT id, actor, rpc, action, entity_type, entity_id, before_value, after_value, created_at FROM audit_log"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id DESC"
+	if q.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, q.Limit)
+	}
+
+	rows, err := sqltx.DB(ctx, as.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		as.logger.Warn(
+			"Failed to query audit log",
+			slog.String("op", op),
+			slog.String("query", query),
+			sl.Err(err),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*core.AuditEntry
+	for rows.Next() {
+		e := core.AuditEntry{}
+		if _, err = e.ScanRows(rows); err != nil {
+			as.logger.Warn(
+				"Failed to scan audit entry",
+				slog.String("op", op),
+				slog.String("query", query),
+				sl.Err(err),
+			)
+			return nil, err
+		}
+		entries = append(entries, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	as.logger.Info(
+		"Audit log successfully queried",
+		slog.String("op", op),
+		slog.Int("entry count", len(entries)),
+	)
+	return entries, nil
+}
@@ -4,14 +4,26 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"maps"
 	"simactive/internal/core"
 	"simactive/internal/infrastructure/repoerrors"
 	"simactive/internal/lib/logger/sl"
+	"slices"
+	"sync"
 )
 
 type ProviderInMemory struct {
+	// mu guards list and aliases, the workers and the gRPC handlers use the repository concurrently
+	mu     sync.RWMutex
 	logger *slog.Logger
 	list   core.List[*core.Provider]
+	// aliases maps the normalized aliases to the providers, see core.ProviderNameKey
+	aliases map[string]providerAlias
+}
+
+type providerAlias struct {
+	alias      string
+	providerId int
 }
 
 // NewProviderInMemory creates a new ProviderInMemory instance.
@@ -19,8 +31,9 @@ type ProviderInMemory struct {
 // It takes a logger as a parameter and returns a pointer to ProviderInMemory.
 func NewProviderInMemory(logger *slog.Logger) *ProviderInMemory {
 	return &ProviderInMemory{
-		logger: logger,
-		list:   make(core.List[*core.Provider]),
+		logger:  logger,
+		list:    make(core.List[*core.Provider]),
+		aliases: make(map[string]providerAlias),
 	}
 }
 
@@ -29,36 +42,37 @@ func NewProviderInMemory(logger *slog.Logger) *ProviderInMemory {
 // Parameters:
 //
 //	ctx context.Context - The context for the operation.
-//	id int - The ID of the provider to add.
-//	name string - The name of the provider to add.
+//	p *core.Provider - The provider to add, with the id it got in sql.
 //
 // Return:
 //
 //	error - An error if the provider already exists, otherwise nil.
-func (im *ProviderInMemory) Add(ctx context.Context, id int, name string) error {
+func (im *ProviderInMemory) Add(ctx context.Context, p *core.Provider) error {
 	const op = "ProviderInMemory.Add"
 
-	if provider, err := im.list.ByID(id); err == nil {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	if provider, err := im.list.ByID(p.Id()); err == nil {
 
 		im.logger.Info(
 			"Provider already exists",
 			slog.String("op", op),
-			slog.Int("provider id", id),
-			slog.String("provider name", name),
+			slog.Int("provider id", p.Id()),
+			slog.String("provider name", p.Name()),
 			slog.Any("provider", *provider),
 		)
 		return repoerrors.ErrAlreadyExists
 
 	}
 
-	p := core.NewProvider(id, name)
-	im.list[id] = &p
+	im.list[p.Id()] = p
 
 	im.logger.Info(
 		"Provider added",
 		slog.String("op", op),
-		slog.Int("provider id", id),
-		slog.String("provider name", name),
+		slog.Int("provider id", p.Id()),
+		slog.String("provider name", p.Name()),
 	)
 	return nil
 }
@@ -70,12 +84,17 @@ func (im *ProviderInMemory) Add(ctx context.Context, id int, name string) error
 func (im *ProviderInMemory) GetList(ctx context.Context) (*core.List[*core.Provider], error) {
 	const op = "ProviderInMemory.GetList"
 
+	im.mu.RLock()
+	defer im.mu.RUnlock()
+
 	im.logger.Info(
 		"Provider list successfully retrieved",
 		slog.String("op", op),
 		slog.Int("provider count", len(im.list)),
 	)
-	return &im.list, nil
+	// a copy, so callers can range over it while the repository changes
+	list := maps.Clone(im.list)
+	return &list, nil
 }
 
 // ByID retrieves a provider by its ID.
@@ -84,6 +103,9 @@ func (im *ProviderInMemory) GetList(ctx context.Context) (*core.List[*core.Provi
 func (im *ProviderInMemory) ByID(ctx context.Context, id int) (*core.Provider, error) {
 	const op = "ProviderInMemory.ByID"
 
+	im.mu.RLock()
+	defer im.mu.RUnlock()
+
 	provider, err := im.list.ByID(id)
 	if err != nil {
 		if errors.Is(err, repoerrors.ErrNotFound) {
@@ -114,6 +136,7 @@ func (im *ProviderInMemory) ByID(ctx context.Context, id int) (*core.Provider, e
 }
 
 // ByName retrieves a provider by name from the ProviderInMemory.
+// Names are matched by core.ProviderNameKey, the oldest provider is returned if several match.
 //
 // ctx - the context
 // name - the name of the provider
@@ -122,10 +145,17 @@ func (im *ProviderInMemory) ByID(ctx context.Context, id int) (*core.Provider, e
 func (im *ProviderInMemory) ByName(ctx context.Context, name string) (*core.Provider, error) {
 	const op = "ProviderInMemory.ByName"
 
-	provider, exists := im.list.ContainsFunc(func(p *core.Provider) bool {
-		return p.Name() == name
-	})
-	if !exists {
+	im.mu.RLock()
+	defer im.mu.RUnlock()
+
+	key := core.ProviderNameKey(name)
+	var provider *core.Provider
+	for _, p := range im.list {
+		if core.ProviderNameKey(p.Name()) == key && (provider == nil || p.Id() < provider.Id()) {
+			provider = p
+		}
+	}
+	if provider == nil {
 
 		im.logger.Info(
 			"Provider does not exist",
@@ -143,11 +173,163 @@ func (im *ProviderInMemory) ByName(ctx context.Context, name string) (*core.Prov
 	return provider, nil
 }
 
-// Remove removes a provider from the ProviderInMemory list by its ID.
+// ByAlias retrieves the provider of the alias, aliases are matched by core.ProviderNameKey.
+//
+// ctx context.Context, alias string
+// *core.Provider, error. Possibly errors: repository.ErrNotFound if no cached provider has the alias.
+func (im *ProviderInMemory) ByAlias(ctx context.Context, alias string) (*core.Provider, error) {
+	const op = "ProviderInMemory.ByAlias"
+
+	im.mu.RLock()
+	defer im.mu.RUnlock()
+
+	a, ok := im.aliases[core.ProviderNameKey(alias)]
+	if !ok {
+		im.logger.Info(
+			"Provider alias does not exist",
+			slog.String("op", op),
+			slog.String("alias", alias),
+		)
+		return nil, repoerrors.ErrNotFound
+	}
+
+	return im.ByID(ctx, a.providerId)
+}
+
+// AddAlias adds an alias of the provider with the given id.
+//
+// ctx context.Context, providerId int, alias string
+// error. Possibly errors: *repository.AlreadyExistsError with the provider id if the alias exists.
+func (im *ProviderInMemory) AddAlias(ctx context.Context, providerId int, alias string) error {
+	const op = "ProviderInMemory.AddAlias"
+
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	key := core.ProviderNameKey(alias)
+	if existing, ok := im.aliases[key]; ok {
+		im.logger.Info(
+			"Provider alias already exists",
+			slog.String("op", op),
+			slog.String("alias", alias),
+		)
+		return &repoerrors.AlreadyExistsError{ID: existing.providerId}
+	}
+
+	im.aliases[key] = providerAlias{alias: alias, providerId: providerId}
+
+	im.logger.Info(
+		"Provider alias added",
+		slog.String("op", op),
+		slog.Int("provider id", providerId),
+		slog.String("alias", alias),
+	)
+	return nil
+}
+
+// RemoveAlias removes the alias.
+//
+// ctx context.Context, alias string
+// error. Possibly errors: repository.ErrNotFound if the alias does not exist.
+func (im *ProviderInMemory) RemoveAlias(ctx context.Context, alias string) error {
+	const op = "ProviderInMemory.RemoveAlias"
+
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	key := core.ProviderNameKey(alias)
+	if _, ok := im.aliases[key]; !ok {
+		im.logger.Info(
+			"Provider alias does not exist",
+			slog.String("op", op),
+			slog.String("alias", alias),
+		)
+		return repoerrors.ErrNotFound
+	}
+
+	delete(im.aliases, key)
+
+	im.logger.Info(
+		"Provider alias removed",
+		slog.String("op", op),
+		slog.String("alias", alias),
+	)
+	return nil
+}
+
+// Aliases retrieves the cached aliases of the provider with the given id, ordered by alias.
+//
+// ctx context.Context, providerId int
+// []string, error
+func (im *ProviderInMemory) Aliases(ctx context.Context, providerId int) ([]string, error) {
+	im.mu.RLock()
+	defer im.mu.RUnlock()
+
+	var aliases []string
+	for _, a := range im.aliases {
+		if a.providerId == providerId {
+			aliases = append(aliases, a.alias)
+		}
+	}
+	slices.Sort(aliases)
+	return aliases, nil
+}
+
+// MoveAliases moves the aliases of the provider with id from to the provider with id to.
+//
+// ctx context.Context, from, to int
+// error
+func (im *ProviderInMemory) MoveAliases(ctx context.Context, from, to int) error {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	for key, a := range im.aliases {
+		if a.providerId == from {
+			a.providerId = to
+			im.aliases[key] = a
+		}
+	}
+	return nil
+}
+
+// Update replaces the provider with the same id in the ProviderInMemory list.
+//
+// ctx context.Context, p *core.Provider
+// error - repository.ErrNotFound if the provider does not exist
+func (im *ProviderInMemory) Update(ctx context.Context, p *core.Provider) error {
+	const op = "ProviderInMemory.Update"
+
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	if _, err := im.list.ByID(p.Id()); err != nil {
+		im.logger.Info(
+			"Provider does not exist",
+			slog.String("op", op),
+			slog.Int("provider id", p.Id()),
+		)
+		return err
+	}
+
+	im.list[p.Id()] = p
+
+	im.logger.Info(
+		"Provider successfully updated",
+		slog.String("op", op),
+		slog.Int("provider id", p.Id()),
+		slog.String("provider name", p.Name()),
+	)
+	return nil
+}
+
+// Remove removes a provider and its aliases from the ProviderInMemory list by its ID.
 // It takes a context and an integer ID as parameters and returns an error.
 func (im *ProviderInMemory) Remove(ctx context.Context, id int) error {
 	const op = "ProviderInMemory.Remove"
 
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
 	_, err := im.list.ByID(id)
 
 	if err != nil {
@@ -170,6 +352,11 @@ func (im *ProviderInMemory) Remove(ctx context.Context, id int) error {
 	}
 
 	delete(im.list, id)
+	for key, a := range im.aliases {
+		if a.providerId == id {
+			delete(im.aliases, key)
+		}
+	}
 
 	im.logger.Info(
 		"Provider successfully removed",
@@ -3,25 +3,34 @@ package providerrepository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
 	"simactive/internal/core"
+	"simactive/internal/infrastructure/repoerrors"
+	"simactive/internal/infrastructure/sqltx"
 )
 
 type ProviderInMemoryRepo interface {
 	SamemRepoFuncs
-	Add(ctx context.Context, id int, name string) error
+	Add(ctx context.Context, p *core.Provider) error
 }
 
 type ProviderSQLRepo interface {
 	SamemRepoFuncs
-	Add(ctx context.Context, name string) (int, error)
+	Add(ctx context.Context, p *core.Provider) (int, error)
 }
 
 type SamemRepoFuncs interface {
 	GetList(ctx context.Context) (*core.List[*core.Provider], error)
 	ByID(ctx context.Context, id int) (*core.Provider, error)
 	ByName(ctx context.Context, name string) (*core.Provider, error)
+	Update(ctx context.Context, p *core.Provider) error
 	Remove(ctx context.Context, id int) error
+	ByAlias(ctx context.Context, alias string) (*core.Provider, error)
+	AddAlias(ctx context.Context, providerId int, alias string) error
+	RemoveAlias(ctx context.Context, alias string) error
+	Aliases(ctx context.Context, providerId int) ([]string, error)
+	MoveAliases(ctx context.Context, from, to int) error
 }
 
 type ProviderRepository struct {
@@ -48,13 +57,19 @@ func NewProviderRepository(logger *slog.Logger, db *sql.DB, inMemory ProviderInM
 	}
 }
 
-func (r *ProviderRepository) Add(ctx context.Context, name string) (int, error) {
-	id, err := r.sql.Add(ctx, name)
+// Add adds a new provider into sql and into in-memory, the id of p is ignored.
+// Inside a transaction the provider gets into in-memory once the transaction is committed.
+func (r *ProviderRepository) Add(ctx context.Context, p *core.Provider) (int, error) {
+	id, err := r.sql.Add(ctx, p)
 	if err != nil {
 		return 0, err
 	}
 
-	err = r.inMemory.Add(ctx, id, name)
+	added := *p
+	added.SetId(id)
+	err = sqltx.AfterCommit(ctx, func() error {
+		return r.inMemory.Add(ctx, &added)
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -97,7 +112,7 @@ func (r *ProviderRepository) ByID(ctx context.Context, id int) (*core.Provider,
 	return r.sql.ByID(ctx, id)
 }
 
-// ByName retrieves a provider by name.
+// ByName retrieves a provider by name, names are matched by core.ProviderNameKey.
 //
 // ctx context.Context, name string. Returns core.Provider, error.
 func (r *ProviderRepository) ByName(ctx context.Context, name string) (*core.Provider, error) {
@@ -107,13 +122,94 @@ func (r *ProviderRepository) ByName(ctx context.Context, name string) (*core.Pro
 	return r.sql.ByName(ctx, name)
 }
 
-// Remove removes an item using the given id.
+// Update updates the provider in sql and then in memory.
+// A provider that is not cached in memory is updated in sql only.
+// Inside a transaction the in-memory provider is updated once the transaction is committed.
+func (r *ProviderRepository) Update(ctx context.Context, p *core.Provider) error {
+	if err := r.sql.Update(ctx, p); err != nil {
+		return err
+	}
+
+	return sqltx.AfterCommit(ctx, func() error {
+		if err := r.inMemory.Update(ctx, p); err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+			return err
+		}
+		return nil
+	})
+}
+
+// Remove removes the provider with the given id from sql and then from memory.
 //
 // Takes a context.Context and an int as parameters.
-// Returns an error.
+// Returns an error. Possibly errors: repository.ErrNotFound if the provider does not exist,
+// repository.ErrInUse if sims still refer to the provider.
 func (r *ProviderRepository) Remove(ctx context.Context, id int) error {
-	if err := r.inMemory.Remove(ctx, id); err != nil {
+	if err := r.sql.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	return sqltx.AfterCommit(ctx, func() error {
+		if err := r.inMemory.Remove(ctx, id); err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+			return err
+		}
+		return nil
+	})
+}
+
+// ByAlias retrieves the provider of the alias, aliases are matched by core.ProviderNameKey.
+//
+// ctx context.Context, alias string
+// *core.Provider, error. Possibly errors: repository.ErrNotFound if no provider has the alias.
+func (r *ProviderRepository) ByAlias(ctx context.Context, alias string) (*core.Provider, error) {
+	if p, err := r.inMemory.ByAlias(ctx, alias); err == nil {
+		return p, nil
+	}
+	return r.sql.ByAlias(ctx, alias)
+}
+
+// AddAlias adds an alias of the provider with the given id into sql and into in-memory.
+// Inside a transaction the alias gets into in-memory once the transaction is committed.
+//
+// Possibly errors: *repository.AlreadyExistsError with the provider id if the alias exists.
+func (r *ProviderRepository) AddAlias(ctx context.Context, providerId int, alias string) error {
+	if err := r.sql.AddAlias(ctx, providerId, alias); err != nil {
+		return err
+	}
+
+	return sqltx.AfterCommit(ctx, func() error {
+		return r.inMemory.AddAlias(ctx, providerId, alias)
+	})
+}
+
+// RemoveAlias removes the alias from sql and then from memory.
+//
+// Possibly errors: repository.ErrNotFound if the alias does not exist.
+func (r *ProviderRepository) RemoveAlias(ctx context.Context, alias string) error {
+	if err := r.sql.RemoveAlias(ctx, alias); err != nil {
 		return err
 	}
-	return r.sql.Remove(ctx, id)
+
+	return sqltx.AfterCommit(ctx, func() error {
+		if err := r.inMemory.RemoveAlias(ctx, alias); err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+			return err
+		}
+		return nil
+	})
+}
+
+// Aliases retrieves the aliases of the provider with the given id, ordered by alias.
+// The aliases are read from sql, the in-memory repository only caches the aliases added since the start.
+func (r *ProviderRepository) Aliases(ctx context.Context, providerId int) ([]string, error) {
+	return r.sql.Aliases(ctx, providerId)
+}
+
+// MoveAliases moves the aliases of the provider with id from to the provider with id to, in sql and then in memory.
+func (r *ProviderRepository) MoveAliases(ctx context.Context, from, to int) error {
+	if err := r.sql.MoveAliases(ctx, from, to); err != nil {
+		return err
+	}
+
+	return sqltx.AfterCommit(ctx, func() error {
+		return r.inMemory.MoveAliases(ctx, from, to)
+	})
 }
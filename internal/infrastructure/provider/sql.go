@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"simactive/internal/core"
 	"simactive/internal/infrastructure/repoerrors"
+	"simactive/internal/infrastructure/sqltx"
 	"simactive/internal/lib/logger/sl"
 
 	"github.com/go-sql-driver/mysql"
@@ -24,16 +25,19 @@ func NewProviderSQL(db *sql.DB, logger *slog.Logger) *ProviderSQL {
 	}
 }
 
-// Add adds a new provider with the given name to the database.
+// selectProvider selects the provider columns in the order of core.Provider.ScanRow.
+const selectProvider = "SELECT id, name, default_activation_period, country, mcc, mnc, monthly_cost, currency FROM provider"
+
+// Add adds a new provider with the name and the metadata of p to the database.
 //
 // ctx is the context for the operation.
-// name is the name of the provider to add.
+// p is the provider to add, its id is ignored.
 // Returns the ID of the newly added provider and any error encountered.
-func (ps *ProviderSQL) Add(ctx context.Context, name string) (int, error) {
+func (ps *ProviderSQL) Add(ctx context.Context, p *core.Provider) (int, error) {
 	const op = "ProviderSQL.Add"
 
-	query := "INSERT INTO provider (name) VALUES (?)"
-	res, err := ps.db.ExecContext(ctx, query, name)
+	query := "INSERT INTO provider (name, name_key, default_activation_period, country, mcc, mnc, monthly_cost, currency) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	res, err := sqltx.DB(ctx, ps.db).ExecContext(ctx, query, p.Name(), core.ProviderNameKey(p.Name()), p.DefaultActivationPeriod(), p.Country(), p.MCC(), p.MNC(), p.MonthlyCost(), p.Currency())
 	if err != nil {
 		var mysqlErr *mysql.MySQLError
 		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
@@ -41,7 +45,7 @@ func (ps *ProviderSQL) Add(ctx context.Context, name string) (int, error) {
 				"Provider already exists",
 				slog.String("op", op),
 				slog.String("query", query),
-				slog.String("provider name", name),
+				slog.String("provider name", p.Name()),
 			)
 			return 0, repoerrors.ErrAlreadyExists
 		}
@@ -49,7 +53,7 @@ func (ps *ProviderSQL) Add(ctx context.Context, name string) (int, error) {
 			"Failed to add provider",
 			slog.String("op", op),
 			slog.String("query", query),
-			slog.String("provider name", name),
+			slog.String("provider name", p.Name()),
 			sl.Err(err),
 		)
 		return 0, err
@@ -61,7 +65,7 @@ func (ps *ProviderSQL) Add(ctx context.Context, name string) (int, error) {
 			"Failed to receive last insert id after query",
 			slog.String("op", op),
 			slog.String("query", query),
-			slog.String("provider name", name),
+			slog.String("provider name", p.Name()),
 			sl.Err(err),
 		)
 		return 0, err
@@ -72,8 +76,8 @@ func (ps *ProviderSQL) Add(ctx context.Context, name string) (int, error) {
 func (ps *ProviderSQL) GetList(ctx context.Context) (*core.List[*core.Provider], error) {
 	const op = "ProviderSQL.GetList"
 
-	query := "SELECT id, name FROM provider"
-	rows, err := ps.db.QueryContext(ctx, query)
+	query := selectProvider
+	rows, err := sqltx.DB(ctx, ps.db).QueryContext(ctx, query)
 	if err != nil {
 		ps.logger.Warn(
 			"Failed to get provider list",
@@ -83,15 +87,12 @@ func (ps *ProviderSQL) GetList(ctx context.Context) (*core.List[*core.Provider],
 		)
 		return nil, err
 	}
+	defer rows.Close()
 
 	providerList := make(core.List[*core.Provider], 0)
 	for rows.Next() {
-		var (
-			id   int
-			name string
-		)
-		err = rows.Scan(&id, &name)
-		if err != nil {
+		p := core.Provider{}
+		if _, err = p.ScanRows(rows); err != nil {
 			ps.logger.Warn(
 				"Failed to scan provider row",
 				slog.String("op", op),
@@ -100,9 +101,7 @@ func (ps *ProviderSQL) GetList(ctx context.Context) (*core.List[*core.Provider],
 			)
 			return nil, err
 		}
-
-		p := core.NewProvider(id, name)
-		providerList[id] = &p
+		providerList[p.Id()] = &p
 	}
 
 	ps.logger.Info(
@@ -115,10 +114,10 @@ func (ps *ProviderSQL) GetList(ctx context.Context) (*core.List[*core.Provider],
 func (ps *ProviderSQL) ByID(ctx context.Context, id int) (*core.Provider, error) {
 	const op = "ProviderSQL.ByID"
 
-	query := "SELECT name FROM provider WHERE id = ?"
+	query := selectProvider + " WHERE id = ?"
 
-	var name string
-	err := ps.db.QueryRowContext(ctx, query, id).Scan(&name)
+	p := core.Provider{}
+	err := p.ScanRow(sqltx.DB(ctx, ps.db).QueryRowContext(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			ps.logger.Info(
@@ -140,19 +139,19 @@ func (ps *ProviderSQL) ByID(ctx context.Context, id int) (*core.Provider, error)
 		return nil, err
 	}
 
-	p := core.NewProvider(id, name)
-
 	ps.logger.Info(
 		"Provider successfully retrieved",
 		slog.String("op", op),
 		slog.Int("provider id", id),
-		slog.String("provider name", name),
+		slog.String("provider name", p.Name()),
 		slog.Any("provider", p),
 	)
 	return &p, nil
 }
 
 // ByName retrieves a provider by name from the database.
+// Names are matched by core.ProviderNameKey. The read locks the row, so inside a transaction
+// it also finds a provider that a concurrent transaction added after the transaction started.
 //
 // ctx: the context for the operation.
 // name: the name of the provider to retrieve.
@@ -160,10 +159,10 @@ func (ps *ProviderSQL) ByID(ctx context.Context, id int) (*core.Provider, error)
 func (ps *ProviderSQL) ByName(ctx context.Context, name string) (*core.Provider, error) {
 	const op = "ProviderSQL.ByName"
 
-	query := "SELECT id FROM provider WHERE name = ?"
+	query := selectProvider + " WHERE name_key = ? FOR SHARE"
 
-	var id int
-	err := ps.db.QueryRowContext(ctx, query, name).Scan(&id)
+	p := core.Provider{}
+	err := p.ScanRow(sqltx.DB(ctx, ps.db).QueryRowContext(ctx, query, core.ProviderNameKey(name)))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			ps.logger.Info(
@@ -184,29 +183,91 @@ func (ps *ProviderSQL) ByName(ctx context.Context, name string) (*core.Provider,
 		)
 		return nil, err
 	}
-	p := core.NewProvider(id, name)
 
 	ps.logger.Info(
 		"Provider successfully retrieved",
 		slog.String("op", op),
-		slog.Int("provider id", id),
+		slog.Int("provider id", p.Id()),
 		slog.String("provider name", name),
 		slog.Any("provider", p),
 	)
 	return &p, nil
 }
 
-// Remove removes a provider from the database by ID.
+// Update updates the name and the metadata of the provider in the database.
+// Possibly errors: repository.ErrAlreadyExists if another provider has the name key.
+//
+// ctx context.Context, p *core.Provider
+// error
+func (ps *ProviderSQL) Update(ctx context.Context, p *core.Provider) error {
+	const op = "ProviderSQL.Update"
+
+	query := "UPDATE provider SET name = ?, name_key = ?, default_activation_period = ?, country = ?, mcc = ?, mnc = ?, monthly_cost = ?, currency = ? WHERE id = ?"
+	_, err := sqltx.DB(ctx, ps.db).ExecContext(ctx, query, p.Name(), core.ProviderNameKey(p.Name()), p.DefaultActivationPeriod(), p.Country(), p.MCC(), p.MNC(), p.MonthlyCost(), p.Currency(), p.Id())
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			ps.logger.Info(
+				"Provider already exists",
+				slog.String("op", op),
+				slog.String("query", query),
+				slog.String("provider name", p.Name()),
+			)
+			return repoerrors.ErrAlreadyExists
+		}
+		ps.logger.Warn(
+			"Failed to update provider",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.Int("provider id", p.Id()),
+			slog.String("provider name", p.Name()),
+			sl.Err(err),
+		)
+		return err
+	}
+
+	ps.logger.Info(
+		"Provider successfully updated",
+		slog.String("op", op),
+		slog.Int("provider id", p.Id()),
+		slog.String("provider name", p.Name()),
+		slog.Int64("default activation period", p.DefaultActivationPeriod()),
+	)
+	return nil
+}
+
+// Remove removes a provider and its aliases from the database by ID.
 //
 // ctx: the context for the database operation.
 // id: the ID of the provider to remove.
-// error: returns an error if the operation fails.
+// error: returns an error if the operation fails, repository.ErrInUse if sims refer to the provider.
 func (ps *ProviderSQL) Remove(ctx context.Context, id int) error {
 	const op = "ProviderSQL.Remove"
 
+	aliasQuery := "DELETE FROM provider_alias WHERE provider_id = ?"
+	if _, err := sqltx.DB(ctx, ps.db).ExecContext(ctx, aliasQuery, id); err != nil {
+		ps.logger.Warn(
+			"Failed to remove provider aliases",
+			slog.String("op", op),
+			slog.String("query", aliasQuery),
+			slog.Int("provider id", id),
+			sl.Err(err),
+		)
+		return err
+	}
+
 	query := "DELETE FROM provider WHERE id = ?"
-	res, err := ps.db.ExecContext(ctx, query, id)
+	res, err := sqltx.DB(ctx, ps.db).ExecContext(ctx, query, id)
 	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1451 {
+			ps.logger.Info(
+				"Provider is referenced by sims",
+				slog.String("op", op),
+				slog.Int("provider id", id),
+			)
+			return repoerrors.ErrInUse
+		}
 		ps.logger.Warn(
 			"Failed to remove provider",
 			slog.String("op", op),
@@ -246,3 +307,210 @@ func (ps *ProviderSQL) Remove(ctx context.Context, id int) error {
 	)
 	return nil
 }
+
+// ByAlias retrieves the provider of the alias from the database, aliases are matched by core.ProviderNameKey.
+//
+// ctx context.Context, alias string
+// *core.Provider, error. Possibly errors: repository.ErrNotFound if no provider has the alias.
+func (ps *ProviderSQL) ByAlias(ctx context.Context, alias string) (*core.Provider, error) {
+	const op = "ProviderSQL.ByAlias"
+
+	query := selectProvider + " WHERE id = (SELECT provider_id FROM provider_alias WHERE alias_key = ?)"
+
+	p := core.Provider{}
+	err := p.ScanRow(sqltx.DB(ctx, ps.db).QueryRowContext(ctx, query, core.ProviderNameKey(alias)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ps.logger.Info(
+				"Provider alias does not exist",
+				slog.String("op", op),
+				slog.String("alias", alias),
+			)
+			return nil, repoerrors.ErrNotFound
+		}
+
+		ps.logger.Warn(
+			"Failed to get provider by alias",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.String("alias", alias),
+			sl.Err(err),
+		)
+		return nil, err
+	}
+
+	ps.logger.Info(
+		"Provider successfully retrieved by alias",
+		slog.String("op", op),
+		slog.Int("provider id", p.Id()),
+		slog.String("alias", alias),
+	)
+	return &p, nil
+}
+
+// AddAlias adds an alias of the provider with the given id to the database.
+//
+// ctx context.Context, providerId int, alias string
+// error. Possibly errors: *repository.AlreadyExistsError with the provider id if the alias exists.
+func (ps *ProviderSQL) AddAlias(ctx context.Context, providerId int, alias string) error {
+	const op = "ProviderSQL.AddAlias"
+
+	query := "INSERT INTO provider_alias (alias_key, alias, provider_id) VALUES (?, ?, ?)"
+	_, err := sqltx.DB(ctx, ps.db).ExecContext(ctx, query, core.ProviderNameKey(alias), alias, providerId)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			ps.logger.Info(
+				"Provider alias already exists",
+				slog.String("op", op),
+				slog.String("alias", alias),
+			)
+			existing, err := ps.ByAlias(ctx, alias)
+			if err != nil {
+				return err
+			}
+			return &repoerrors.AlreadyExistsError{ID: existing.Id()}
+		}
+
+		ps.logger.Warn(
+			"Failed to add provider alias",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.Int("provider id", providerId),
+			slog.String("alias", alias),
+			sl.Err(err),
+		)
+		return err
+	}
+
+	ps.logger.Info(
+		"Provider alias successfully added",
+		slog.String("op", op),
+		slog.Int("provider id", providerId),
+		slog.String("alias", alias),
+	)
+	return nil
+}
+
+// RemoveAlias removes the alias from the database.
+//
+// ctx context.Context, alias string
+// error. Possibly errors: repository.ErrNotFound if the alias does not exist.
+func (ps *ProviderSQL) RemoveAlias(ctx context.Context, alias string) error {
+	const op = "ProviderSQL.RemoveAlias"
+
+	query := "DELETE FROM provider_alias WHERE alias_key = ?"
+	res, err := sqltx.DB(ctx, ps.db).ExecContext(ctx, query, core.ProviderNameKey(alias))
+	if err != nil {
+		ps.logger.Warn(
+			"Failed to remove provider alias",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.String("alias", alias),
+			sl.Err(err),
+		)
+		return err
+	}
+
+	affectedRows, err := res.RowsAffected()
+	if err != nil {
+		ps.logger.Warn(
+			"Failed to receive affected rows after query",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.String("alias", alias),
+			sl.Err(err),
+		)
+		return err
+	}
+
+	if affectedRows == 0 {
+		ps.logger.Info(
+			"Provider alias does not exist",
+			slog.String("op", op),
+			slog.String("alias", alias),
+		)
+		return repoerrors.ErrNotFound
+	}
+
+	ps.logger.Info(
+		"Provider alias successfully removed",
+		slog.String("op", op),
+		slog.String("alias", alias),
+	)
+	return nil
+}
+
+// Aliases retrieves the aliases of the provider with the given id from the database, ordered by alias.
+//
+// ctx context.Context, providerId int
+// []string, error
+func (ps *ProviderSQL) Aliases(ctx context.Context, providerId int) ([]string, error) {
+	const op = "ProviderSQL.Aliases"
+
+	query := "SELECT alias FROM provider_alias WHERE provider_id = ? ORDER BY alias"
+	rows, err := sqltx.DB(ctx, ps.db).QueryContext(ctx, query, providerId)
+	if err != nil {
+		ps.logger.Warn(
+			"Failed to get provider aliases",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.Int("provider id", providerId),
+			sl.Err(err),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var aliases []string
+	for rows.Next() {
+		var alias string
+		if err := rows.Scan(&alias); err != nil {
+			ps.logger.Warn(
+				"Failed to scan provider alias",
+				slog.String("op", op),
+				slog.String("query", query),
+				sl.Err(err),
+			)
+			return nil, err
+		}
+		aliases = append(aliases, alias)
+	}
+
+	ps.logger.Info(
+		"Provider aliases successfully retrieved",
+		slog.String("op", op),
+		slog.Int("provider id", providerId),
+		slog.Int("alias count", len(aliases)),
+	)
+	return aliases, rows.Err()
+}
+
+// MoveAliases moves the aliases of the provider with id from to the provider with id to.
+//
+// ctx context.Context, from, to int
+// error
+func (ps *ProviderSQL) MoveAliases(ctx context.Context, from, to int) error {
+	const op = "ProviderSQL.MoveAliases"
+
+	query := "UPDATE provider_alias SET provider_id = ? WHERE provider_id = ?"
+	if _, err := sqltx.DB(ctx, ps.db).ExecContext(ctx, query, to, from); err != nil {
+		ps.logger.Warn(
+			"Failed to move provider aliases",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.Int("from provider id", from),
+			slog.Int("to provider id", to),
+			sl.Err(err),
+		)
+		return err
+	}
+
+	ps.logger.Info(
+		"Provider aliases successfully moved",
+		slog.String("op", op),
+		slog.Int("from provider id", from),
+		slog.Int("to provider id", to),
+	)
+	return nil
+}
@@ -1,8 +1,27 @@
 package repoerrors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrAlreadyExists = errors.New("Already exists")
 	ErrNotFound      = errors.New("Not found")
+	// ErrInUse is returned when a record can not be removed, because other records refer to it
+	ErrInUse = errors.New("In use")
 )
+
+// AlreadyExistsError is ErrAlreadyExists with the id of the existing record.
+// errors.Is(err, ErrAlreadyExists) reports true for it.
+type AlreadyExistsError struct {
+	ID int
+}
+
+func (e *AlreadyExistsError) Error() string {
+	return fmt.Sprintf("%s with id %d", ErrAlreadyExists, e.ID)
+}
+
+func (e *AlreadyExistsError) Unwrap() error {
+	return ErrAlreadyExists
+}
@@ -1,23 +1,33 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"log/slog"
+	activationrepository "simactive/internal/infrastructure/activation"
+	auditrepository "simactive/internal/infrastructure/audit"
 	providerrepository "simactive/internal/infrastructure/provider"
 	servicerepository "simactive/internal/infrastructure/service"
 	simrepository "simactive/internal/infrastructure/sim"
+	"simactive/internal/infrastructure/sqltx"
 	usedrepository "simactive/internal/infrastructure/used"
 )
 
 type Repository struct {
-	SimRepository      *simrepository.SimRepository
-	ServiceRepository  *servicerepository.ServiceRepository
-	ProviderRepository *providerrepository.ProviderRepository
-	UsedRepository     *usedrepository.UsedRepository
+	SimRepository        *simrepository.SimRepository
+	ServiceRepository    *servicerepository.ServiceRepository
+	ProviderRepository   *providerrepository.ProviderRepository
+	UsedRepository       *usedrepository.UsedRepository
+	ActivationRepository *activationrepository.ActivationRepository
+	AuditRepository      *auditrepository.AuditRepository
+
+	db *sql.DB
 }
 
 func NewRepository(logger *slog.Logger, db *sql.DB) *Repository {
 	return &Repository{
+		db: db,
+
 		SimRepository: simrepository.NewSimRepository(
 			logger,
 			db,
@@ -44,5 +54,31 @@ func NewRepository(logger *slog.Logger, db *sql.DB) *Repository {
 			usedrepository.NewUsedInMemoryRepository(logger),
 			usedrepository.NewUsedSQLRepository(db, logger),
 		),
+		ActivationRepository: activationrepository.NewActivationRepository(
+			logger,
+			db,
+			activationrepository.NewActivationInMemoryRepository(logger),
+			activationrepository.NewActivationSQLRepository(db, logger),
+		),
+		AuditRepository: auditrepository.NewAuditRepository(
+			logger,
+			auditrepository.NewAuditSQLRepository(db, logger),
+		),
+	}
+}
+
+// Load fills the in-memory repositories that serve lists of records with all records of sql.
+// It is called once at the start, before the repositories are used.
+func (r *Repository) Load(ctx context.Context) error {
+	if err := r.UsedRepository.Load(ctx); err != nil {
+		return err
 	}
+	return r.ActivationRepository.Load(ctx)
+}
+
+// InTx runs fn inside one sql transaction. The repositories called with the ctx of fn take part in it,
+// their in-memory repositories get the added records only after the commit.
+// The transaction is committed if fn returns nil and rolled back otherwise.
+func (r *Repository) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return sqltx.Run(ctx, r.db, fn)
 }
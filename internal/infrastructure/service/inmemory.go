@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"maps"
 	"simactive/internal/core"
 	"simactive/internal/infrastructure/repoerrors"
 	"simactive/internal/lib/logger/sl"
+	"sync"
 )
 
 type ServiceInMemory struct {
+	// mu guards list, the workers and the gRPC handlers use the repository concurrently
+	mu     sync.RWMutex
 	list   core.List[*core.Service]
 	logger *slog.Logger
 }
@@ -24,30 +28,31 @@ func NewServiceInMemoryRepository(logger *slog.Logger) *ServiceInMemory {
 // Add adds a new service to the ServiceInMemory list if it doesn't already exist.
 //
 // ctx: the context.Context for the operation.
-// serviceId: the ID of the service to be added.
-// name: the name of the service to be added.
+// s: the service to be added, its ID must be set.
 // error: returns an error if the service already exists.
-func (si *ServiceInMemory) Add(ctx context.Context, serviceId int, name string) error {
+func (si *ServiceInMemory) Add(ctx context.Context, s *core.Service) error {
 	const op = "ServiceInMemory.Add"
 
-	if service, err := si.list.ByID(serviceId); err == nil {
+	si.mu.Lock()
+	defer si.mu.Unlock()
+
+	if service, err := si.list.ByID(s.Id()); err == nil {
 		si.logger.Info(
 			"Service already exists",
 			slog.String("op", op),
-			slog.Int("service id", serviceId),
+			slog.Int("service id", s.Id()),
 			slog.Any("service", *service),
 		)
 		return repoerrors.ErrAlreadyExists
 	}
 
-	s := core.NewService(serviceId, name)
-	si.list[serviceId] = &s
+	si.list[s.Id()] = s
 
 	si.logger.Info(
 		"Service added in memory",
 		slog.String("op", op),
-		slog.Int("service id", serviceId),
-		slog.String("service name", name),
+		slog.Int("service id", s.Id()),
+		slog.String("service name", s.Name()),
 	)
 	return nil
 }
@@ -60,6 +65,9 @@ func (si *ServiceInMemory) Add(ctx context.Context, serviceId int, name string)
 func (si *ServiceInMemory) Remove(ctx context.Context, id int) error {
 	const op = "ServiceInMemory.Remove"
 
+	si.mu.Lock()
+	defer si.mu.Unlock()
+
 	service, err := si.list.ByID(id)
 	if err != nil {
 		if errors.Is(err, repoerrors.ErrNotFound) {
@@ -98,12 +106,17 @@ func (si *ServiceInMemory) Remove(ctx context.Context, id int) error {
 func (si *ServiceInMemory) GetList(ctx context.Context) (*core.List[*core.Service], error) {
 	const op = "ServiceInMemory.GetList"
 
+	si.mu.RLock()
+	defer si.mu.RUnlock()
+
 	si.logger.Info(
 		"Service list successfully retrieved",
 		slog.String("op", op),
 		slog.Int("service count", len(si.list)),
 	)
-	return &si.list, nil
+	// a copy, so callers can range over it while the repository changes
+	list := maps.Clone(si.list)
+	return &list, nil
 }
 
 // Update updates a service in memory.
@@ -114,6 +127,9 @@ func (si *ServiceInMemory) GetList(ctx context.Context) (*core.List[*core.Servic
 func (si *ServiceInMemory) Update(ctx context.Context, s *core.Service) error {
 	const op = "ServiceInMemory.Update"
 
+	si.mu.Lock()
+	defer si.mu.Unlock()
+
 	_, err := si.list.ByID(s.Id())
 	if err != nil {
 		if errors.Is(err, repoerrors.ErrNotFound) {
@@ -144,3 +160,43 @@ func (si *ServiceInMemory) Update(ctx context.Context, s *core.Service) error {
 	)
 	return nil
 }
+
+// ByID retrieves a service from memory by its ID.
+//
+// ctx: context.Context
+// id: int - the ID of the service to retrieve
+// Returns the service and an error if the service with the given ID is not found.
+func (si *ServiceInMemory) ByID(ctx context.Context, id int) (*core.Service, error) {
+	const op = "ServiceInMemory.ByID"
+
+	si.mu.RLock()
+	defer si.mu.RUnlock()
+
+	service, err := si.list.ByID(id)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			si.logger.Info(
+				"Service does not exist",
+				slog.String("op", op),
+				slog.Int("service id", id),
+			)
+			return nil, repoerrors.ErrNotFound
+		}
+
+		si.logger.Error(
+			"Failed to retrieve service",
+			slog.String("op", op),
+			slog.Int("service id", id),
+			sl.Err(err),
+		)
+		return nil, err
+	}
+
+	si.logger.Info(
+		"Service successfully retrieved",
+		slog.String("op", op),
+		slog.Int("service id", id),
+		slog.Any("service", *service),
+	)
+	return service, nil
+}
@@ -3,24 +3,32 @@ package servicerepository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
 	"simactive/internal/core"
+	"simactive/internal/infrastructure/repoerrors"
+	"simactive/internal/infrastructure/sqltx"
+	"slices"
 )
 
 type ServiceInMemRepo interface {
 	SameRepoFuncs
-	Add(ctx context.Context, serviceId int, name string) (err error)
+	Add(ctx context.Context, s *core.Service) (err error)
 }
 
 type ServiceSQLRepo interface {
 	SameRepoFuncs
-	Add(ctx context.Context, name string) (serviceId int, err error)
+	Add(ctx context.Context, s *core.Service) (serviceId int, err error)
+	ByGroup(ctx context.Context, groupId int) (*core.List[*core.Service], error)
+	Restore(ctx context.Context, id int) error
+	Purge(ctx context.Context, deletedBefore int64) ([]int, error)
 }
 
 type SameRepoFuncs interface {
 	Remove(ctx context.Context, id int) (err error)
 	GetList(ctx context.Context) (*core.List[*core.Service], error)
 	Update(ctx context.Context, s *core.Service) error
+	ByID(ctx context.Context, id int) (*core.Service, error)
 }
 
 type ServiceRepository struct {
@@ -44,16 +52,20 @@ func NewServiceRepository(logger *slog.Logger, db *sql.DB, serviceInMemory Servi
 // Add adds a new service to the repository.
 //
 // ctx: the context for the operation.
-// name: the name of the service to add.
+// s: the service to add.
 // Returns the service ID and an error if any.  Possibly errors: repository.ErrAlreadyExists.
-func (sr *ServiceRepository) Add(ctx context.Context, name string) (serviceId int, err error) {
+func (sr *ServiceRepository) Add(ctx context.Context, s *core.Service) (serviceId int, err error) {
 
-	id, err := sr.sql.Add(ctx, name)
+	id, err := sr.sql.Add(ctx, s)
 	if err != nil {
 		return 0, err
 	}
 
-	err = sr.inMemory.Add(ctx, id, name)
+	added := *s
+	added.SetID(id)
+	err = sqltx.AfterCommit(ctx, func() error {
+		return sr.inMemory.Add(ctx, &added)
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -61,22 +73,23 @@ func (sr *ServiceRepository) Add(ctx context.Context, name string) (serviceId in
 	return id, nil
 }
 
-// Remove removes an item with the given ID from the ServiceRepository.
+// Remove marks the service with the given ID as deleted in sql and removes it from memory.
 //
 // ctx: context.Context - The context for the operation.
 // id: int - The ID of the item to be removed.
-// error - Returns an error if any occurred during the removal process.  Possibly errors: repository.ErrNotFound.
+// error - Returns an error if any occurred during the removal process. Possibly errors: repository.ErrNotFound.
 func (sr *ServiceRepository) Remove(ctx context.Context, id int) (err error) {
 
-	if err = sr.inMemory.Remove(ctx, id); err != nil {
-		return err
-	}
-
 	if err = sr.sql.Remove(ctx, id); err != nil {
 		return err
 	}
 
-	return nil
+	return sqltx.AfterCommit(ctx, func() error {
+		if err := sr.inMemory.Remove(ctx, id); err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+			return err
+		}
+		return nil
+	})
 }
 
 // GetList retrieves a list of services from the ServiceRepository.
@@ -104,15 +117,97 @@ func (sr *ServiceRepository) GetList(ctx context.Context) (*core.List[*core.Serv
 //
 // ctx: the context.Context for the operation.
 // s: the *core.Service to be updated.
-// error: returns an error if the update operation fails. Possibly errors: repository.ErrNotFound
+// error: returns an error if the update operation fails.
+// The service is updated in memory only if it is cached there.
 func (sr *ServiceRepository) Update(ctx context.Context, s *core.Service) error {
-	if err := sr.inMemory.Update(ctx, s); err != nil {
+	if err := sr.sql.Update(ctx, s); err != nil {
 		return err
 	}
 
-	if err := sr.sql.Update(ctx, s); err != nil {
-		return err
+	return sqltx.AfterCommit(ctx, func() error {
+		if err := sr.inMemory.Update(ctx, s); err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+			return err
+		}
+		return nil
+	})
+}
+
+// ByID retrieves a service by its ID.
+//
+// ctx: the context.Context for the operation.
+// id: the ID of the service to retrieve.
+// Returns the service and an error, if any. Possibly errors: repository.ErrNotFound
+func (sr *ServiceRepository) ByID(ctx context.Context, id int) (*core.Service, error) {
+	if s, err := sr.inMemory.ByID(ctx, id); err == nil {
+		return s, nil
+	}
+
+	return sr.sql.ByID(ctx, id)
+}
+
+// ByGroup retrieves the services of the group with the given id, the top level service and its children.
+// The group is read from sql, the in-memory list may miss services of the group.
+func (sr *ServiceRepository) ByGroup(ctx context.Context, groupId int) (*core.List[*core.Service], error) {
+	return sr.sql.ByGroup(ctx, groupId)
+}
+
+// Restore clears the deletion of the service with the given id and caches it in memory again.
+// Returns the restored service. Possibly errors: repository.ErrNotFound if there is no deleted service with the id.
+func (sr *ServiceRepository) Restore(ctx context.Context, id int) (*core.Service, error) {
+	if err := sr.sql.Restore(ctx, id); err != nil {
+		return nil, err
 	}
 
-	return nil
+	s, err := sr.sql.ByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the cached copy is set after the commit, a restore that is rolled back leaves the memory as it was
+	cached := *s
+	err = sqltx.AfterCommit(ctx, func() error {
+		if err := sr.inMemory.Add(ctx, &cached); err != nil && !errors.Is(err, repoerrors.ErrAlreadyExists) {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Purge deletes the services deleted before the unix timestamp with their used records from sql.
+// Deleted services are not in memory, their cached children become top level services once the transaction is committed.
+// The cached used records of the returned service ids are left to the caller, see UsedRepository.EvictServices.
+func (sr *ServiceRepository) Purge(ctx context.Context, deletedBefore int64) ([]int, error) {
+	ids, err := sr.sql.Purge(ctx, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sqltx.AfterCommit(ctx, func() error {
+		cached, err := sr.inMemory.GetList(ctx)
+		if err != nil {
+			return err
+		}
+
+		noParent := 0
+		for _, s := range *cached {
+			if !slices.Contains(ids, s.ParentID()) {
+				continue
+			}
+			// update a copy, the cached service may be held by a caller
+			updated := *s
+			core.ServiceUpdate{ParentID: &noParent}.Apply(&updated)
+			if err := sr.inMemory.Update(ctx, &updated); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
 }
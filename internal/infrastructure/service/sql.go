@@ -7,11 +7,18 @@ import (
 	"log/slog"
 	"simactive/internal/core"
 	"simactive/internal/infrastructure/repoerrors"
+	"simactive/internal/infrastructure/sqltx"
 	"simactive/internal/lib/logger/sl"
+	"strings"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
 )
 
+// selectService selects the columns of the not deleted services in the order of core.Service.ScanRow.
+// Conditions are appended with AND.
+const selectService = "SELECT id, name, url, category, verification_type, countries, enabled, COALESCE(parent_id, 0) FROM service WHERE deleted_at = 0"
+
 type ServiceSQL struct {
 	logger *slog.Logger
 	db     *sql.DB
@@ -24,16 +31,17 @@ func NewServiceSQLRepository(db *sql.DB, logger *slog.Logger) *ServiceSQL {
 	}
 }
 
-// Add adds a new service with the given name to the database.
+// Add adds a new service with its metadata to the database.
 //
 // ctx: the context for the request
-// name: the name of the service to be added
+// s: the service to be added
 // Returns the ID of the newly added service and an error, if any
-func (ss *ServiceSQL) Add(ctx context.Context, name string) (int, error) {
+func (ss *ServiceSQL) Add(ctx context.Context, s *core.Service) (int, error) {
 	const op = "ServiceSQL.Add"
 
-	query := "INSERT INTO service (name) VALUES (?)"
-	res, err := ss.db.ExecContext(ctx, query, name)
+	name := s.Name()
+	query := "INSERT INTO service (name, url, category, verification_type, countries, enabled, parent_id) VALUES (?, ?, ?, ?, ?, ?, NULLIF(?, 0))"
+	res, err := sqltx.DB(ctx, ss.db).ExecContext(ctx, query, name, s.URL(), s.Category(), s.VerificationType(), core.JoinCountries(s.Countries()), s.Enabled(), s.ParentID())
 	if err != nil {
 
 		var mysqlErr *mysql.MySQLError
@@ -81,15 +89,17 @@ func (ss *ServiceSQL) Add(ctx context.Context, name string) (int, error) {
 	return int(id), nil
 }
 
-// Remove removes a service from the database based on the provided ID.
+// Remove marks a service as deleted at now, it is skipped by the lookups until it is restored or purged.
+// The used records of the service are kept.
 // ctx - the context for the operation.
 // id - the ID of the service to remove.
-// error - returns an error if the removal operation encounters any issues.
+// error - returns an error if the removal operation encounters any issues,
+// repository.ErrNotFound if there is no not deleted service with the id.
 func (ss *ServiceSQL) Remove(ctx context.Context, id int) error {
 	const op = "ServiceSQL.Remove"
 
-	query := "DELETE FROM service WHERE id = ?"
-	res, err := ss.db.ExecContext(ctx, query, id)
+	query := "UPDATE service SET deleted_at = ? WHERE id = ? AND deleted_at = 0"
+	res, err := sqltx.DB(ctx, ss.db).ExecContext(ctx, query, time.Now().Unix(), id)
 	if err != nil {
 
 		ss.logger.Warn(
@@ -133,6 +143,116 @@ func (ss *ServiceSQL) Remove(ctx context.Context, id int) error {
 	return nil
 }
 
+// Restore clears the deletion of a service.
+//
+// ctx - the context for the operation.
+// id - the ID of the service to restore.
+// error - repository.ErrNotFound if there is no deleted service with the id.
+func (ss *ServiceSQL) Restore(ctx context.Context, id int) error {
+	const op = "ServiceSQL.Restore"
+
+	query := "UPDATE service SET deleted_at = 0 WHERE id = ? AND deleted_at != 0"
+	res, err := sqltx.DB(ctx, ss.db).ExecContext(ctx, query, id)
+	if err != nil {
+		ss.logger.Warn(
+			"Failed to restore service",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.Int("service id", id),
+			sl.Err(err),
+		)
+		return err
+	}
+
+	affectedRows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affectedRows == 0 {
+		ss.logger.Info(
+			"Deleted service does not exist",
+			slog.String("op", op),
+			slog.Int("service id", id),
+		)
+		return repoerrors.ErrNotFound
+	}
+
+	ss.logger.Info(
+		"Service successfully restored",
+		slog.String("op", op),
+		slog.Int("service id", id),
+	)
+	return nil
+}
+
+// Purge deletes the services deleted before the unix timestamp with their used records,
+// the children of a purged service become top level services.
+// It is supposed to run in a transaction, see repository.InTx.
+//
+// ctx - the context for the operation.
+// deletedBefore - the unix timestamp the services were deleted before.
+// Returns the ids of the purged services and an error, if any.
+func (ss *ServiceSQL) Purge(ctx context.Context, deletedBefore int64) ([]int, error) {
+	const op = "ServiceSQL.Purge"
+
+	// mysql can not update the service table with a subquery of it, so the ids are selected first
+	query := "SELECT id FROM service WHERE deleted_at != 0 AND deleted_at < ?"
+	rows, err := sqltx.DB(ctx, ss.db).QueryContext(ctx, query, deletedBefore)
+	if err != nil {
+		ss.logger.Warn(
+			"Failed to get deleted services",
+			slog.String("op", op),
+			slog.String("query", query),
+			sl.Err(err),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	var args []any
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+		args = append(args, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	in := "(?" + strings.Repeat(", ?", len(ids)-1) + ")"
+	queries := []string{
+		"UPDATE service SET parent_id = NULL WHERE parent_id IN " + in,
+		"DELETE FROM used_service WHERE service_id IN " + in,
+		"DELETE FROM service WHERE id IN " + in,
+	}
+	for _, query := range queries {
+		if _, err := sqltx.DB(ctx, ss.db).ExecContext(ctx, query, args...); err != nil {
+			ss.logger.Warn(
+				"Failed to purge services",
+				slog.String("op", op),
+				slog.String("query", query),
+				sl.Err(err),
+			)
+			return nil, err
+		}
+	}
+
+	ss.logger.Info(
+		"Deleted services purged",
+		slog.String("op", op),
+		slog.Int64("deleted before", deletedBefore),
+		slog.Int("service count", len(ids)),
+	)
+	return ids, nil
+}
+
 // GetList retrieves a list of services from the database.
 //
 // ctx - the context for the operation.
@@ -140,8 +260,8 @@ func (ss *ServiceSQL) Remove(ctx context.Context, id int) error {
 func (ss *ServiceSQL) GetList(ctx context.Context) (*core.List[*core.Service], error) {
 	const op = "ServiceSQL.GetList"
 
-	query := "SELECT id, name FROM service"
-	rows, err := ss.db.QueryContext(ctx, query)
+	query := selectService
+	rows, err := sqltx.DB(ctx, ss.db).QueryContext(ctx, query)
 	if err != nil {
 		ss.logger.Warn(
 			"Failed to get service list",
@@ -155,11 +275,8 @@ func (ss *ServiceSQL) GetList(ctx context.Context) (*core.List[*core.Service], e
 	serviceList := make(core.List[*core.Service], 0)
 
 	for rows.Next() {
-		var (
-			id   int
-			name string
-		)
-		err = rows.Scan(&id, &name)
+		service := core.Service{}
+		id, err := service.ScanRows(rows)
 		if err != nil {
 			ss.logger.Warn(
 				"Failed to scan service row",
@@ -170,7 +287,6 @@ func (ss *ServiceSQL) GetList(ctx context.Context) (*core.List[*core.Service], e
 			return nil, err
 		}
 
-		service := core.NewService(id, name)
 		serviceList[id] = &service
 	}
 
@@ -190,8 +306,8 @@ func (ss *ServiceSQL) GetList(ctx context.Context) (*core.List[*core.Service], e
 func (ss *ServiceSQL) Update(ctx context.Context, s *core.Service) error {
 	const op = "ServiceSQL.Update"
 
-	query := "UPDATE service SET name = ? WHERE id = ?"
-	_, err := ss.db.ExecContext(ctx, query, s.Name(), s.Id())
+	query := "UPDATE service SET name = ?, url = ?, category = ?, verification_type = ?, countries = ?, enabled = ?, parent_id = NULLIF(?, 0) WHERE id = ?"
+	_, err := sqltx.DB(ctx, ss.db).ExecContext(ctx, query, s.Name(), s.URL(), s.Category(), s.VerificationType(), core.JoinCountries(s.Countries()), s.Enabled(), s.ParentID(), s.Id())
 	if err != nil {
 
 		var mysqlErr *mysql.MySQLError
@@ -220,6 +336,92 @@ func (ss *ServiceSQL) Update(ctx context.Context, s *core.Service) error {
 		slog.String("op", op),
 		slog.Int("service id", s.Id()),
 		slog.String("service name", s.Name()),
+		slog.Bool("enabled", s.Enabled()),
 	)
 	return nil
 }
+
+// ByID retrieves a service from the database by its ID.
+//
+// ctx: the context for the operation.
+// id: the ID of the service to retrieve.
+// Returns the service and an error, if any.
+func (ss *ServiceSQL) ByID(ctx context.Context, id int) (*core.Service, error) {
+	const op = "ServiceSQL.ByID"
+
+	query := selectService + " AND id = ?"
+
+	service := core.Service{}
+	if err := service.ScanRow(sqltx.DB(ctx, ss.db).QueryRowContext(ctx, query, id)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ss.logger.Info(
+				"Service does not exist",
+				slog.String("op", op),
+				slog.String("query", query),
+				slog.Int("service id", id),
+			)
+			return nil, repoerrors.ErrNotFound
+		}
+
+		ss.logger.Warn(
+			"Failed to get service",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.Int("service id", id),
+			sl.Err(err),
+		)
+		return nil, err
+	}
+
+	ss.logger.Info(
+		"Service successfully retrieved",
+		slog.String("op", op),
+		slog.Int("service id", id),
+		slog.String("service name", service.Name()),
+	)
+	return &service, nil
+}
+
+// ByGroup retrieves the services of the group with the given id, the top level service and its children.
+//
+// ctx: the context for the operation.
+// groupId: the id of the top level service of the group.
+// Returns the services of the group, an empty list if the group does not exist, and an error, if any.
+func (ss *ServiceSQL) ByGroup(ctx context.Context, groupId int) (*core.List[*core.Service], error) {
+	const op = "ServiceSQL.ByGroup"
+
+	query := selectService + " AND (id = ? OR parent_id = ?)"
+	rows, err := sqltx.DB(ctx, ss.db).QueryContext(ctx, query, groupId, groupId)
+	if err != nil {
+		ss.logger.Warn(
+			"Failed to get service group",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.Int("group id", groupId),
+			sl.Err(err),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	group := make(core.List[*core.Service])
+	for rows.Next() {
+		service := core.Service{}
+		id, err := service.ScanRows(rows)
+		if err != nil {
+			ss.logger.Warn(
+				"Failed to scan service row",
+				slog.String("op", op),
+				slog.String("query", query),
+				sl.Err(err),
+			)
+			return nil, err
+		}
+		group[id] = &service
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
@@ -4,21 +4,29 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"maps"
 	"simactive/internal/core"
 	"simactive/internal/infrastructure/repoerrors"
 	"simactive/internal/lib/logger/sl"
+	"slices"
+	"sync"
 )
 
 // SimInMemory is a repository that stores SIM cards in memory.
 type SimInMemory struct {
-	list   core.List[*core.Sim]
-	logger *slog.Logger
+	// mu guards list and byNumber, the workers and the gRPC handlers use the repository concurrently
+	mu   sync.RWMutex
+	list core.List[*core.Sim]
+	// byNumber maps the phone number of a sim to its id
+	byNumber map[string]int
+	logger   *slog.Logger
 }
 
 func NewSimInMemoryRepository(logger *slog.Logger) *SimInMemory {
 	return &SimInMemory{
-		list:   make(core.List[*core.Sim]),
-		logger: logger,
+		list:     make(core.List[*core.Sim]),
+		byNumber: make(map[string]int),
+		logger:   logger,
 	}
 }
 
@@ -27,17 +35,21 @@ func NewSimInMemoryRepository(logger *slog.Logger) *SimInMemory {
 // Parameters:
 //   - simId: the ID of the SIM card
 //   - number: the phone number associated with the SIM card
+//   - country: the country code of the phone number
 //   - provider: the provider of the SIM card
-//   - isActivated: flag indicating if the SIM card is activated
+//   - state: lifecycle state of the SIM card
 //   - activateUntil: timestamp until the SIM card is activated
-//   - isBlocked: flag indicating if the SIM card is blocked
 //
 // Return:
 //   - err: an error, if any
 //   - ErrAlreadyExists: if the SIM card already exists
-func (i *SimInMemory) Add(ctx context.Context, simId int, number string, provider *core.Provider, isActivated bool, activateUntil int64, isBlocked bool) (err error) {
+//   - *AlreadyExistsError: if another SIM card has the same number
+func (i *SimInMemory) Add(ctx context.Context, simId int, number, country string, provider *core.Provider, state core.SimState, activateUntil int64) (err error) {
 	const op = "SimInMemory.Add"
 
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
 	if sim, err := i.list.ByID(simId); err == nil {
 
 		i.logger.Info(
@@ -48,27 +60,39 @@ func (i *SimInMemory) Add(ctx context.Context, simId int, number string, provide
 			slog.String("number", number),
 			slog.Int("provider id", provider.Id()),
 			slog.String("provider name", provider.Name()),
-			slog.Bool("isActivated", isActivated),
+			slog.String("state", string(state)),
 			slog.Int64("activateUntil", activateUntil),
-			slog.Bool("isBlocked", isBlocked),
 		)
 
 		return repoerrors.ErrAlreadyExists
 	}
 
-	s := core.NewSim(simId, number, provider, isActivated, activateUntil, isBlocked)
+	if id, exists := i.byNumber[number]; exists {
+		i.logger.Info(
+			"Sim with the same number already exists",
+			slog.String("op", op),
+			slog.Int("sim id", id),
+			slog.String("number", number),
+		)
+
+		return &repoerrors.AlreadyExistsError{ID: id}
+	}
+
+	s := core.NewSim(simId, number, provider, state, activateUntil)
+	s.SetCountry(country)
 	i.list[simId] = &s
+	i.byNumber[number] = simId
 
 	i.logger.Info(
 		"Sim successfully added",
 		slog.String("op", op),
 		slog.Int("sim id", simId),
 		slog.String("number", number),
+		slog.String("country", country),
 		slog.Int("provider id", provider.Id()),
 		slog.String("provider name", provider.Name()),
-		slog.Bool("isActivated", isActivated),
+		slog.String("state", string(state)),
 		slog.Int64("activateUntil", activateUntil),
-		slog.Bool("isBlocked", isBlocked),
 	)
 	return nil
 }
@@ -80,7 +104,10 @@ func (i *SimInMemory) Add(ctx context.Context, simId int, number string, provide
 func (i *SimInMemory) Remove(ctx context.Context, id int) error {
 	const op = "SimInMemory.Remove"
 
-	_, err := i.list.ByID(id)
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	sim, err := i.list.ByID(id)
 
 	if err != nil {
 		if errors.Is(err, repoerrors.ErrNotFound) {
@@ -101,6 +128,7 @@ func (i *SimInMemory) Remove(ctx context.Context, id int) error {
 	}
 
 	delete(i.list, id)
+	i.unindex(sim)
 
 	i.logger.Info(
 		"Sim successfully removed",
@@ -117,12 +145,47 @@ func (i *SimInMemory) Remove(ctx context.Context, id int) error {
 func (i *SimInMemory) GetList(ctx context.Context) (*core.List[*core.Sim], error) {
 	const op = "SimInMemory.GetList"
 
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
 	i.logger.Info(
 		"Sim list successfully retrieved",
 		slog.String("op", op),
 		slog.Int("sim count", len(i.list)),
 	)
-	return &i.list, nil
+	// a copy, so callers can range over it while the repository changes
+	list := maps.Clone(i.list)
+	return &list, nil
+}
+
+// List retrieves the Sims that pass the query filter, ordered and limited by the query.
+//
+// ctx context.Context, q core.SimQuery
+// []*core.Sim, error
+func (i *SimInMemory) List(ctx context.Context, q core.SimQuery) ([]*core.Sim, error) {
+	const op = "SimInMemory.List"
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	sims := make([]*core.Sim, 0)
+	for _, s := range i.list {
+		if q.Filter.Match(s) && q.IsAfterCursor(s) {
+			sims = append(sims, s)
+		}
+	}
+
+	slices.SortFunc(sims, q.Compare)
+	if q.Limit > 0 && len(sims) > q.Limit {
+		sims = sims[:q.Limit]
+	}
+
+	i.logger.Info(
+		"Sim list successfully retrieved",
+		slog.String("op", op),
+		slog.Int("sim count", len(sims)),
+	)
+	return sims, nil
 }
 
 // Update updates the Sim in the SimInMemory with the given context and core.Sim.
@@ -132,7 +195,10 @@ func (i *SimInMemory) GetList(ctx context.Context) (*core.List[*core.Sim], error
 func (i *SimInMemory) Update(ctx context.Context, s *core.Sim) error {
 	const op = "SimInMemory.Update"
 
-	_, err := i.list.ByID(s.Id())
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	old, err := i.list.ByID(s.Id())
 	if err != nil {
 		if errors.Is(err, repoerrors.ErrNotFound) {
 			i.logger.Info(
@@ -151,7 +217,20 @@ func (i *SimInMemory) Update(ctx context.Context, s *core.Sim) error {
 		return err
 	}
 
+	if id, exists := i.byNumber[s.Number()]; exists && id != s.Id() {
+		i.logger.Info(
+			"Sim with the same number already exists",
+			slog.String("op", op),
+			slog.Int("sim id", id),
+			slog.String("number", s.Number()),
+		)
+
+		return &repoerrors.AlreadyExistsError{ID: id}
+	}
+
+	i.unindex(old)
 	i.list[s.Id()] = s
+	i.byNumber[s.Number()] = s.Id()
 
 	i.logger.Info(
 		"Sim successfully updated",
@@ -165,6 +244,9 @@ func (i *SimInMemory) Update(ctx context.Context, s *core.Sim) error {
 func (i *SimInMemory) ByID(ctx context.Context, id int) (*core.Sim, error) {
 	const op = "SimInMemory.ByID"
 
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
 	sim, err := i.list.ByID(id)
 	if err != nil {
 		if errors.Is(err, repoerrors.ErrNotFound) {
@@ -192,3 +274,41 @@ func (i *SimInMemory) ByID(ctx context.Context, id int) (*core.Sim, error) {
 	)
 	return sim, nil
 }
+
+// ByNumber retrieves a Sim by its phone number using the number index.
+//
+// ctx context.Context, number string
+// *core.Sim, error
+func (i *SimInMemory) ByNumber(ctx context.Context, number string) (*core.Sim, error) {
+	const op = "SimInMemory.ByNumber"
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	id, exists := i.byNumber[number]
+	sim, err := i.list.ByID(id)
+	if !exists || err != nil {
+		i.logger.Info(
+			"Sim does not exist",
+			slog.String("op", op),
+			slog.String("number", number),
+		)
+		return nil, repoerrors.ErrNotFound
+	}
+
+	i.logger.Info(
+		"Sim successfully retrieved",
+		slog.String("op", op),
+		slog.String("number", number),
+		slog.Any("sim", *sim),
+	)
+	return sim, nil
+}
+
+// unindex removes the number of the sim from the number index
+// unless the number already points to another sim.
+func (i *SimInMemory) unindex(s *core.Sim) {
+	if id, ok := i.byNumber[s.Number()]; ok && id == s.Id() {
+		delete(i.byNumber, s.Number())
+	}
+}
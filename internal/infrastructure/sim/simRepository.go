@@ -3,25 +3,33 @@ package simrepository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
 	"simactive/internal/core"
+	"simactive/internal/infrastructure/repoerrors"
+	"simactive/internal/infrastructure/sqltx"
 )
 
 type SimInMemRepo interface {
 	SameRepoFuncs
-	Add(ctx context.Context, simId int, number string, provider *core.Provider, isActivated bool, activateUntil int64, isBlocked bool) (err error)
+	Add(ctx context.Context, simId int, number, country string, provider *core.Provider, state core.SimState, activateUntil int64) (err error)
 }
 
 type SimSQLRepo interface {
 	SameRepoFuncs
-	Add(ctx context.Context, number string, provider *core.Provider, isActivated bool, activateUntil int64, isBlocked bool) (simId int, err error)
+	Add(ctx context.Context, number, country string, provider *core.Provider, state core.SimState, activateUntil int64) (simId int, err error)
+	Restore(ctx context.Context, id int) error
+	Purge(ctx context.Context, deletedBefore int64) ([]int, error)
+	MoveProvider(ctx context.Context, fromProviderId, toProviderId int) (int, error)
 }
 
 type SameRepoFuncs interface {
 	Remove(ctx context.Context, id int) (err error)
 	GetList(ctx context.Context) (*core.List[*core.Sim], error)
+	List(ctx context.Context, q core.SimQuery) ([]*core.Sim, error)
 	Update(ctx context.Context, s *core.Sim) error
 	ByID(ctx context.Context, id int) (*core.Sim, error)
+	ByNumber(ctx context.Context, number string) (*core.Sim, error)
 }
 
 type SimRepository struct {
@@ -53,13 +61,21 @@ func NewSimRepository(logger *slog.Logger, db *sql.DB, simInMemory SimInMemRepo,
 
 // Add adds a new sim into in-memory and into sql
 // If errors not occured it will return [ID] of new sim
-func (r *SimRepository) Add(ctx context.Context, number string, provider *core.Provider, isActivated bool, activateUntil int64, isBlocked bool) (int, error) {
-	id, err := r.sql.Add(ctx, number, provider, isActivated, activateUntil, isBlocked)
+// If a sim with the same number exists, *repoerrors.AlreadyExistsError with its id is returned
+// Inside a transaction the sim gets into in-memory once the transaction is committed
+func (r *SimRepository) Add(ctx context.Context, number, country string, provider *core.Provider, state core.SimState, activateUntil int64) (int, error) {
+	if s, err := r.inMemory.ByNumber(ctx, number); err == nil {
+		return 0, &repoerrors.AlreadyExistsError{ID: s.Id()}
+	}
+
+	id, err := r.sql.Add(ctx, number, country, provider, state, activateUntil)
 	if err != nil {
 		return 0, err
 	}
 
-	err = r.inMemory.Add(ctx, id, number, provider, isActivated, activateUntil, isBlocked)
+	err = sqltx.AfterCommit(ctx, func() error {
+		return r.inMemory.Add(ctx, id, number, country, provider, state, activateUntil)
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -67,62 +83,135 @@ func (r *SimRepository) Add(ctx context.Context, number string, provider *core.P
 	return id, nil
 }
 
-// Remove removes a sim with the given id.
+// Remove marks the sim with the given id as deleted in sql and removes it from memory.
+// Inside a transaction the sim is removed from memory once the transaction is committed.
 //
 // ctx: the context in which the operation is performed.
 // id: the id of the simulation to be removed.
 // error: an error if any occurred during the removal process.
 // Possibly errors is repository.ErrNotFound if sim with given id does not exist.
 func (r *SimRepository) Remove(ctx context.Context, id int) (err error) {
-	err = r.inMemory.Remove(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	err = r.sql.Remove(ctx, id)
-	if err != nil {
+	if err = r.sql.Remove(ctx, id); err != nil {
 		return err
 	}
 
-	return nil
+	return sqltx.AfterCommit(ctx, func() error {
+		if err := r.inMemory.Remove(ctx, id); err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+			return err
+		}
+		return nil
+	})
 }
 
-// GetList retrieves a list of sims from the repository.
+// Restore clears the deletion of the sim with the given id and caches it in memory again.
 //
-// ctx context.Context
-// *core.List[*core.Sim], error
-func (r *SimRepository) GetList(ctx context.Context) (*core.List[*core.Sim], error) {
-	list, err := r.inMemory.GetList(ctx)
-	if err != nil {
+// ctx: the context in which the operation is performed.
+// id: the id of the sim to be restored.
+// Returns the restored sim. Possibly errors is repository.ErrNotFound if there is no deleted sim with the id.
+func (r *SimRepository) Restore(ctx context.Context, id int) (*core.Sim, error) {
+	if err := r.sql.Restore(ctx, id); err != nil {
 		return nil, err
 	}
 
-	if list != nil && len(*list) != 0 {
-		return list, nil
+	s, err := r.sql.ByID(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
-	list, err = r.sql.GetList(ctx)
+	err = sqltx.AfterCommit(ctx, func() error {
+		if err := r.inMemory.Add(ctx, s.Id(), s.Number(), s.Country(), s.Provider(), s.State(), s.ActivateUntil()); err != nil {
+			return err
+		}
+		// Add does not take the state reason
+		return r.inMemory.Update(ctx, s)
+	})
 	if err != nil {
 		return nil, err
 	}
+	return s, nil
+}
+
+// Purge deletes the sims deleted before the unix timestamp with their activations and used services from sql.
+// Deleted sims are not in memory, the cached used records and activations of the returned sim ids are left to the caller,
+// see UsedRepository.EvictSims and ActivationRepository.EvictSims.
+func (r *SimRepository) Purge(ctx context.Context, deletedBefore int64) ([]int, error) {
+	return r.sql.Purge(ctx, deletedBefore)
+}
+
+// MoveProvider sets the provider of all sims of a provider in sql with one update, the deleted sims included.
+// The cached sims of the provider get the new provider once the transaction is committed.
+func (r *SimRepository) MoveProvider(ctx context.Context, fromProviderId int, to *core.Provider) error {
+	if _, err := r.sql.MoveProvider(ctx, fromProviderId, to.Id()); err != nil {
+		return err
+	}
+
+	return sqltx.AfterCommit(ctx, func() error {
+		return r.setCachedProvider(ctx, fromProviderId, to)
+	})
+}
 
-	return list, nil
+// RefreshProvider gives the cached sims of the provider its current name once the transaction is committed.
+// The sims refer to the provider by id, so sql is left as is.
+func (r *SimRepository) RefreshProvider(ctx context.Context, provider *core.Provider) error {
+	return sqltx.AfterCommit(ctx, func() error {
+		return r.setCachedProvider(ctx, provider.Id(), provider)
+	})
+}
+
+// setCachedProvider sets the provider of the cached sims of the provider with id fromProviderId.
+func (r *SimRepository) setCachedProvider(ctx context.Context, fromProviderId int, to *core.Provider) error {
+	cached, err := r.inMemory.List(ctx, core.SimQuery{Filter: core.SimFilter{ProviderID: fromProviderId}})
+	if err != nil {
+		return err
+	}
+	for _, s := range cached {
+		// update a copy, the cached sim may be held by a caller
+		updated := *s
+		p := *to
+		updated.SetProvider(&p)
+		if err := r.inMemory.Update(ctx, &updated); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetList retrieves a list of sims from sql,
+// the in-memory repository only holds the sims written since the start and can not answer it alone.
+//
+// ctx context.Context
+// *core.List[*core.Sim], error
+func (r *SimRepository) GetList(ctx context.Context) (*core.List[*core.Sim], error) {
+	return r.sql.GetList(ctx)
+}
+
+// List retrieves the sims that pass the query filter, ordered and limited by the query.
+// Like GetList, the sims are read from sql.
+//
+// ctx context.Context, q core.SimQuery
+// []*core.Sim, error
+func (r *SimRepository) List(ctx context.Context, q core.SimQuery) ([]*core.Sim, error) {
+	return r.sql.List(ctx, q)
 }
 
 // Update updates the SimRepository with the given Sim.
+// The sim is updated in sql first, so the in-memory copy is left untouched if sql fails.
+// A sim that is not cached in memory is updated in sql only.
+// Inside a transaction the in-memory copy is updated once the transaction is committed.
 //
 // ctx context.Context, s *core.Sim
-// error
+// error. Possibly errors is repository.ErrAlreadyExists if another sim has the same number.
 func (r *SimRepository) Update(ctx context.Context, s *core.Sim) error {
-	if err := r.inMemory.Update(ctx, s); err != nil {
-		return err
-	}
-
 	if err := r.sql.Update(ctx, s); err != nil {
 		return err
 	}
 
-	return nil
+	return sqltx.AfterCommit(ctx, func() error {
+		if err := r.inMemory.Update(ctx, s); err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+			return err
+		}
+		return nil
+	})
 }
 
 func (r *SimRepository) ByID(ctx context.Context, id int) (*core.Sim, error) {
@@ -132,3 +221,15 @@ func (r *SimRepository) ByID(ctx context.Context, id int) (*core.Sim, error) {
 
 	return r.sql.ByID(ctx, id)
 }
+
+// ByNumber retrieves a sim by its phone number.
+//
+// ctx context.Context, number string
+// *core.Sim, error. Possibly errors is repository.ErrNotFound if sim with given number does not exist.
+func (r *SimRepository) ByNumber(ctx context.Context, number string) (*core.Sim, error) {
+	if s, err := r.inMemory.ByNumber(ctx, number); err == nil {
+		return s, nil
+	}
+
+	return r.sql.ByNumber(ctx, number)
+}
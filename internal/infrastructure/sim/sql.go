@@ -4,14 +4,26 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"log/slog"
 	"simactive/internal/core"
 	"simactive/internal/infrastructure/repoerrors"
+	"simactive/internal/infrastructure/sqltx"
 	"simactive/internal/lib/logger/sl"
+	"strings"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
 )
 
+// selectSim selects the columns of the not deleted sims in the order expected by core.Sim.ScanRow and core.Sim.ScanRows.
+// Conditions are appended with AND.
+const selectSim = `SELECT sim.id, sim.number, sim.country, sim.provider_id, provider.name, sim.state, sim.state_reason, sim.activate_until 
+				FROM sim 
+				JOIN provider
+				ON provider.id = sim.provider_id
+				WHERE sim.deleted_at = 0`
+
 type SimSQL struct {
 	db     *sql.DB
 	logger *slog.Logger
@@ -29,21 +41,19 @@ func NewSimSQLRepository(db *sql.DB, logger *slog.Logger) *SimSQL {
 // Parameters:
 //   - ctx: the context of the operation
 //   - number: the number associated with the Sim
+//   - country: the country code of the number
 //   - provider: the provider of the Sim
-//   - isActivated: a boolean indicating if the Sim is activated
+//   - state: the lifecycle state of the Sim
 //   - activateUntil: the activation time of the Sim
-//   - isBlocked: a boolean indicating if the Sim is blocked
 //
 // Returns:
 //   - int: the ID of the inserted Sim
 //   - error: an error, if any
-func (ss *SimSQL) Add(ctx context.Context, number string, provider *core.Provider, isActivated bool, activateUntil int64, isBlocked bool) (int, error) {
+func (ss *SimSQL) Add(ctx context.Context, number, country string, provider *core.Provider, state core.SimState, activateUntil int64) (int, error) {
 	const op = "SimSQL.Add"
 
-	var insertedId int
-
-	query := `CALL InsertSim(?,?,?,?,?,@lastId)`
-	_, err := ss.db.ExecContext(ctx, query, number, provider.Id(), isActivated, activateUntil, isBlocked)
+	query := "INSERT INTO sim (number, country, provider_id, state, activate_until) VALUES (?, ?, ?, ?, ?)"
+	res, err := sqltx.DB(ctx, ss.db).ExecContext(ctx, query, number, country, provider.Id(), state, activateUntil)
 	if err != nil {
 		var mysqlErr *mysql.MySQLError
 		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
@@ -53,12 +63,11 @@ func (ss *SimSQL) Add(ctx context.Context, number string, provider *core.Provide
 				slog.String("op", op),
 				slog.String("number", number),
 				slog.Int("provider id", provider.Id()),
-				slog.Bool("isActivated", isActivated),
+				slog.String("state", string(state)),
 				slog.Int64("activateUntil", activateUntil),
-				slog.Bool("isBlocked", isBlocked),
 			)
 
-			return 0, repoerrors.ErrAlreadyExists
+			return 0, ss.alreadyExists(ctx, number)
 		}
 
 		ss.logger.Error(
@@ -70,32 +79,17 @@ func (ss *SimSQL) Add(ctx context.Context, number string, provider *core.Provide
 		return 0, err
 	}
 
-	if err := ss.db.QueryRowContext(ctx, "SELECT @lastId").Scan(&insertedId); err != nil {
-		var mysqlErr *mysql.MySQLError
-		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
-
-			ss.logger.Info(
-				"Sim already exists",
-				slog.String("op", op),
-				slog.String("number", number),
-				slog.Int("provider id", provider.Id()),
-				slog.Bool("isActivated", isActivated),
-				slog.Int64("activateUntil", activateUntil),
-				slog.Bool("isBlocked", isBlocked),
-			)
-
-			return 0, repoerrors.ErrAlreadyExists
-		}
-
+	lastId, err := res.LastInsertId()
+	if err != nil {
 		ss.logger.Error(
-			"Failed to add sim",
+			"Failed to receive last insert id after query",
 			slog.String("op", op),
 			slog.String("query", query),
 			sl.Err(err),
 		)
-
 		return 0, err
 	}
+	insertedId := int(lastId)
 
 	ss.logger.Info(
 		"Sim added",
@@ -103,23 +97,32 @@ func (ss *SimSQL) Add(ctx context.Context, number string, provider *core.Provide
 		slog.Int("sim id", insertedId),
 		slog.String("number", number),
 		slog.Int("provider id", provider.Id()),
-		slog.Bool("isActivated", isActivated),
+		slog.String("state", string(state)),
 		slog.Int64("activateUntil", activateUntil),
-		slog.Bool("isBlocked", isBlocked),
 	)
 	return insertedId, nil
 }
 
-// Remove removes a sim from the database.
+// alreadyExists returns the error of a duplicate sim number.
+// The error holds the id of the existing sim if it can be retrieved.
+func (ss *SimSQL) alreadyExists(ctx context.Context, number string) error {
+	var id int
+	if err := sqltx.DB(ctx, ss.db).QueryRowContext(ctx, "SELECT id FROM sim WHERE number = ? AND deleted_at = 0", number).Scan(&id); err != nil {
+		return repoerrors.ErrAlreadyExists
+	}
+	return &repoerrors.AlreadyExistsError{ID: id}
+}
+
+// Remove marks a sim as deleted at now, it is skipped by the lookups until it is restored or purged.
 //
 // ctx: context for the operation.
 // id: the ID of the sim to be removed.
-// error: returns any error that occurred during the operation.
+// error: returns any error that occurred during the operation, repository.ErrNotFound if there is no not deleted sim with the id.
 func (ss *SimSQL) Remove(ctx context.Context, id int) (err error) {
 	const op = "SimSQL.Remove"
 
-	query := "DELETE FROM sim WHERE id = ?"
-	res, err := ss.db.ExecContext(ctx, query, id)
+	query := "UPDATE sim SET deleted_at = ? WHERE id = ? AND deleted_at = 0"
+	res, err := sqltx.DB(ctx, ss.db).ExecContext(ctx, query, time.Now().Unix(), id)
 	if err != nil {
 		ss.logger.Warn(
 			"Failed to remove sim",
@@ -160,6 +163,162 @@ func (ss *SimSQL) Remove(ctx context.Context, id int) (err error) {
 	return nil
 }
 
+// Restore clears the deletion of a sim.
+//
+// ctx: context for the operation.
+// id: the ID of the sim to be restored.
+// error: returns any error that occurred during the operation, repository.ErrNotFound if there is no deleted sim with the id,
+// *repository.AlreadyExistsError if another sim has the number of the deleted sim.
+func (ss *SimSQL) Restore(ctx context.Context, id int) error {
+	const op = "SimSQL.Restore"
+
+	query := "UPDATE sim SET deleted_at = 0 WHERE id = ? AND deleted_at != 0"
+	res, err := sqltx.DB(ctx, ss.db).ExecContext(ctx, query, id)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			// another sim got the number after the deletion
+			var number string
+			if err := sqltx.DB(ctx, ss.db).QueryRowContext(ctx, "SELECT number FROM sim WHERE id = ?", id).Scan(&number); err != nil {
+				return err
+			}
+
+			ss.logger.Info(
+				"Sim with the same number already exists",
+				slog.String("op", op),
+				slog.Int("sim id", id),
+				slog.String("number", number),
+			)
+			return ss.alreadyExists(ctx, number)
+		}
+
+		ss.logger.Warn(
+			"Failed to restore sim",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.Int("sim id", id),
+			sl.Err(err),
+		)
+		return err
+	}
+
+	affectedRows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affectedRows == 0 {
+		ss.logger.Info(
+			"Deleted sim does not exist",
+			slog.String("op", op),
+			slog.Int("sim id", id),
+		)
+		return repoerrors.ErrNotFound
+	}
+
+	ss.logger.Info(
+		"Sim successfully restored",
+		slog.String("op", op),
+		slog.Int("sim id", id),
+	)
+	return nil
+}
+
+// Purge deletes the sims deleted before the unix timestamp with their activations and used services.
+// It is supposed to run in a transaction, see repository.InTx.
+//
+// ctx: context for the operation.
+// deletedBefore: the unix timestamp the sims were deleted before.
+// Returns the ids of the purged sims and an error, if any.
+func (ss *SimSQL) Purge(ctx context.Context, deletedBefore int64) ([]int, error) {
+	const op = "SimSQL.Purge"
+
+	// the ids are selected first, so the caller can drop the dependents from the caches
+	query := "SELECT id FROM sim WHERE deleted_at != 0 AND deleted_at < ?"
+	rows, err := sqltx.DB(ctx, ss.db).QueryContext(ctx, query, deletedBefore)
+	if err != nil {
+		ss.logger.Warn(
+			"Failed to get deleted sims",
+			slog.String("op", op),
+			slog.String("query", query),
+			sl.Err(err),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	var args []any
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+		args = append(args, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	in := "(?" + strings.Repeat(", ?", len(ids)-1) + ")"
+	queries := []string{
+		"DELETE FROM sim_activation WHERE sim_id IN " + in,
+		"DELETE FROM used_service WHERE sim_id IN " + in,
+		"DELETE FROM sim WHERE id IN " + in,
+	}
+	for _, query := range queries {
+		if _, err := sqltx.DB(ctx, ss.db).ExecContext(ctx, query, args...); err != nil {
+			ss.logger.Warn(
+				"Failed to purge sims",
+				slog.String("op", op),
+				slog.String("query", query),
+				sl.Err(err),
+			)
+			return nil, err
+		}
+	}
+
+	ss.logger.Info(
+		"Deleted sims purged",
+		slog.String("op", op),
+		slog.Int64("deleted before", deletedBefore),
+		slog.Int("sim count", len(ids)),
+	)
+	return ids, nil
+}
+
+// MoveProvider sets the provider of all sims of a provider with one update, the deleted sims included,
+// so the provider can be removed.
+//
+// ctx: context for the operation.
+// fromProviderId, toProviderId: the ids of the current and the new provider of the sims.
+// Returns the number of moved sims.
+func (ss *SimSQL) MoveProvider(ctx context.Context, fromProviderId, toProviderId int) (int, error) {
+	const op = "SimSQL.MoveProvider"
+
+	query := "UPDATE sim SET provider_id = ? WHERE provider_id = ?"
+	result, err := sqltx.DB(ctx, ss.db).ExecContext(ctx, query, toProviderId, fromProviderId)
+	if err != nil {
+		ss.logger.Warn(
+			"Failed to move sims",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.Int("provider id", fromProviderId),
+			sl.Err(err),
+		)
+		return 0, err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affectedRows), nil
+}
+
 // GetList retrieves a list of Sims from the database.
 //
 // ctx: the context for the database query.
@@ -167,11 +326,8 @@ func (ss *SimSQL) Remove(ctx context.Context, id int) (err error) {
 func (ss *SimSQL) GetList(ctx context.Context) (*core.List[*core.Sim], error) {
 	const op = "SimSQL.GetList"
 
-	query := `SELECT sim.id, sim.number, sim.provider_id, sim.is_activated, sim.activate_until, sim.is_blocked, provider.name 
-				FROM sim 
-				JOIN provider
-				ON provider.id = sim.provider_id`
-	rows, err := ss.db.QueryContext(ctx, query)
+	query := selectSim
+	rows, err := sqltx.DB(ctx, ss.db).QueryContext(ctx, query)
 	if err != nil {
 		ss.logger.Warn(
 			"Failed to get sim list",
@@ -179,22 +335,14 @@ func (ss *SimSQL) GetList(ctx context.Context) (*core.List[*core.Sim], error) {
 			slog.String("query", query),
 			sl.Err(err),
 		)
+		return nil, err
 	}
+	defer rows.Close()
 
 	simList := make(core.List[*core.Sim], 0)
 	for rows.Next() {
-		var (
-			id            int
-			number        string
-			providerId    int
-			isActivated   bool
-			activateUntil int64
-			isBlocked     bool
-			providerName  string
-		)
-
-		err = rows.Scan(&id, &number, &providerId, &isActivated, &activateUntil, &isBlocked, &providerName)
-		if err != nil {
+		sim := core.NewSim(0, "", &core.Provider{}, core.SimStateNew, 0)
+		if _, err = sim.ScanRows(rows); err != nil {
 			ss.logger.Warn(
 				"Failed to scan sim",
 				slog.String("op", op),
@@ -203,28 +351,175 @@ func (ss *SimSQL) GetList(ctx context.Context) (*core.List[*core.Sim], error) {
 			)
 			return nil, err
 		}
+		simList[sim.Id()] = &sim
+	}
 
-		p := core.NewProvider(providerId, providerName)
+	ss.logger.Info(
+		"Sim list successfully retrieved",
+		slog.String("op", op),
+		slog.Int("sim count", len(simList)),
+	)
+	return &simList, nil
+}
 
-		sim := core.NewSim(
-			id,
-			number,
-			&p,
-			isActivated,
-			activateUntil,
-			isBlocked,
+// List retrieves the Sims that pass the query filter, ordered and limited by the query.
+// Results are the same as of SimInMemory.List for the same query.
+//
+// ctx context.Context, q core.SimQuery
+// []*core.Sim, error
+func (ss *SimSQL) List(ctx context.Context, q core.SimQuery) ([]*core.Sim, error) {
+	const op = "SimSQL.List"
+
+	where, args := simQueryWhere(q)
+	query := selectSim + where + simQueryOrder(q)
+	if q.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, q.Limit)
+	}
+
+	rows, err := sqltx.DB(ctx, ss.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		ss.logger.Warn(
+			"Failed to get sim list",
+			slog.String("op", op),
+			slog.String("query", query),
+			sl.Err(err),
 		)
-		simList[id] = &sim
+		return nil, err
+	}
+	defer rows.Close()
+
+	sims := make([]*core.Sim, 0)
+	for rows.Next() {
+		sim := core.NewSim(0, "", &core.Provider{}, core.SimStateNew, 0)
+		if _, err = sim.ScanRows(rows); err != nil {
+			ss.logger.Warn(
+				"Failed to scan sim",
+				slog.String("op", op),
+				slog.String("query", query),
+				sl.Err(err),
+			)
+			return nil, err
+		}
+		sims = append(sims, &sim)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
 	ss.logger.Info(
 		"Sim list successfully retrieved",
 		slog.String("op", op),
-		slog.Int("sim count", len(simList)),
+		slog.Int("sim count", len(sims)),
 	)
-	return &simList, nil
+	return sims, nil
+}
+
+// simQueryWhere builds the conditions of the query filter and cursor, they are appended to selectSim.
+func simQueryWhere(q core.SimQuery) (string, []any) {
+	var (
+		conds []string
+		args  []any
+	)
+
+	f := q.Filter
+	if f.ProviderID != 0 {
+		conds = append(conds, "sim.provider_id = ?")
+		args = append(args, f.ProviderID)
+	}
+	if f.ProviderName != "" {
+		conds = append(conds, "provider.name = ?")
+		args = append(args, f.ProviderName)
+	}
+	if f.IsActivated != nil {
+		conds = append(conds, stateCond(*f.IsActivated))
+		args = append(args, core.SimStateActive)
+	}
+	if f.IsBlocked != nil {
+		conds = append(conds, stateCond(*f.IsBlocked))
+		args = append(args, core.SimStateBlocked)
+	}
+	if len(f.States) != 0 {
+		conds = append(conds, "sim.state IN (?"+strings.Repeat(", ?", len(f.States)-1)+")")
+		for _, state := range f.States {
+			args = append(args, state)
+		}
+	}
+	if f.ActivateUntilBefore != 0 {
+		conds = append(conds, "sim.activate_until < ?")
+		args = append(args, f.ActivateUntilBefore)
+	}
+	if f.ActivateUntilAfter != 0 {
+		conds = append(conds, "sim.activate_until > ?")
+		args = append(args, f.ActivateUntilAfter)
+	}
+	if f.NumberPrefix != "" {
+		conds = append(conds, "sim.number LIKE ?")
+		args = append(args, likeEscaper.Replace(f.NumberPrefix)+"%")
+	}
+	if f.Country != "" {
+		conds = append(conds, "sim.country = ?")
+		args = append(args, f.Country)
+	}
+	if len(f.Countries) != 0 {
+		conds = append(conds, "(sim.country = '' OR sim.country IN (?"+strings.Repeat(", ?", len(f.Countries)-1)+"))")
+		for _, country := range f.Countries {
+			args = append(args, country)
+		}
+	}
+
+	if c := q.After; c != nil {
+		op := ">"
+		if q.Descending {
+			op = "<"
+		}
+
+		switch q.SortBy {
+		case core.SimSortByNumber:
+			conds = append(conds, fmt.Sprintf("(sim.number %[1]s ? OR (sim.number = ? AND sim.id %[1]s ?))", op))
+			args = append(args, c.Number, c.Number, c.ID)
+		case core.SimSortByActivateUntil:
+			conds = append(conds, fmt.Sprintf("(sim.activate_until %[1]s ? OR (sim.activate_until = ? AND sim.id %[1]s ?))", op))
+			args = append(args, c.ActivateUntil, c.ActivateUntil, c.ID)
+		default:
+			conds = append(conds, "sim.id "+op+" ?")
+			args = append(args, c.ID)
+		}
+	}
+
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " AND " + strings.Join(conds, " AND "), args
 }
 
+// simQueryOrder builds the ORDER BY clause of the query, sims with equal sort keys are ordered by id.
+func simQueryOrder(q core.SimQuery) string {
+	dir := "ASC"
+	if q.Descending {
+		dir = "DESC"
+	}
+
+	switch q.SortBy {
+	case core.SimSortByNumber:
+		return fmt.Sprintf(" ORDER BY sim.number %[1]s, sim.id %[1]s", dir)
+	case core.SimSortByActivateUntil:
+		return fmt.Sprintf(" ORDER BY sim.activate_until %[1]s, sim.id %[1]s", dir)
+	default:
+		return " ORDER BY sim.id " + dir
+	}
+}
+
+func stateCond(equal bool) string {
+	if equal {
+		return "sim.state = ?"
+	}
+	return "sim.state <> ?"
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 // Update updates the Sim object in the database.
 //
 // ctx context.Context, s *core.Sim
@@ -232,12 +527,21 @@ func (ss *SimSQL) GetList(ctx context.Context) (*core.List[*core.Sim], error) {
 func (ss *SimSQL) Update(ctx context.Context, s *core.Sim) error {
 	const op = "SimSQL.Update"
 
-	query := "UPDATE sim SET number = ?, provider_id = ?, is_activated = ?, activate_until = ?, is_blocked = ? WHERE id = ?"
-	_, err := ss.db.ExecContext(ctx, query, s.Number(), s.Provider().Id(), s.IsActivated(), s.ActivateUntil(), s.IsBlocked(), s.Id())
+	query := "UPDATE sim SET number = ?, country = ?, provider_id = ?, state = ?, state_reason = ?, activate_until = ? WHERE id = ?"
+	_, err := sqltx.DB(ctx, ss.db).ExecContext(ctx, query, s.Number(), s.Country(), s.Provider().Id(), s.State(), s.StateReason(), s.ActivateUntil(), s.Id())
 	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
 
-		// TODO:
-		// possibly unique constraint error
+			ss.logger.Info(
+				"Sim with the same number already exists",
+				slog.String("op", op),
+				slog.Int("sim id", s.Id()),
+				slog.String("number", s.Number()),
+			)
+
+			return ss.alreadyExists(ctx, s.Number())
+		}
 
 		ss.logger.Warn(
 			"Failed to update sim",
@@ -247,9 +551,8 @@ func (ss *SimSQL) Update(ctx context.Context, s *core.Sim) error {
 			slog.Int("provider id", s.Provider().Id()),
 			slog.String("provider name", s.Provider().Name()),
 			slog.String("number", s.Number()),
-			slog.Bool("isActivated", s.IsActivated()),
+			slog.String("state", string(s.State())),
 			slog.Int64("activateUntil", s.ActivateUntil()),
-			slog.Bool("isBlocked", s.IsBlocked()),
 			sl.Err(err),
 		)
 		return err
@@ -262,9 +565,8 @@ func (ss *SimSQL) Update(ctx context.Context, s *core.Sim) error {
 		slog.Int("provider id", s.Provider().Id()),
 		slog.String("provider name", s.Provider().Name()),
 		slog.String("number", s.Number()),
-		slog.Bool("isActivated", s.IsActivated()),
+		slog.String("state", string(s.State())),
 		slog.Int64("activateUntil", s.ActivateUntil()),
-		slog.Bool("isBlocked", s.IsBlocked()),
 	)
 
 	return nil
@@ -276,22 +578,10 @@ func (ss *SimSQL) Update(ctx context.Context, s *core.Sim) error {
 func (ss *SimSQL) ByID(ctx context.Context, id int) (*core.Sim, error) {
 	const op = "SimSQL.ByID"
 
-	query := `SELECT sim.id, sim.number, sim.provider_id, sim.is_activated, sim.activate_until, sim.is_blocked, provider.name 
-				FROM sim 
-				JOIN provider
-				ON provider.id = sim.provider_id
-				WHERE sim.id = ?`
+	query := selectSim + ` AND sim.id = ?`
 
-	var (
-		number        string
-		providerId    int
-		isActivated   bool
-		activateUntil int64
-		isBlocked     bool
-		providerName  string
-	)
-	err := ss.db.QueryRowContext(ctx, query, id).Scan(&id, &number, &providerId, &isActivated, &activateUntil, &isBlocked, &providerName)
-	if err != nil {
+	sim := core.NewSim(0, "", &core.Provider{}, core.SimStateNew, 0)
+	if err := sim.ScanRow(sqltx.DB(ctx, ss.db).QueryRowContext(ctx, query, id)); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			ss.logger.Info(
 				"Sim does not exist",
@@ -311,16 +601,43 @@ func (ss *SimSQL) ByID(ctx context.Context, id int) (*core.Sim, error) {
 		return nil, err
 	}
 
-	p := core.NewProvider(providerId, providerName)
-
-	sim := core.NewSim(
-		id,
-		number,
-		&p,
-		isActivated,
-		activateUntil,
-		isBlocked,
+	ss.logger.Info(
+		"Sim successfully retrieved",
+		slog.String("op", op),
+		slog.Any("sim", sim),
 	)
+	return &sim, nil
+}
+
+// ByNumber retrieves a Sim by its phone number.
+//
+// Takes in a context and a phone number, returns a pointer to a core.Sim and an error.
+func (ss *SimSQL) ByNumber(ctx context.Context, number string) (*core.Sim, error) {
+	const op = "SimSQL.ByNumber"
+
+	query := selectSim + ` AND sim.number = ?`
+
+	sim := core.NewSim(0, "", &core.Provider{}, core.SimStateNew, 0)
+	if err := sim.ScanRow(sqltx.DB(ctx, ss.db).QueryRowContext(ctx, query, number)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ss.logger.Info(
+				"Sim does not exist",
+				slog.String("op", op),
+				slog.String("number", number),
+			)
+			return nil, repoerrors.ErrNotFound
+		}
+
+		ss.logger.Warn(
+			"Failed to get sim",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.String("number", number),
+			sl.Err(err),
+		)
+		return nil, err
+	}
+
 	ss.logger.Info(
 		"Sim successfully retrieved",
 		slog.String("op", op),
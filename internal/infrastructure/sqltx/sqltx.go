@@ -0,0 +1,80 @@
+// Package sqltx carries a sql transaction in the context, so that sql repositories
+// take part in a transaction without changing their methods.
+package sqltx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// Querier is implemented by both *sql.DB and *sql.Tx.
+type Querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type txKey struct{}
+
+type tx struct {
+	*sql.Tx
+	afterCommit []func() error
+}
+
+// DB returns the transaction of the context, or db if the context has no transaction.
+func DB(ctx context.Context, db *sql.DB) Querier {
+	if t, ok := ctx.Value(txKey{}).(*tx); ok {
+		return t
+	}
+	return db
+}
+
+// AfterCommit runs fn once the transaction of the context is committed.
+// If the context has no transaction, fn runs right away and its error is returned.
+// Errors of functions run after the commit are dropped, they can not undo the commit.
+//
+// It is used to keep in-memory repositories away from rows that may be rolled back.
+func AfterCommit(ctx context.Context, fn func() error) error {
+	if t, ok := ctx.Value(txKey{}).(*tx); ok {
+		t.afterCommit = append(t.afterCommit, fn)
+		return nil
+	}
+	return fn()
+}
+
+// Run runs fn inside a transaction that is passed in the context.
+// The transaction is committed if fn returns nil and rolled back otherwise.
+// If the context already has a transaction, fn runs inside it.
+func Run(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*tx); ok {
+		return fn(ctx)
+	}
+
+	sqlTx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	t := &tx{Tx: sqlTx}
+	if err := fn(context.WithValue(ctx, txKey{}, t)); err != nil {
+		return errors.Join(err, ignoreDone(sqlTx.Rollback()))
+	}
+
+	if err := sqlTx.Commit(); err != nil {
+		return err
+	}
+
+	for _, after := range t.afterCommit {
+		_ = after()
+	}
+	return nil
+}
+
+// ignoreDone drops the error of a rollback of a transaction that the context has already rolled back.
+func ignoreDone(err error) error {
+	if errors.Is(err, sql.ErrTxDone) {
+		return nil
+	}
+	return err
+}
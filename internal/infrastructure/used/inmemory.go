@@ -4,26 +4,60 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"maps"
 	"simactive/internal/core"
 	"simactive/internal/infrastructure/repoerrors"
 	"simactive/internal/lib/logger/sl"
+	"sync"
 )
 
 type UsedInMemoryRepository struct {
-	list   core.List[*core.Used]
+	// mu guards list and bySim, the workers and the gRPC handlers use the repository concurrently
+	mu   sync.RWMutex
+	list core.List[*core.Used]
+	// bySim indexes used records by sim id, so the records of a single sim
+	// can be retrieved without a scan of the whole list.
+	bySim  map[int]core.List[*core.Used]
 	logger *slog.Logger
 }
 
 func NewUsedInMemoryRepository(logger *slog.Logger) *UsedInMemoryRepository {
 	return &UsedInMemoryRepository{
 		list:   make(core.List[*core.Used]),
+		bySim:  make(map[int]core.List[*core.Used]),
 		logger: logger,
 	}
 }
 
-func (ir *UsedInMemoryRepository) Add(ctx context.Context, id int, simId int, serviceId int, isBlocked bool, blockedInfo string) error {
+// index adds the used record to the sim index.
+func (ir *UsedInMemoryRepository) index(u *core.Used) {
+	simList, ok := ir.bySim[u.SimID()]
+	if !ok {
+		simList = make(core.List[*core.Used])
+		ir.bySim[u.SimID()] = simList
+	}
+	simList[u.Id()] = u
+}
+
+// unindex removes the used record from the sim index.
+func (ir *UsedInMemoryRepository) unindex(u *core.Used) {
+	simList, ok := ir.bySim[u.SimID()]
+	if !ok {
+		return
+	}
+
+	delete(simList, u.Id())
+	if len(simList) == 0 {
+		delete(ir.bySim, u.SimID())
+	}
+}
+
+func (ir *UsedInMemoryRepository) Add(ctx context.Context, id int, simId int, serviceId int, isBlocked bool, blockedInfo string, blockedAt int64, usedAt int64) error {
 	const op = "UsedInMemoryRepository.Add"
 
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
 	if _, err := ir.list.ByID(id); err == nil {
 
 		ir.logger.Info(
@@ -39,7 +73,10 @@ func (ir *UsedInMemoryRepository) Add(ctx context.Context, id int, simId int, se
 	}
 
 	used := core.NewUsed(id, simId, serviceId, isBlocked, blockedInfo)
+	used.SetBlockedAt(blockedAt)
+	used.SetUsedAt(usedAt)
 	ir.list[used.Id()] = &used
+	ir.index(&used)
 
 	ir.logger.Info(
 		"Used added",
@@ -55,16 +92,24 @@ func (ir *UsedInMemoryRepository) Add(ctx context.Context, id int, simId int, se
 func (ir *UsedInMemoryRepository) GetList(ctx context.Context) (*core.List[*core.Used], error) {
 	const op = "UsedInMemoryRepository.GetList"
 
+	ir.mu.RLock()
+	defer ir.mu.RUnlock()
+
 	ir.logger.Info(
 		"Used list successfully retrieved",
 		slog.String("op", op),
 		slog.Int("used count", len(ir.list)),
 	)
-	return &ir.list, nil
+	// a copy, so callers can range over it while the repository changes
+	list := maps.Clone(ir.list)
+	return &list, nil
 }
 func (ir *UsedInMemoryRepository) ByID(ctx context.Context, id int) (*core.Used, error) {
 	const op = "UsedInMemoryRepository.ByID"
 
+	ir.mu.RLock()
+	defer ir.mu.RUnlock()
+
 	used, err := ir.list.ByID(id)
 	if err != nil {
 		if errors.Is(err, repoerrors.ErrNotFound) {
@@ -92,10 +137,39 @@ func (ir *UsedInMemoryRepository) ByID(ctx context.Context, id int) (*core.Used,
 	)
 	return used, nil
 }
+
+// BySimID retrieves all used records of the sim with the given id.
+//
+// ctx context.Context, simId int
+// core.List[*core.Used], error
+func (ir *UsedInMemoryRepository) BySimID(ctx context.Context, simId int) (core.List[*core.Used], error) {
+	const op = "UsedInMemoryRepository.BySimID"
+
+	ir.mu.RLock()
+	defer ir.mu.RUnlock()
+
+	// copy the index entry, so callers can not modify the index
+	list := make(core.List[*core.Used], len(ir.bySim[simId]))
+	for id, used := range ir.bySim[simId] {
+		list[id] = used
+	}
+
+	ir.logger.Info(
+		"Used list of sim successfully retrieved",
+		slog.String("op", op),
+		slog.Int("sim id", simId),
+		slog.Int("used count", len(list)),
+	)
+	return list, nil
+}
 func (ir *UsedInMemoryRepository) Update(ctx context.Context, s *core.Used) error {
 	const op = "UsedInMemoryRepository.Update"
 
-	if _, err := ir.list.ByID(s.Id()); err != nil {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	old, err := ir.list.ByID(s.Id())
+	if err != nil {
 		if errors.Is(err, repoerrors.ErrNotFound) {
 			ir.logger.Info(
 				"Used does not exist",
@@ -114,7 +188,9 @@ func (ir *UsedInMemoryRepository) Update(ctx context.Context, s *core.Used) erro
 		return err
 	}
 
+	ir.unindex(old)
 	ir.list[s.Id()] = s
+	ir.index(s)
 
 	ir.logger.Info(
 		"Used successfully updated",
@@ -127,7 +203,10 @@ func (ir *UsedInMemoryRepository) Update(ctx context.Context, s *core.Used) erro
 func (ir *UsedInMemoryRepository) Remove(ctx context.Context, id int) error {
 	const op = "UsedInMemoryRepository.Remove"
 
-	_, err := ir.list.ByID(id)
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	used, err := ir.list.ByID(id)
 	if err != nil {
 		if errors.Is(err, repoerrors.ErrNotFound) {
 			ir.logger.Info(
@@ -147,6 +226,7 @@ func (ir *UsedInMemoryRepository) Remove(ctx context.Context, id int) error {
 		return err
 	}
 
+	ir.unindex(used)
 	delete(ir.list, id)
 
 	ir.logger.Info(
@@ -157,3 +237,74 @@ func (ir *UsedInMemoryRepository) Remove(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// RemoveBySimID removes all used records of the sim with the given id.
+func (ir *UsedInMemoryRepository) RemoveBySimID(ctx context.Context, simId int) error {
+	const op = "UsedInMemoryRepository.RemoveBySimID"
+
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	removed := 0
+	for id := range ir.bySim[simId] {
+		delete(ir.list, id)
+		removed++
+	}
+	delete(ir.bySim, simId)
+
+	ir.logger.Info(
+		"Used list of sim successfully removed",
+		slog.String("op", op),
+		slog.Int("sim id", simId),
+		slog.Int("used count", removed),
+	)
+	return nil
+}
+
+// RemoveByServiceID removes all used records of the service with the given id.
+func (ir *UsedInMemoryRepository) RemoveByServiceID(ctx context.Context, serviceId int) error {
+	const op = "UsedInMemoryRepository.RemoveByServiceID"
+
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	removed := 0
+	for id, used := range ir.list {
+		if used.ServiceID() != serviceId {
+			continue
+		}
+		ir.unindex(used)
+		delete(ir.list, id)
+		removed++
+	}
+
+	ir.logger.Info(
+		"Used list of service successfully removed",
+		slog.String("op", op),
+		slog.Int("service id", serviceId),
+		slog.Int("used count", removed),
+	)
+	return nil
+}
+
+// Load replaces the cached used records with the given ones, e.g. with all records of sql at the start.
+func (ir *UsedInMemoryRepository) Load(ctx context.Context, list core.List[*core.Used]) error {
+	const op = "UsedInMemoryRepository.Load"
+
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	ir.list = make(core.List[*core.Used], len(list))
+	ir.bySim = make(map[int]core.List[*core.Used])
+	for id, used := range list {
+		ir.list[id] = used
+		ir.index(used)
+	}
+
+	ir.logger.Info(
+		"Used list successfully loaded",
+		slog.String("op", op),
+		slog.Int("used count", len(ir.list)),
+	)
+	return nil
+}
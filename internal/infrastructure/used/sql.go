@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"simactive/internal/core"
 	"simactive/internal/infrastructure/repoerrors"
+	"simactive/internal/infrastructure/sqltx"
 	"simactive/internal/lib/logger/sl"
 
 	"github.com/go-sql-driver/mysql"
@@ -24,12 +25,12 @@ func NewUsedSQLRepository(db *sql.DB, logger *slog.Logger) *UsedSQLRepository {
 	}
 }
 
-func (ur *UsedSQLRepository) Add(ctx context.Context, simId int, serviceId int, isBlocked bool, blockedInfo string) (int, error) {
+func (ur *UsedSQLRepository) Add(ctx context.Context, simId int, serviceId int, isBlocked bool, blockedInfo string, blockedAt int64, usedAt int64) (int, error) {
 	const op = "UsedSQLRepository.Add"
 
-	query := `INSERT INTO used_services (sim_id, service_id, is_blocked, blocked_info) VALUES (?, ?, ?, ?);`
+	query := `INSERT INTO used_service (sim_id, service_id, is_blocked, blocked_info, blocked_at, used_at) VALUES (?, ?, ?, ?, ?, ?);`
 
-	res, err := ur.db.ExecContext(ctx, query, simId, serviceId, isBlocked, blockedInfo)
+	res, err := sqltx.DB(ctx, ur.db).ExecContext(ctx, query, simId, serviceId, isBlocked, blockedInfo, blockedAt, usedAt)
 	if err != nil {
 
 		var mysqlErr *mysql.MySQLError
@@ -85,9 +86,9 @@ func (ur *UsedSQLRepository) Add(ctx context.Context, simId int, serviceId int,
 func (ur *UsedSQLRepository) GetList(ctx context.Context) (*core.List[*core.Used], error) {
 	const op = "UsedSQLRepository.GetList"
 
-	query := "SELECT id, sim_id, service_id, is_blocked, blocked_info FROM used_services"
+	query := "SELECT id, sim_id, service_id, is_blocked, blocked_info, blocked_at, used_at, released_at FROM used_service"
 
-	rows, err := ur.db.QueryContext(ctx, query)
+	rows, err := sqltx.DB(ctx, ur.db).QueryContext(ctx, query)
 	if err != nil {
 
 		ur.logger.Error(
@@ -98,18 +99,12 @@ func (ur *UsedSQLRepository) GetList(ctx context.Context) (*core.List[*core.Used
 		)
 		return nil, err
 	}
+	defer rows.Close()
 
 	usedList := make(core.List[*core.Used], 0)
 	for rows.Next() {
-		var (
-			id          int
-			simId       int
-			serviceId   int
-			isBlocked   bool
-			blockedInfo string
-		)
-
-		if err = rows.Scan(&id, &simId, &serviceId, &isBlocked, &blockedInfo); err != nil {
+		used := core.Used{}
+		if _, err = used.ScanRows(rows); err != nil {
 			ur.logger.Error(
 				"Failed to scan used service",
 				slog.String("op", op),
@@ -118,7 +113,6 @@ func (ur *UsedSQLRepository) GetList(ctx context.Context) (*core.List[*core.Used
 			)
 			return nil, err
 		}
-		used := core.NewUsed(id, simId, serviceId, isBlocked, blockedInfo)
 		usedList[used.Id()] = &used
 	}
 
@@ -134,16 +128,10 @@ func (ur *UsedSQLRepository) GetList(ctx context.Context) (*core.List[*core.Used
 func (ur *UsedSQLRepository) ByID(ctx context.Context, id int) (*core.Used, error) {
 	const op = "UsedSQLRepository.ByID"
 
-	query := "SELECT sim_id, service_id, is_blocked, blocked_info FROM used_services WHERE id = ?"
+	query := "SELECT id, sim_id, service_id, is_blocked, blocked_info, blocked_at, used_at, released_at FROM used_service WHERE id = ?"
 
-	var (
-		simId       int
-		serviceId   int
-		isBlocked   bool
-		blockedInfo string
-	)
-
-	if err := ur.db.QueryRowContext(ctx, query, id).Scan(&simId, &serviceId, &isBlocked, &blockedInfo); err != nil {
+	used := core.Used{}
+	if err := used.ScanRow(sqltx.DB(ctx, ur.db).QueryRowContext(ctx, query, id)); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			ur.logger.Info(
 				"Used service does not exist",
@@ -162,7 +150,6 @@ func (ur *UsedSQLRepository) ByID(ctx context.Context, id int) (*core.Used, erro
 		)
 		return nil, err
 	}
-	used := core.NewUsed(id, simId, serviceId, isBlocked, blockedInfo)
 	ur.logger.Info(
 		"Used service successfully got",
 		slog.String("op", op),
@@ -172,12 +159,59 @@ func (ur *UsedSQLRepository) ByID(ctx context.Context, id int) (*core.Used, erro
 
 	return &used, nil
 }
+
+// BySimID retrieves all used records of the sim with the given id from the database.
+//
+// ctx context.Context, simId int
+// core.List[*core.Used], error
+func (ur *UsedSQLRepository) BySimID(ctx context.Context, simId int) (core.List[*core.Used], error) {
+	const op = "UsedSQLRepository.BySimID"
+
+	query := "SELECT id, sim_id, service_id, is_blocked, blocked_info, blocked_at, used_at, released_at FROM used_service WHERE sim_id = ?"
+
+	rows, err := sqltx.DB(ctx, ur.db).QueryContext(ctx, query, simId)
+	if err != nil {
+		ur.logger.Error(
+			"Failed to get used service list of sim",
+			slog.String("op", op),
+			slog.String("query", query),
+			slog.Int("sim id", simId),
+			sl.Err(err),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	usedList := make(core.List[*core.Used])
+	for rows.Next() {
+		used := core.Used{}
+		if _, err = used.ScanRows(rows); err != nil {
+			ur.logger.Error(
+				"Failed to scan used service",
+				slog.String("op", op),
+				slog.String("query", query),
+				sl.Err(err),
+			)
+			return nil, err
+		}
+		usedList[used.Id()] = &used
+	}
+
+	ur.logger.Info(
+		"Used service list of sim successfully got",
+		slog.String("op", op),
+		slog.String("query", query),
+		slog.Int("sim id", simId),
+		slog.Int("count", len(usedList)),
+	)
+	return usedList, nil
+}
 func (ur *UsedSQLRepository) Update(ctx context.Context, s *core.Used) error {
 	const op = "UsedSQLRepository.Update"
 
-	query := "UPDATE used_services SET sim_id = ?, service_id = ?, is_blocked = ?, blocked_info = ? WHERE id = ?"
+	query := "UPDATE used_service SET sim_id = ?, service_id = ?, is_blocked = ?, blocked_info = ?, blocked_at = ?, used_at = ?, released_at = ? WHERE id = ?"
 
-	_, err := ur.db.ExecContext(ctx, query, s.SimID(), s.ServiceID(), s.IsBlocked(), s.BlockedInfo(), s.Id())
+	_, err := sqltx.DB(ctx, ur.db).ExecContext(ctx, query, s.SimID(), s.ServiceID(), s.IsBlocked(), s.BlockedInfo(), s.BlockedAt(), s.UsedAt(), s.ReleasedAt(), s.Id())
 	if err != nil {
 		ur.logger.Error(
 			"Failed to update used service",
@@ -198,8 +232,8 @@ func (ur *UsedSQLRepository) Update(ctx context.Context, s *core.Used) error {
 func (ur *UsedSQLRepository) Remove(ctx context.Context, id int) error {
 	const op = "UsedSQLRepository.Remove"
 
-	query := "DELETE FROM used_services WHERE id = ?"
-	res, err := ur.db.ExecContext(ctx, query, id)
+	query := "DELETE FROM used_service WHERE id = ?"
+	res, err := sqltx.DB(ctx, ur.db).ExecContext(ctx, query, id)
 	if err != nil {
 		ur.logger.Error(
 			"Failed to remove used service",
@@ -3,23 +3,31 @@ package usedrepository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
 	"simactive/internal/core"
+	"simactive/internal/infrastructure/repoerrors"
+	"simactive/internal/infrastructure/sqltx"
+	"sync/atomic"
 )
 
 type UsedInMemory interface {
 	SamemRepoFuncs
-	Add(ctx context.Context, id int, simId int, serviceId int, isBlocked bool, blockedInfo string) error
+	Add(ctx context.Context, id int, simId int, serviceId int, isBlocked bool, blockedInfo string, blockedAt int64, usedAt int64) error
+	RemoveBySimID(ctx context.Context, simId int) error
+	RemoveByServiceID(ctx context.Context, serviceId int) error
+	Load(ctx context.Context, list core.List[*core.Used]) error
 }
 
 type UsedSQL interface {
 	SamemRepoFuncs
-	Add(ctx context.Context, simId int, serviceId int, isBlocked bool, blockedInfo string) (id int, err error)
+	Add(ctx context.Context, simId int, serviceId int, isBlocked bool, blockedInfo string, blockedAt int64, usedAt int64) (id int, err error)
 }
 
 type SamemRepoFuncs interface {
 	GetList(ctx context.Context) (*core.List[*core.Used], error)
 	ByID(ctx context.Context, id int) (*core.Used, error)
+	BySimID(ctx context.Context, simId int) (core.List[*core.Used], error)
 	Update(ctx context.Context, s *core.Used) error
 	Remove(ctx context.Context, id int) error
 }
@@ -29,6 +37,8 @@ type UsedRepository struct {
 	db       *sql.DB
 	inMemory UsedInMemory
 	sql      UsedSQL
+	// loaded is set once the in-memory repository holds all records, see Load
+	loaded atomic.Bool
 }
 
 func NewUsedRepository(logger *slog.Logger, db *sql.DB, inMemory UsedInMemory, sql UsedSQL) *UsedRepository {
@@ -44,34 +54,44 @@ func NewUsedRepository(logger *slog.Logger, db *sql.DB, inMemory UsedInMemory, s
 	}
 }
 
-func (ur *UsedRepository) Add(ctx context.Context, simId int, serviceId int, isBlocked bool, blockedInfo string) (int, error) {
+func (ur *UsedRepository) Add(ctx context.Context, simId int, serviceId int, isBlocked bool, blockedInfo string, blockedAt int64, usedAt int64) (int, error) {
 
-	id, err := ur.sql.Add(ctx, simId, serviceId, isBlocked, blockedInfo)
+	id, err := ur.sql.Add(ctx, simId, serviceId, isBlocked, blockedInfo, blockedAt, usedAt)
 
 	if err != nil {
 		return 0, err
 	}
 
-	if err = ur.inMemory.Add(ctx, id, simId, serviceId, isBlocked, blockedInfo); err != nil {
+	err = sqltx.AfterCommit(ctx, func() error {
+		return ur.inMemory.Add(ctx, id, simId, serviceId, isBlocked, blockedInfo, blockedAt, usedAt)
+	})
+	if err != nil {
 		return 0, err
 	}
 	return id, nil
 }
-func (ur *UsedRepository) GetList(ctx context.Context) (*core.List[*core.Used], error) {
-	list, err := ur.inMemory.GetList(ctx)
+
+// Load caches all used records of sql in memory, so the lists of records are read from memory afterwards.
+// It is called at the start, before the repository is used.
+func (ur *UsedRepository) Load(ctx context.Context) error {
+	list, err := ur.sql.GetList(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	if list != nil && len(*list) > 0 {
-		return list, nil
+	if err := ur.inMemory.Load(ctx, *list); err != nil {
+		return err
 	}
 
-	list, err = ur.sql.GetList(ctx)
-	if err != nil {
-		return nil, err
+	ur.loaded.Store(true)
+	return nil
+}
+
+// GetList retrieves all used records, from memory once they are loaded and from sql before, see Load.
+func (ur *UsedRepository) GetList(ctx context.Context) (*core.List[*core.Used], error) {
+	if ur.loaded.Load() {
+		return ur.inMemory.GetList(ctx)
 	}
-	return list, nil
+	return ur.sql.GetList(ctx)
 }
 func (ur *UsedRepository) ByID(ctx context.Context, id int) (*core.Used, error) {
 	if used, err := ur.inMemory.ByID(ctx, id); err == nil {
@@ -80,16 +100,32 @@ func (ur *UsedRepository) ByID(ctx context.Context, id int) (*core.Used, error)
 
 	return ur.sql.ByID(ctx, id)
 }
-func (ur *UsedRepository) Update(ctx context.Context, s *core.Used) error {
-	if err := ur.inMemory.Update(ctx, s); err != nil {
-		return err
+
+// BySimID retrieves all used records of the sim with the given id,
+// from the sim index in memory once the records are loaded and from sql before, see Load.
+//
+// ctx context.Context, simId int
+// core.List[*core.Used], error. The list is empty if the sim was never used.
+func (ur *UsedRepository) BySimID(ctx context.Context, simId int) (core.List[*core.Used], error) {
+	if ur.loaded.Load() {
+		return ur.inMemory.BySimID(ctx, simId)
 	}
+	return ur.sql.BySimID(ctx, simId)
+}
 
+// Update updates the used record in sql and then in memory, after the commit if ctx has a transaction.
+// A record that is not cached in memory yet is only updated in sql.
+func (ur *UsedRepository) Update(ctx context.Context, s *core.Used) error {
 	if err := ur.sql.Update(ctx, s); err != nil {
 		return err
 	}
 
-	return nil
+	return sqltx.AfterCommit(ctx, func() error {
+		if err := ur.inMemory.Update(ctx, s); err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+			return err
+		}
+		return nil
+	})
 }
 func (ur *UsedRepository) Remove(ctx context.Context, id int) error {
 	err := ur.inMemory.Remove(ctx, id)
@@ -104,3 +140,29 @@ func (ur *UsedRepository) Remove(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// EvictSims removes the used records of the sims from memory once the transaction is committed.
+// It is called for the sims whose used records were deleted from sql along with the sims, see SimRepository.Purge.
+func (ur *UsedRepository) EvictSims(ctx context.Context, simIds []int) error {
+	return sqltx.AfterCommit(ctx, func() error {
+		for _, id := range simIds {
+			if err := ur.inMemory.RemoveBySimID(ctx, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// EvictServices removes the used records of the services from memory once the transaction is committed.
+// It is called for the services whose used records were deleted from sql along with the services, see ServiceRepository.Purge.
+func (ur *UsedRepository) EvictServices(ctx context.Context, serviceIds []int) error {
+	return sqltx.AfterCommit(ctx, func() error {
+		for _, id := range serviceIds {
+			if err := ur.inMemory.RemoveByServiceID(ctx, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,81 @@
+// Package apikey checks the API keys of the callers against a store of key hashes.
+package apikey
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"simactive/internal/core"
+)
+
+var (
+	ErrInvalidHash   = errors.New("key hash must be a hex encoded SHA-256 hash")
+	ErrDuplicateName = errors.New("key name is used twice")
+	ErrEmptyName     = errors.New("key name cannot be empty")
+)
+
+// Key is an API key of a caller. Only the hash of the key is kept.
+type Key struct {
+	// Name identifies the caller in the logs and the audit log
+	Name string
+	Role core.Role
+	hash [sha256.Size]byte
+}
+
+// Store looks up the API keys by their value.
+type Store struct {
+	keys []Key
+}
+
+// NewKey creates a key with the hex encoded SHA-256 hash of its value, see Hash.
+func NewKey(name, hash string, role core.Role) (Key, error) {
+	if name == "" {
+		return Key{}, ErrEmptyName
+	}
+
+	decoded, err := hex.DecodeString(hash)
+	if err != nil || len(decoded) != sha256.Size {
+		return Key{}, fmt.Errorf("%w: key %s", ErrInvalidHash, name)
+	}
+
+	k := Key{Name: name, Role: role}
+	copy(k.hash[:], decoded)
+	return k, nil
+}
+
+// NewStore creates a store of the keys, the names of the keys must be unique.
+func NewStore(keys ...Key) (*Store, error) {
+	names := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		if _, ok := names[k.Name]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateName, k.Name)
+		}
+		names[k.Name] = struct{}{}
+	}
+	return &Store{keys: keys}, nil
+}
+
+// Lookup returns the key with the given value.
+// The hash of the value is compared with every key in constant time, so the time does not tell which key matched.
+func (s *Store) Lookup(value string) (Key, bool) {
+	hash := sha256.Sum256([]byte(value))
+
+	var (
+		found Key
+		ok    bool
+	)
+	for _, k := range s.keys {
+		if subtle.ConstantTimeCompare(hash[:], k.hash[:]) == 1 {
+			found, ok = k, true
+		}
+	}
+	return found, ok
+}
+
+// Hash returns the hex encoded SHA-256 hash of the key value, the form the keys are configured in.
+func Hash(value string) string {
+	hash := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(hash[:])
+}
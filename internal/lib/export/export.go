@@ -0,0 +1,114 @@
+// Package export encodes records with named columns as csv or json lines.
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Encoder writes records with the values in the order of its columns.
+type Encoder interface {
+	Write(values []any) error
+	// Flush writes the buffered records to the underlying writer.
+	Flush() error
+}
+
+// CSV writes the records as csv rows after a header row with the column names.
+type CSV struct {
+	w       *csv.Writer
+	columns []string
+	record  []string
+}
+
+// NewCSV returns a csv encoder and writes the header row.
+func NewCSV(w io.Writer, columns []string) (*CSV, error) {
+	e := &CSV{
+		w:       csv.NewWriter(w),
+		columns: columns,
+		record:  make([]string, len(columns)),
+	}
+	if err := e.w.Write(columns); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *CSV) Write(values []any) error {
+	if len(values) != len(e.columns) {
+		return fmt.Errorf("got %d values for %d columns", len(values), len(e.columns))
+	}
+
+	for i, v := range values {
+		switch v := v.(type) {
+		case string:
+			e.record[i] = v
+		case int:
+			e.record[i] = strconv.Itoa(v)
+		case int64:
+			e.record[i] = strconv.FormatInt(v, 10)
+		case bool:
+			e.record[i] = strconv.FormatBool(v)
+		default:
+			e.record[i] = fmt.Sprint(v)
+		}
+	}
+	return e.w.Write(e.record)
+}
+
+func (e *CSV) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// JSONL writes every record as a json object on its own line.
+// The keys of the objects are in the order of the columns.
+type JSONL struct {
+	w       io.Writer
+	columns []string
+	buf     bytes.Buffer
+}
+
+func NewJSONL(w io.Writer, columns []string) *JSONL {
+	return &JSONL{
+		w:       w,
+		columns: columns,
+	}
+}
+
+func (e *JSONL) Write(values []any) error {
+	if len(values) != len(e.columns) {
+		return fmt.Errorf("got %d values for %d columns", len(values), len(e.columns))
+	}
+
+	e.buf.Reset()
+	e.buf.WriteByte('{')
+	for i, v := range values {
+		if i > 0 {
+			e.buf.WriteByte(',')
+		}
+		key, err := json.Marshal(e.columns[i])
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		e.buf.Write(key)
+		e.buf.WriteByte(':')
+		e.buf.Write(value)
+	}
+	e.buf.WriteString("}\n")
+
+	_, err := e.w.Write(e.buf.Bytes())
+	return err
+}
+
+// Flush does nothing, records are written by Write.
+func (e *JSONL) Flush() error {
+	return nil
+}
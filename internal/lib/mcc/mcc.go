@@ -0,0 +1,55 @@
+// Package mcc maps the mobile country codes of ITU-T E.212 to their countries.
+package mcc
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnknownMCC = errors.New("unknown mobile country code")
+	ErrInvalidMNC = errors.New("mobile network code must have 2 or 3 digits")
+)
+
+// countries maps the mobile country codes to the ISO 3166-1 alpha-2 codes of their countries.
+// It covers the countries supported by the phone package.
+var countries = map[string]string{
+	"202": "GR", "204": "NL", "206": "BE", "208": "FR", "214": "ES", "216": "HU", "219": "HR",
+	"220": "RS", "222": "IT", "226": "RO", "228": "CH", "230": "CZ", "231": "SK", "232": "AT",
+	"234": "GB", "235": "GB", "238": "DK", "240": "SE", "242": "NO", "244": "FI", "246": "LT",
+	"247": "LV", "248": "EE", "250": "RU", "255": "UA", "257": "BY", "259": "MD", "260": "PL",
+	"262": "DE", "268": "PT", "272": "IE", "282": "GE", "283": "AM", "284": "BG", "286": "TR",
+	"293": "SI",
+	"310": "US", "311": "US", "312": "US", "313": "US", "314": "US", "315": "US", "316": "US",
+	"334": "MX", "368": "CU",
+	"400": "AZ", "401": "KZ", "404": "IN", "405": "IN", "406": "IN", "410": "PK", "412": "AF",
+	"413": "LK", "414": "MM", "419": "KW", "420": "SA", "424": "AE", "425": "IL", "427": "QA",
+	"430": "AE", "431": "AE", "432": "IR", "434": "UZ", "436": "TJ", "437": "KG", "438": "TM",
+	"440": "JP", "441": "JP", "450": "KR", "452": "VN", "454": "HK", "460": "CN", "461": "CN",
+	"466": "TW", "470": "BD",
+	"502": "MY", "505": "AU", "510": "ID", "515": "PH", "520": "TH", "525": "SG", "530": "NZ",
+	"602": "EG", "603": "DZ", "604": "MA", "605": "TN", "621": "NG", "639": "KE", "655": "ZA",
+	"716": "PE", "722": "AR", "724": "BR", "730": "CL", "732": "CO", "734": "VE",
+}
+
+// Country returns the ISO 3166-1 alpha-2 code of the country of the mobile country code.
+func Country(mcc string) (string, error) {
+	country, ok := countries[mcc]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownMCC, mcc)
+	}
+	return country, nil
+}
+
+// ValidateMNC checks that the mobile network code has 2 or 3 digits.
+func ValidateMNC(mnc string) error {
+	if len(mnc) != 2 && len(mnc) != 3 {
+		return ErrInvalidMNC
+	}
+	for _, r := range mnc {
+		if r < '0' || r > '9' {
+			return ErrInvalidMNC
+		}
+	}
+	return nil
+}
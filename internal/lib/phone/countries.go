@@ -0,0 +1,122 @@
+package phone
+
+import "strings"
+
+// country describes the numbers of a country calling code.
+type country struct {
+	code    string
+	country string
+	// minLength and maxLength are the lengths of the national significant number
+	minLength int
+	maxLength int
+	// shared lists the countries that share the calling code, by the first digits of the national number
+	shared []sharedCountry
+}
+
+type sharedCountry struct {
+	prefix  string
+	country string
+}
+
+// countryOf returns the country of the national number.
+func (c country) countryOf(national string) string {
+	for _, s := range c.shared {
+		if strings.HasPrefix(national, s.prefix) {
+			return s.country
+		}
+	}
+	return c.country
+}
+
+// countries maps the supported country calling codes to their countries.
+var countries = func() map[string]country {
+	list := []country{
+		{code: "1", country: "US", minLength: 10, maxLength: 10},
+		{code: "7", country: "RU", minLength: 10, maxLength: 10, shared: []sharedCountry{{"6", "KZ"}, {"7", "KZ"}}},
+		{code: "20", country: "EG", minLength: 8, maxLength: 10},
+		{code: "27", country: "ZA", minLength: 9, maxLength: 9},
+		{code: "30", country: "GR", minLength: 10, maxLength: 10},
+		{code: "31", country: "NL", minLength: 9, maxLength: 9},
+		{code: "32", country: "BE", minLength: 8, maxLength: 9},
+		{code: "33", country: "FR", minLength: 9, maxLength: 9},
+		{code: "34", country: "ES", minLength: 9, maxLength: 9},
+		{code: "36", country: "HU", minLength: 8, maxLength: 9},
+		{code: "39", country: "IT", minLength: 6, maxLength: 11},
+		{code: "40", country: "RO", minLength: 9, maxLength: 9},
+		{code: "41", country: "CH", minLength: 9, maxLength: 9},
+		{code: "43", country: "AT", minLength: 4, maxLength: 13},
+		{code: "44", country: "GB", minLength: 7, maxLength: 10},
+		{code: "45", country: "DK", minLength: 8, maxLength: 8},
+		{code: "46", country: "SE", minLength: 7, maxLength: 10},
+		{code: "47", country: "NO", minLength: 8, maxLength: 8},
+		{code: "48", country: "PL", minLength: 9, maxLength: 9},
+		{code: "49", country: "DE", minLength: 5, maxLength: 13},
+		{code: "51", country: "PE", minLength: 8, maxLength: 9},
+		{code: "52", country: "MX", minLength: 10, maxLength: 10},
+		{code: "53", country: "CU", minLength: 8, maxLength: 8},
+		{code: "54", country: "AR", minLength: 10, maxLength: 11},
+		{code: "55", country: "BR", minLength: 10, maxLength: 11},
+		{code: "56", country: "CL", minLength: 9, maxLength: 9},
+		{code: "57", country: "CO", minLength: 8, maxLength: 10},
+		{code: "58", country: "VE", minLength: 10, maxLength: 10},
+		{code: "60", country: "MY", minLength: 8, maxLength: 10},
+		{code: "61", country: "AU", minLength: 9, maxLength: 9},
+		{code: "62", country: "ID", minLength: 7, maxLength: 12},
+		{code: "63", country: "PH", minLength: 8, maxLength: 10},
+		{code: "64", country: "NZ", minLength: 8, maxLength: 10},
+		{code: "65", country: "SG", minLength: 8, maxLength: 8},
+		{code: "66", country: "TH", minLength: 8, maxLength: 9},
+		{code: "81", country: "JP", minLength: 9, maxLength: 10},
+		{code: "82", country: "KR", minLength: 8, maxLength: 10},
+		{code: "84", country: "VN", minLength: 9, maxLength: 10},
+		{code: "86", country: "CN", minLength: 9, maxLength: 11},
+		{code: "90", country: "TR", minLength: 10, maxLength: 10},
+		{code: "91", country: "IN", minLength: 10, maxLength: 10},
+		{code: "92", country: "PK", minLength: 9, maxLength: 10},
+		{code: "93", country: "AF", minLength: 9, maxLength: 9},
+		{code: "94", country: "LK", minLength: 9, maxLength: 9},
+		{code: "95", country: "MM", minLength: 7, maxLength: 10},
+		{code: "98", country: "IR", minLength: 10, maxLength: 10},
+		{code: "212", country: "MA", minLength: 9, maxLength: 9},
+		{code: "213", country: "DZ", minLength: 8, maxLength: 9},
+		{code: "216", country: "TN", minLength: 8, maxLength: 8},
+		{code: "234", country: "NG", minLength: 8, maxLength: 10},
+		{code: "254", country: "KE", minLength: 9, maxLength: 9},
+		{code: "351", country: "PT", minLength: 9, maxLength: 9},
+		{code: "353", country: "IE", minLength: 7, maxLength: 9},
+		{code: "358", country: "FI", minLength: 5, maxLength: 12},
+		{code: "359", country: "BG", minLength: 8, maxLength: 9},
+		{code: "370", country: "LT", minLength: 8, maxLength: 8},
+		{code: "371", country: "LV", minLength: 8, maxLength: 8},
+		{code: "372", country: "EE", minLength: 7, maxLength: 8},
+		{code: "373", country: "MD", minLength: 8, maxLength: 8},
+		{code: "374", country: "AM", minLength: 8, maxLength: 8},
+		{code: "375", country: "BY", minLength: 9, maxLength: 9},
+		{code: "380", country: "UA", minLength: 9, maxLength: 9},
+		{code: "381", country: "RS", minLength: 8, maxLength: 9},
+		{code: "385", country: "HR", minLength: 8, maxLength: 9},
+		{code: "386", country: "SI", minLength: 8, maxLength: 8},
+		{code: "420", country: "CZ", minLength: 9, maxLength: 9},
+		{code: "421", country: "SK", minLength: 9, maxLength: 9},
+		{code: "852", country: "HK", minLength: 8, maxLength: 8},
+		{code: "880", country: "BD", minLength: 10, maxLength: 10},
+		{code: "886", country: "TW", minLength: 8, maxLength: 9},
+		{code: "965", country: "KW", minLength: 8, maxLength: 8},
+		{code: "966", country: "SA", minLength: 8, maxLength: 9},
+		{code: "971", country: "AE", minLength: 8, maxLength: 9},
+		{code: "972", country: "IL", minLength: 8, maxLength: 9},
+		{code: "974", country: "QA", minLength: 8, maxLength: 8},
+		{code: "992", country: "TJ", minLength: 9, maxLength: 9},
+		{code: "993", country: "TM", minLength: 8, maxLength: 8},
+		{code: "994", country: "AZ", minLength: 9, maxLength: 9},
+		{code: "995", country: "GE", minLength: 9, maxLength: 9},
+		{code: "996", country: "KG", minLength: 9, maxLength: 9},
+		{code: "998", country: "UZ", minLength: 9, maxLength: 9},
+	}
+
+	m := make(map[string]country, len(list))
+	for _, c := range list {
+		m[c.code] = c
+	}
+	return m
+}()
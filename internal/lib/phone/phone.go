@@ -0,0 +1,111 @@
+// Package phone parses phone numbers into the canonical E.164 format.
+package phone
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// maxDigits is the max number of digits of an E.164 number, country calling code included.
+const maxDigits = 15
+
+var (
+	ErrEmpty              = errors.New("phone number is empty")
+	ErrInvalidCharacters  = errors.New("phone number contains invalid characters")
+	ErrUnknownCountryCode = errors.New("unknown country calling code")
+	ErrInvalidLength      = errors.New("invalid phone number length")
+)
+
+// Number is a parsed phone number.
+type Number struct {
+	// CountryCode is the country calling code, 7 for +7 999 888-77-66
+	CountryCode int
+	// National is the national significant number, 9998887766 for +7 999 888-77-66
+	National string
+	// Country is the ISO 3166-1 alpha-2 code of the country, RU for +7 999 888-77-66.
+	// Countries that share a calling code are reported as the main country of the code.
+	Country string
+}
+
+// E164 returns the number in the E.164 format, +79998887766 for +7 999 888-77-66.
+func (n Number) E164() string {
+	return "+" + strconv.Itoa(n.CountryCode) + n.National
+}
+
+func (n Number) String() string {
+	return n.E164()
+}
+
+// Parse parses a phone number written in one of the common international formats:
+//
+//	+7 999 888-77-66
+//	+7 (999) 888.77.66
+//	007 999 888 77 66
+//	79998887766
+//
+// A number without + or 00 is read as an international number without the +,
+// national formats like 8 999 888-77-66 are not supported.
+// The length of the national number is validated for the detected country.
+func Parse(input string) (Number, error) {
+	s := strings.TrimSpace(input)
+	if s == "" {
+		return Number{}, ErrEmpty
+	}
+	international := strings.HasPrefix(s, "+")
+	s = strings.TrimPrefix(s, "+")
+
+	digits := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			digits = append(digits, c)
+		case c == ' ' || c == '-' || c == '(' || c == ')' || c == '.':
+		default:
+			return Number{}, fmt.Errorf("%w: %q", ErrInvalidCharacters, c)
+		}
+	}
+
+	number := string(digits)
+	if !international {
+		number = strings.TrimPrefix(number, "00")
+	}
+	if number == "" {
+		return Number{}, ErrEmpty
+	}
+	if len(number) > maxDigits {
+		return Number{}, fmt.Errorf("%w: more than %d digits", ErrInvalidLength, maxDigits)
+	}
+
+	c, ok := lookupCountry(number)
+	if !ok {
+		return Number{}, fmt.Errorf("%w: %s", ErrUnknownCountryCode, number)
+	}
+
+	national := number[len(c.code):]
+	if len(national) < c.minLength || len(national) > c.maxLength {
+		if c.minLength == c.maxLength {
+			return Number{}, fmt.Errorf("%w: numbers of +%s have %d digits after the country code", ErrInvalidLength, c.code, c.minLength)
+		}
+		return Number{}, fmt.Errorf("%w: numbers of +%s have %d to %d digits after the country code", ErrInvalidLength, c.code, c.minLength, c.maxLength)
+	}
+
+	code, _ := strconv.Atoi(c.code)
+	return Number{
+		CountryCode: code,
+		National:    national,
+		Country:     c.countryOf(national),
+	}, nil
+}
+
+// lookupCountry finds the country of the number by its calling code.
+// Calling codes are prefix free, so at most one code matches.
+func lookupCountry(number string) (country, bool) {
+	for l := 1; l <= 3 && l <= len(number); l++ {
+		if c, ok := countries[number[:l]]; ok {
+			return c, true
+		}
+	}
+	return country{}, false
+}
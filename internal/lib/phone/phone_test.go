@@ -0,0 +1,122 @@
+package phone
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Valid(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		e164     string
+		national string
+		country  string
+	}{
+		{name: "RU with spaces and dashes", input: "+7 999 888-77-66", e164: "+79998887766", national: "9998887766", country: "RU"},
+		{name: "RU with parentheses and dots", input: "+7 (999) 888.77.66", e164: "+79998887766", national: "9998887766", country: "RU"},
+		{name: "RU without plus", input: "79998887766", e164: "+79998887766", national: "9998887766", country: "RU"},
+		{name: "RU with surrounding spaces", input: "  +79998887766 ", e164: "+79998887766", national: "9998887766", country: "RU"},
+		{name: "KZ shares +7 by prefix 7", input: "+7 701 234 56 78", e164: "+77012345678", national: "7012345678", country: "KZ"},
+		{name: "KZ shares +7 by prefix 6", input: "+7 600 123 45 67", e164: "+76001234567", national: "6001234567", country: "KZ"},
+		{name: "US", input: "+1 (415) 555-2671", e164: "+14155552671", national: "4155552671", country: "US"},
+		{name: "GB", input: "+44 20 7946 0958", e164: "+442079460958", national: "2079460958", country: "GB"},
+		{name: "DE", input: "+49 30 12345678", e164: "+493012345678", national: "3012345678", country: "DE"},
+		{name: "FR", input: "+33 1 23 45 67 89", e164: "+33123456789", national: "123456789", country: "FR"},
+		{name: "IT keeps the leading 0 of the national number", input: "+39 06 6982 1234", e164: "+390669821234", national: "0669821234", country: "IT"},
+		{name: "CN", input: "+86 138 0013 8000", e164: "+8613800138000", national: "13800138000", country: "CN"},
+		{name: "UA with a three digit code", input: "+380 44 123 4567", e164: "+380441234567", national: "441234567", country: "UA"},
+		{name: "AE with a three digit code", input: "+971 50 123 4567", e164: "+971501234567", national: "501234567", country: "AE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.e164, n.E164())
+			assert.Equal(t, tt.national, n.National)
+			assert.Equal(t, tt.country, n.Country)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectedErr error
+	}{
+		{name: "Empty", input: "", expectedErr: ErrEmpty},
+		{name: "Only spaces", input: "   ", expectedErr: ErrEmpty},
+		{name: "Only plus", input: "+", expectedErr: ErrEmpty},
+		{name: "Only international prefix", input: "00", expectedErr: ErrEmpty},
+		{name: "Letters", input: "+7 999 888-77-6a", expectedErr: ErrInvalidCharacters},
+		{name: "Plus inside the number", input: "7+9998887766", expectedErr: ErrInvalidCharacters},
+		{name: "Unknown calling code", input: "+999 123 456 789", expectedErr: ErrUnknownCountryCode},
+		{name: "More than 15 digits", input: "+7 999 888 77 66 12345", expectedErr: ErrInvalidLength},
+		{name: "RU too short", input: "+7 999 888 77", expectedErr: ErrInvalidLength},
+		{name: "US too long", input: "+1 415 555 26711", expectedErr: ErrInvalidLength},
+		{name: "GB too short", input: "+44 123 45", expectedErr: ErrInvalidLength},
+		{name: "DE too short", input: "+49 1234", expectedErr: ErrInvalidLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+// National formats are not supported, a trunk prefix is never stripped,
+// only the international 00 prefix of a number written without +.
+func TestParse_TrunkPrefix(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		e164        string
+		expectedErr error
+	}{
+		{name: "International prefix 00 is stripped", input: "007 999 888 77 66", e164: "+79998887766"},
+		{name: "International prefix 00 of a three digit code", input: "00380 44 123 4567", e164: "+380441234567"},
+		{name: "International prefix 00 after plus is kept", input: "+007 999 888 77 66", expectedErr: ErrUnknownCountryCode},
+		{name: "RU national format with trunk prefix 8", input: "8 999 888-77-66", expectedErr: ErrUnknownCountryCode},
+		{name: "RU trunk prefix 8 after the calling code", input: "+7 8 999 888-77-66", expectedErr: ErrInvalidLength},
+		{name: "GB trunk prefix 0 after the calling code", input: "+44 (0)20 7946 0958", expectedErr: ErrInvalidLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := Parse(tt.input)
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.e164, n.E164())
+		})
+	}
+}
+
+func TestCountries_CodesArePrefixFree(t *testing.T) {
+	for code := range countries {
+		for l := 1; l < len(code); l++ {
+			_, ok := countries[code[:l]]
+			assert.False(t, ok, "calling code %s starts with calling code %s", code, code[:l])
+		}
+	}
+}
+
+func TestCountries_Lengths(t *testing.T) {
+	for code, c := range countries {
+		assert.Equal(t, code, c.code)
+		assert.Len(t, c.country, 2, "country of calling code %s", code)
+		assert.Positive(t, c.minLength, "min length of calling code %s", code)
+		assert.LessOrEqual(t, c.minLength, c.maxLength, "lengths of calling code %s", code)
+		assert.LessOrEqual(t, len(code)+c.maxLength, maxDigits, "max length of calling code %s", code)
+	}
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+	"simactive/internal/core"
+	repository "simactive/internal/infrastructure"
+	"time"
+)
+
+type AuditService struct {
+	repository *repository.Repository
+}
+
+func NewAuditService(repo *repository.Repository) *AuditService {
+	return &AuditService{
+		repository: repo,
+	}
+}
+
+// Query retrieves the audit entries selected by the query, newest first.
+func (as *AuditService) Query(ctx context.Context, q core.AuditQuery) ([]*core.AuditEntry, error) {
+	return as.repository.AuditRepository.Query(ctx, q)
+}
+
+// audit appends the entry of a mutation to the audit log, the caller is taken from ctx.
+// It is called inside the transaction of the mutation, so the mutation is rolled back if the entry is not written.
+// before is nil for an added entity, after is nil for a deleted one.
+func audit(ctx context.Context, repo *repository.Repository, action string, entity core.AuditEntity, id int, before, after any) error {
+	entry, err := core.NewAuditEntry(core.CallerFrom(ctx), action, entity, id, before, after, time.Now().Unix())
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.AuditRepository.Add(ctx, &entry)
+	return err
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"simactive/internal/core"
+	repository "simactive/internal/infrastructure"
+	"slices"
+)
+
+// exportPageSize is the number of sims read from the repository at once during an export.
+const exportPageSize = 500
+
+// InventoryService exports snapshots of the sims, providers, services and their usage.
+type InventoryService struct {
+	repository *repository.Repository
+}
+
+func NewInventoryService(repo *repository.Repository) *InventoryService {
+	return &InventoryService{
+		repository: repo,
+	}
+}
+
+// Export calls write for every record selected by the query, ordered by id.
+// The values of a record are in the order of q.Entity.Columns().
+// Sims are read page by page, so that the export of many sims never holds all of them at once.
+//
+// ctx context.Context, q core.ExportQuery, write func(values []any) error
+// error, the first error of write stops the export and is returned
+func (is *InventoryService) Export(ctx context.Context, q core.ExportQuery, write func(values []any) error) error {
+	switch q.Entity {
+	case core.ExportSims:
+		return is.exportSims(ctx, q, write)
+	case core.ExportProviders:
+		return is.exportProviders(ctx, write)
+	case core.ExportServices:
+		return is.exportServices(ctx, q, write)
+	case core.ExportUsage:
+		return is.exportUsage(ctx, q, write)
+	default:
+		return fmt.Errorf("unknown export entity %q", q.Entity)
+	}
+}
+
+func (is *InventoryService) exportSims(ctx context.Context, q core.ExportQuery, write func(values []any) error) error {
+	return is.eachSimPage(ctx, q.SimFilter, func(sims []*core.Sim) error {
+		for _, s := range sims {
+			p := s.Provider()
+			err := write([]any{
+				s.Id(), s.Number(), s.Country(), p.Id(), p.Name(),
+				string(s.State()), s.StateReason(), s.IsActivated(), s.ActivateUntil(), s.IsBlocked(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (is *InventoryService) exportProviders(ctx context.Context, write func(values []any) error) error {
+	list, err := is.repository.ProviderRepository.GetList(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range sortedByID(list) {
+		values := []any{p.Id(), p.Name(), p.DefaultActivationPeriod(), p.Country(), p.MCC(), p.MNC(), p.MonthlyCost(), p.Currency()}
+		if err := write(values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (is *InventoryService) exportServices(ctx context.Context, q core.ExportQuery, write func(values []any) error) error {
+	list, err := is.repository.ServiceRepository.GetList(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range sortedByID(list) {
+		if q.ServiceID != 0 && s.Id() != q.ServiceID {
+			continue
+		}
+		values := []any{s.Id(), s.Name(), s.URL(), s.Category(), string(s.VerificationType()), core.JoinCountries(s.Countries()), s.Enabled(), s.ParentID()}
+		if err := write(values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportUsage writes the used services of the sims ordered by the sim id and then by the used id.
+func (is *InventoryService) exportUsage(ctx context.Context, q core.ExportQuery, write func(values []any) error) error {
+	usedList, err := is.repository.UsedRepository.GetList(ctx)
+	if err != nil {
+		return err
+	}
+	serviceList, err := is.repository.ServiceRepository.GetList(ctx)
+	if err != nil {
+		return err
+	}
+
+	bySim := make(map[int][]*core.Used)
+	for _, u := range sortedByID(usedList) {
+		if q.ServiceID != 0 && u.ServiceID() != q.ServiceID {
+			continue
+		}
+		bySim[u.SimID()] = append(bySim[u.SimID()], u)
+	}
+	if len(bySim) == 0 {
+		return nil
+	}
+
+	return is.eachSimPage(ctx, q.SimFilter, func(sims []*core.Sim) error {
+		for _, s := range sims {
+			for _, u := range bySim[s.Id()] {
+				var serviceName string
+				if service, ok := (*serviceList)[u.ServiceID()]; ok {
+					serviceName = service.Name()
+				}
+
+				err := write([]any{
+					u.Id(), s.Id(), s.Number(), u.ServiceID(), serviceName, u.IsBlocked(), u.BlockedInfo(),
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// eachSimPage calls fn for every page of the sims that pass the filter, ordered by id.
+func (is *InventoryService) eachSimPage(ctx context.Context, filter core.SimFilter, fn func(sims []*core.Sim) error) error {
+	q := core.SimQuery{
+		Filter: filter,
+		SortBy: core.SimSortByID,
+		Limit:  exportPageSize,
+	}
+	for {
+		sims, err := is.repository.SimRepository.List(ctx, q)
+		if err != nil {
+			return err
+		}
+		if err := fn(sims); err != nil {
+			return err
+		}
+		if len(sims) < exportPageSize {
+			return nil
+		}
+
+		cursor := core.CursorOf(sims[len(sims)-1])
+		q.After = &cursor
+	}
+}
+
+// sortedByID returns the values of the list ordered by their keys.
+func sortedByID[T core.DBModel](list *core.List[T]) []T {
+	if list == nil {
+		return nil
+	}
+	values := make([]T, 0, len(*list))
+	for _, v := range *list {
+		values = append(values, v)
+	}
+	slices.SortFunc(values, func(a, b T) int {
+		return cmp.Compare(a.GetKey(), b.GetKey())
+	})
+	return values
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"simactive/internal/core"
+	"slices"
+)
+
+// leaseStore keeps the active leases in memory.
+// Leases are never persisted: a lease that is lost on restart is simply expired.
+// leaseStore is not safe for concurrent use, callers guard it with their own lock.
+type leaseStore struct {
+	leases map[string]core.Lease
+}
+
+func newLeaseStore() *leaseStore {
+	return &leaseStore{
+		leases: make(map[string]core.Lease),
+	}
+}
+
+// add stores a new lease of the sim for the service and returns it.
+func (ls *leaseStore) add(simId, serviceId int, expiresAt int64) (core.Lease, error) {
+	id, err := newLeaseID()
+	if err != nil {
+		return core.Lease{}, err
+	}
+
+	lease := core.NewLease(id, simId, serviceId, expiresAt)
+	ls.leases[id] = lease
+	return lease, nil
+}
+
+// get returns the lease with the given id if it exists and is not expired.
+func (ls *leaseStore) get(id string, now int64) (core.Lease, error) {
+	lease, ok := ls.leases[id]
+	if !ok || lease.IsExpired(now) {
+		return core.Lease{}, core.ErrLeaseNotFound
+	}
+	return lease, nil
+}
+
+// remove removes the lease with the given id.
+func (ls *leaseStore) remove(id string) {
+	delete(ls.leases, id)
+}
+
+// isLeased reports whether the sim holds a not expired lease for one of the services.
+// The lease with the id except is left out, e.g. the lease that is being confirmed.
+func (ls *leaseStore) isLeased(simId int, serviceIds []int, now int64, except string) bool {
+	for id, lease := range ls.leases {
+		if id != except && lease.SimID() == simId && slices.Contains(serviceIds, lease.ServiceID()) && !lease.IsExpired(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// sweep removes all leases expired at the given unix timestamp.
+func (ls *leaseStore) sweep(now int64) {
+	for id, lease := range ls.leases {
+		if lease.IsExpired(now) {
+			delete(ls.leases, id)
+		}
+	}
+}
+
+func newLeaseID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
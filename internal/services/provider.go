@@ -2,8 +2,11 @@ package services
 
 import (
 	"context"
+	"errors"
 	"simactive/internal/core"
 	repository "simactive/internal/infrastructure"
+	"simactive/internal/infrastructure/repoerrors"
+	"simactive/internal/lib/mcc"
 )
 
 type ProviderService struct {
@@ -27,6 +30,324 @@ func (ps *ProviderService) GetProviderList(ctx context.Context) (*core.List[*cor
 // ctx - the context in which the operation is performed.
 // p - the Provider to be added.
 // Returns an int represents the ID of the added Provider and an error.
+// Possibly errors: *repository.AlreadyExistsError if a provider with the same name exists,
+// core.ErrCurrencyRequired and *core.MCCCountryError, see validateMetadata.
 func (ps *ProviderService) Add(ctx context.Context, p *core.Provider) (int, error) {
-	return ps.repository.ProviderRepository.Add(ctx, p.Name())
+	if err := validateMetadata(p); err != nil {
+		return 0, err
+	}
+	if err := ps.nameIsFree(ctx, p.Name(), 0); err != nil {
+		return 0, err
+	}
+	return ps.repository.ProviderRepository.Add(ctx, p)
+}
+
+// Update changes the metadata of the provider with the given id that is set in the update.
+// The metadata is validated together with the stored metadata, so an update of the mcc alone
+// is checked against the stored country and an update of the monthly cost alone may use the stored currency.
+//
+// Returns the updated provider.
+// Possibly errors: repository.ErrNotFound if the provider does not exist,
+// core.ErrCurrencyRequired and *core.MCCCountryError, see validateMetadata.
+func (ps *ProviderService) Update(ctx context.Context, id int, update core.ProviderUpdate) (*core.Provider, error) {
+	provider, err := ps.repository.ProviderRepository.ByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := *provider
+	update.Apply(&updated)
+	if err := validateMetadata(&updated); err != nil {
+		return nil, err
+	}
+	if err := ps.repository.ProviderRepository.Update(ctx, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// Rename changes the name of the provider with the given id.
+// The cached sims of the provider are refreshed too, so they report the new name.
+//
+// Returns the renamed provider.
+// Possibly errors: repository.ErrNotFound if the provider does not exist,
+// *repository.AlreadyExistsError if another provider has the name.
+func (ps *ProviderService) Rename(ctx context.Context, id int, name string) (*core.Provider, error) {
+	provider, err := ps.repository.ProviderRepository.ByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ps.nameIsFree(ctx, name, id); err != nil {
+		return nil, err
+	}
+
+	renamed := provider.WithName(name)
+	err = ps.repository.InTx(ctx, func(ctx context.Context) error {
+		if err := ps.repository.ProviderRepository.Update(ctx, &renamed); err != nil {
+			return err
+		}
+		// the sims keep the provider, only the name cached with them changes
+		return ps.repository.SimRepository.RefreshProvider(ctx, &renamed)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &renamed, nil
+}
+
+// Delete removes the provider with the given id.
+// A provider with sims is only removed if reassignTo is set, its sims and aliases are moved to the provider with that id first.
+//
+// Returns the number of reassigned sims.
+// Possibly errors: repository.ErrNotFound if the provider does not exist,
+// *core.ProviderInUseError if the provider has sims and reassignTo is 0,
+// core.ErrReassignTargetNotFound if the provider with id reassignTo does not exist.
+func (ps *ProviderService) Delete(ctx context.Context, id, reassignTo int) (int, error) {
+	if _, err := ps.repository.ProviderRepository.ByID(ctx, id); err != nil {
+		return 0, err
+	}
+
+	var target *core.Provider
+	if reassignTo != 0 {
+		p, err := ps.repository.ProviderRepository.ByID(ctx, reassignTo)
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return 0, core.ErrReassignTargetNotFound
+		}
+		if err != nil {
+			return 0, err
+		}
+		copied := *p
+		target = &copied
+	}
+
+	var reassigned int
+	err := ps.repository.InTx(ctx, func(ctx context.Context) error {
+		sims, err := ps.repository.SimRepository.List(ctx, core.SimQuery{Filter: core.SimFilter{ProviderID: id}})
+		if err != nil {
+			return err
+		}
+		if len(sims) > 0 && target == nil {
+			return &core.ProviderInUseError{Sims: len(sims)}
+		}
+
+		if target != nil {
+			if reassigned, err = ps.moveSims(ctx, id, target); err != nil {
+				return err
+			}
+			if err := ps.repository.ProviderRepository.MoveAliases(ctx, id, target.Id()); err != nil {
+				return err
+			}
+		}
+
+		return ps.removeProvider(ctx, id)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reassigned, nil
+}
+
+// moveSims sets the provider of all sims of the provider with the given id with one update,
+// the deleted sims included, every moved sim that is not deleted is audited.
+// It runs in the transaction of the caller. Returns the number of moved sims that are not deleted.
+func (ps *ProviderService) moveSims(ctx context.Context, id int, provider *core.Provider) (int, error) {
+	sims, err := ps.repository.SimRepository.List(ctx, core.SimQuery{Filter: core.SimFilter{ProviderID: id}})
+	if err != nil {
+		return 0, err
+	}
+
+	if err := ps.repository.SimRepository.MoveProvider(ctx, id, provider); err != nil {
+		return 0, err
+	}
+
+	for _, sim := range sims {
+		updated := *sim
+		p := *provider
+		updated.SetProvider(&p)
+		if err := audit(ctx, ps.repository, "ReassignProvider", core.AuditEntitySim, sim.Id(), core.SimAuditValue(sim), core.SimAuditValue(&updated)); err != nil {
+			return 0, err
+		}
+	}
+	return len(sims), nil
+}
+
+// removeProvider removes the provider with the given id in the transaction of the caller.
+// Possibly errors: *core.ProviderInUseError with the number of the provider sims if sims still refer to it.
+func (ps *ProviderService) removeProvider(ctx context.Context, id int) error {
+	err := ps.repository.ProviderRepository.Remove(ctx, id)
+	if !errors.Is(err, repoerrors.ErrInUse) {
+		return err
+	}
+
+	// deleted sims refer to the provider until they are purged, sims may also be added to it meanwhile
+	sims, err := ps.repository.SimRepository.List(ctx, core.SimQuery{Filter: core.SimFilter{ProviderID: id}})
+	if err != nil {
+		return err
+	}
+	return &core.ProviderInUseError{Sims: len(sims)}
+}
+
+// Merge moves the sims and the aliases of the source providers to the target provider and deletes the sources,
+// all in one transaction. The names of the sources become aliases of the target,
+// so sims added with them later get the target provider.
+//
+// Returns the target provider and the number of moved sims.
+// Possibly errors: *core.ProviderNotFoundError if the target or a source does not exist,
+// *core.ProviderInUseError if sims were added to a source while it was merged.
+func (ps *ProviderService) Merge(ctx context.Context, targetId int, sourceIds []int) (*core.Provider, int, error) {
+	target, err := ps.providerByID(ctx, targetId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sources := make([]*core.Provider, 0, len(sourceIds))
+	for _, id := range sourceIds {
+		source, err := ps.providerByID(ctx, id)
+		if err != nil {
+			return nil, 0, err
+		}
+		sources = append(sources, source)
+	}
+
+	var moved int
+	err = ps.repository.InTx(ctx, func(ctx context.Context) error {
+		for _, source := range sources {
+			n, err := ps.moveSims(ctx, source.Id(), target)
+			if err != nil {
+				return err
+			}
+			moved += n
+
+			if err := ps.repository.ProviderRepository.MoveAliases(ctx, source.Id(), target.Id()); err != nil {
+				return err
+			}
+			if err := ps.removeProvider(ctx, source.Id()); err != nil {
+				return err
+			}
+
+			if core.ProviderNameKey(source.Name()) == core.ProviderNameKey(target.Name()) {
+				continue
+			}
+			err = ps.repository.ProviderRepository.AddAlias(ctx, target.Id(), source.Name())
+			if err != nil && !errors.Is(err, repoerrors.ErrAlreadyExists) {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return target, moved, nil
+}
+
+// Aliases retrieves the aliases of the provider with the given id.
+//
+// Possibly errors: repository.ErrNotFound if the provider does not exist.
+func (ps *ProviderService) Aliases(ctx context.Context, id int) ([]string, error) {
+	if _, err := ps.repository.ProviderRepository.ByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return ps.repository.ProviderRepository.Aliases(ctx, id)
+}
+
+// AddAlias adds an alias the provider with the given id is found by, when sims are added.
+//
+// Returns the aliases of the provider.
+// Possibly errors: repository.ErrNotFound if the provider does not exist,
+// *repository.AlreadyExistsError with the provider id if a provider already has the alias as its name or alias.
+func (ps *ProviderService) AddAlias(ctx context.Context, id int, alias string) ([]string, error) {
+	if _, err := ps.repository.ProviderRepository.ByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := ps.nameIsFree(ctx, alias, 0); err != nil {
+		return nil, err
+	}
+
+	if err := ps.repository.ProviderRepository.AddAlias(ctx, id, alias); err != nil {
+		return nil, err
+	}
+	return ps.repository.ProviderRepository.Aliases(ctx, id)
+}
+
+// RemoveAlias removes an alias of the provider with the given id.
+//
+// Returns the aliases of the provider.
+// Possibly errors: repository.ErrNotFound if the provider does not exist or does not have the alias.
+func (ps *ProviderService) RemoveAlias(ctx context.Context, id int, alias string) ([]string, error) {
+	provider, err := ps.repository.ProviderRepository.ByAlias(ctx, alias)
+	if err != nil {
+		return nil, err
+	}
+	if provider.Id() != id {
+		return nil, repoerrors.ErrNotFound
+	}
+
+	if err := ps.repository.ProviderRepository.RemoveAlias(ctx, alias); err != nil {
+		return nil, err
+	}
+	return ps.repository.ProviderRepository.Aliases(ctx, id)
+}
+
+// providerByID retrieves a copy of the provider with the given id.
+//
+// Possibly errors: *core.ProviderNotFoundError if the provider does not exist.
+func (ps *ProviderService) providerByID(ctx context.Context, id int) (*core.Provider, error) {
+	provider, err := ps.repository.ProviderRepository.ByID(ctx, id)
+	if errors.Is(err, repoerrors.ErrNotFound) {
+		return nil, &core.ProviderNotFoundError{ID: id}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p := *provider
+	return &p, nil
+}
+
+// nameIsFree checks that no provider other than the one with the given id has the name as its name or alias.
+// Names and aliases are matched by core.ProviderNameKey.
+func (ps *ProviderService) nameIsFree(ctx context.Context, name string, id int) error {
+	existing, err := ps.repository.ProviderRepository.ByName(ctx, name)
+	if errors.Is(err, repoerrors.ErrNotFound) {
+		existing, err = ps.repository.ProviderRepository.ByAlias(ctx, name)
+	}
+	if errors.Is(err, repoerrors.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if existing.Id() == id {
+		return nil
+	}
+	return &repoerrors.AlreadyExistsError{ID: existing.Id()}
+}
+
+// validateMetadata checks the metadata fields of the provider against each other.
+// A provider with an mcc and without a country gets the country of the mcc.
+// Possibly errors: core.ErrCurrencyRequired if the provider has a monthly cost and no currency,
+// *core.MCCCountryError if the mcc belongs to another country than the provider.
+func validateMetadata(p *core.Provider) error {
+	if p.MonthlyCost() > 0 && p.Currency() == "" {
+		return core.ErrCurrencyRequired
+	}
+	if p.MCC() == "" {
+		return nil
+	}
+
+	country, err := mcc.Country(p.MCC())
+	if err != nil {
+		return err
+	}
+	switch p.Country() {
+	case "":
+		p.SetCountry(country)
+	case country:
+	default:
+		return &core.MCCCountryError{MCC: p.MCC(), MCCCountry: country, Country: p.Country()}
+	}
+	return nil
 }
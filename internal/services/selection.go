@@ -0,0 +1,222 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"simactive/internal/core"
+	"simactive/internal/infrastructure/repoerrors"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Names of the built-in selection strategies, used in the config.
+const (
+	SelectFirst             = "first"
+	SelectLeastRecentlyUsed = "least_recently_used"
+	SelectRoundRobin        = "round_robin"
+	SelectOldestActivation  = "oldest_activation"
+	SelectRandom            = "random"
+)
+
+// SelectionCandidate is a sim that can be acquired for a service, with the facts the strategies choose by.
+type SelectionCandidate struct {
+	Sim *core.Sim
+	// LastUsedAt is the unix timestamp of the last use of the sim for any service, 0 if it was never used
+	LastUsedAt int64
+	// ActivatedAt is the unix timestamp of the start of the current activation, 0 if it is unknown
+	ActivatedAt int64
+}
+
+// SelectionStrategy picks the sim for a service when several sims can be acquired for it.
+type SelectionStrategy interface {
+	// Select returns the index of the chosen candidate.
+	// Candidates are ordered by sim id and there is at least one candidate.
+	Select(serviceId int, candidates []SelectionCandidate) int
+}
+
+// NewSelectionStrategy returns the built-in strategy with the name.
+func NewSelectionStrategy(name string) (SelectionStrategy, error) {
+	switch name {
+	case SelectFirst, "":
+		return FirstSelection{}, nil
+	case SelectLeastRecentlyUsed:
+		return LeastRecentlyUsedSelection{}, nil
+	case SelectRoundRobin:
+		return NewRoundRobinSelection(), nil
+	case SelectOldestActivation:
+		return OldestActivationSelection{}, nil
+	case SelectRandom:
+		return RandomSelection{}, nil
+	default:
+		return nil, fmt.Errorf("unknown selection strategy %q", name)
+	}
+}
+
+// FirstSelection picks the sim with the lowest id.
+type FirstSelection struct{}
+
+func (FirstSelection) Select(_ int, _ []SelectionCandidate) int {
+	return 0
+}
+
+// LeastRecentlyUsedSelection picks the sim that was used for any service longest ago, never used sims first.
+type LeastRecentlyUsedSelection struct{}
+
+func (LeastRecentlyUsedSelection) Select(_ int, candidates []SelectionCandidate) int {
+	return minIndex(candidates, func(c SelectionCandidate) int64 { return c.LastUsedAt })
+}
+
+// OldestActivationSelection picks the sim whose current activation started first,
+// so sims closer to the end of their paid period are used up first.
+type OldestActivationSelection struct{}
+
+func (OldestActivationSelection) Select(_ int, candidates []SelectionCandidate) int {
+	return minIndex(candidates, func(c SelectionCandidate) int64 { return c.ActivatedAt })
+}
+
+// RandomSelection picks a random sim to even out the wear of the sims.
+type RandomSelection struct {
+	// Rand is the source of the choice, the global source is used if it is nil
+	Rand *rand.Rand
+}
+
+func (s RandomSelection) Select(_ int, candidates []SelectionCandidate) int {
+	if s.Rand == nil {
+		return rand.IntN(len(candidates))
+	}
+	return s.Rand.IntN(len(candidates))
+}
+
+// RoundRobinSelection takes turns between the providers for every service,
+// within a provider the sim with the lowest id is picked.
+type RoundRobinSelection struct {
+	mu sync.Mutex
+	// lastProvider is the provider id of the last sim picked for a service
+	lastProvider map[int]int
+}
+
+func NewRoundRobinSelection() *RoundRobinSelection {
+	return &RoundRobinSelection{
+		lastProvider: make(map[int]int),
+	}
+}
+
+func (s *RoundRobinSelection) Select(serviceId int, candidates []SelectionCandidate) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	providers := make([]int, 0, len(candidates))
+	for _, c := range candidates {
+		providers = append(providers, c.Sim.Provider().Id())
+	}
+	slices.Sort(providers)
+	providers = slices.Compact(providers)
+
+	// the next provider after the last one, wrapping around to the first provider
+	next := providers[0]
+	if last, ok := s.lastProvider[serviceId]; ok {
+		if i, _ := slices.BinarySearch(providers, last+1); i < len(providers) {
+			next = providers[i]
+		}
+	}
+	s.lastProvider[serviceId] = next
+
+	return slices.IndexFunc(candidates, func(c SelectionCandidate) bool {
+		return c.Sim.Provider().Id() == next
+	})
+}
+
+// minIndex returns the index of the first candidate with the least key.
+func minIndex(candidates []SelectionCandidate, key func(c SelectionCandidate) int64) int {
+	best := 0
+	for i := 1; i < len(candidates); i++ {
+		if key(candidates[i]) < key(candidates[best]) {
+			best = i
+		}
+	}
+	return best
+}
+
+// SelectionStrategies are the selection strategies of the services.
+type SelectionStrategies struct {
+	Default SelectionStrategy
+	// ByService overrides the default strategy by the service name, names are compared case-insensitively
+	ByService map[string]SelectionStrategy
+}
+
+// NewSelectionStrategies creates the strategies from their names, see NewSelectionStrategy.
+func NewSelectionStrategies(defaultName string, byService map[string]string) (SelectionStrategies, error) {
+	var (
+		strategies SelectionStrategies
+		err        error
+	)
+	if strategies.Default, err = NewSelectionStrategy(defaultName); err != nil {
+		return strategies, err
+	}
+
+	strategies.ByService = make(map[string]SelectionStrategy, len(byService))
+	for service, name := range byService {
+		if strategies.ByService[service], err = NewSelectionStrategy(name); err != nil {
+			return strategies, fmt.Errorf("service %s: %w", service, err)
+		}
+	}
+	return strategies, nil
+}
+
+// For returns the strategy of the service with the name.
+func (s SelectionStrategies) For(serviceName string) SelectionStrategy {
+	for name, strategy := range s.ByService {
+		if strings.EqualFold(name, serviceName) {
+			return strategy
+		}
+	}
+	if s.Default == nil {
+		return FirstSelection{}
+	}
+	return s.Default
+}
+
+// usedBySim and activationsBySim are implemented by the repositories and by their in-memory parts.
+type (
+	usedBySim interface {
+		BySimID(ctx context.Context, simId int) (core.List[*core.Used], error)
+	}
+	activationsBySim interface {
+		BySimID(ctx context.Context, simId int) (core.List[*core.Activation], error)
+	}
+)
+
+// selectionCandidates collects the facts the strategies choose by for the sims.
+func selectionCandidates(ctx context.Context, sims []*core.Sim, used usedBySim, activations activationsBySim) ([]SelectionCandidate, error) {
+	candidates := make([]SelectionCandidate, 0, len(sims))
+	for _, sim := range sims {
+		c := SelectionCandidate{Sim: sim}
+
+		usedList, err := used.BySimID(ctx, sim.Id())
+		if err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, err
+		}
+		for _, u := range usedList {
+			c.LastUsedAt = max(c.LastUsedAt, u.UsedAt())
+		}
+
+		activationList, err := activations.BySimID(ctx, sim.Id())
+		if err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, err
+		}
+		// the current activation started at the last activation that was not an extension
+		var lastId int
+		for _, a := range activationList {
+			if a.Kind() == core.ActivationKindActivate && a.Id() > lastId {
+				lastId = a.Id()
+				c.ActivatedAt = a.ActivatedAt()
+			}
+		}
+
+		candidates = append(candidates, c)
+	}
+	return candidates, nil
+}
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"math/rand/v2"
+	"simactive/internal/core"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testServiceID = 1
+
+// candidate returns a candidate of the sim with the id of the provider with the id.
+func candidate(simId, providerId int, lastUsedAt, activatedAt int64) SelectionCandidate {
+	provider := core.NewProvider(providerId, "provider")
+	sim := core.NewSim(simId, "+79000000000", &provider, core.SimStateActive, 0)
+	return SelectionCandidate{Sim: &sim, LastUsedAt: lastUsedAt, ActivatedAt: activatedAt}
+}
+
+func TestSelectionStrategies(t *testing.T) {
+	tests := []struct {
+		name       string
+		strategy   SelectionStrategy
+		candidates []SelectionCandidate
+		expected   int
+	}{
+		{
+			name:       "First picks the lowest id",
+			strategy:   FirstSelection{},
+			candidates: []SelectionCandidate{candidate(1, 1, 300, 30), candidate(2, 1, 100, 10)},
+			expected:   0,
+		},
+		{
+			name:       "Least recently used",
+			strategy:   LeastRecentlyUsedSelection{},
+			candidates: []SelectionCandidate{candidate(1, 1, 300, 0), candidate(2, 1, 100, 0), candidate(3, 2, 200, 0)},
+			expected:   1,
+		},
+		{
+			name:       "Least recently used prefers never used sims",
+			strategy:   LeastRecentlyUsedSelection{},
+			candidates: []SelectionCandidate{candidate(1, 1, 100, 0), candidate(2, 1, 0, 0)},
+			expected:   1,
+		},
+		{
+			name:       "Least recently used tie picks the lowest id",
+			strategy:   LeastRecentlyUsedSelection{},
+			candidates: []SelectionCandidate{candidate(1, 1, 300, 0), candidate(2, 1, 100, 0), candidate(3, 2, 100, 0)},
+			expected:   1,
+		},
+		{
+			name:       "Oldest activation",
+			strategy:   OldestActivationSelection{},
+			candidates: []SelectionCandidate{candidate(1, 1, 0, 30), candidate(2, 1, 0, 10), candidate(3, 2, 0, 40)},
+			expected:   1,
+		},
+		{
+			name:       "Oldest activation prefers unknown activations",
+			strategy:   OldestActivationSelection{},
+			candidates: []SelectionCandidate{candidate(1, 1, 0, 30), candidate(2, 1, 0, 0)},
+			expected:   1,
+		},
+		{
+			name:       "Oldest activation tie picks the lowest id",
+			strategy:   OldestActivationSelection{},
+			candidates: []SelectionCandidate{candidate(1, 1, 0, 30), candidate(2, 1, 0, 10), candidate(3, 2, 0, 10)},
+			expected:   1,
+		},
+		{
+			name:       "Random with one candidate",
+			strategy:   RandomSelection{Rand: rand.New(rand.NewPCG(1, 2))},
+			candidates: []SelectionCandidate{candidate(1, 1, 0, 0)},
+			expected:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.strategy.Select(testServiceID, tt.candidates))
+		})
+	}
+}
+
+func TestRoundRobinSelection(t *testing.T) {
+	tests := []struct {
+		name string
+		// rounds are the candidates of the consecutive selections for the service
+		rounds   [][]SelectionCandidate
+		expected []int
+	}{
+		{
+			name: "Takes turns between the providers",
+			rounds: [][]SelectionCandidate{
+				{candidate(1, 1, 0, 0), candidate(2, 1, 0, 0), candidate(3, 2, 0, 0), candidate(4, 3, 0, 0)},
+				{candidate(1, 1, 0, 0), candidate(2, 1, 0, 0), candidate(3, 2, 0, 0), candidate(4, 3, 0, 0)},
+				{candidate(1, 1, 0, 0), candidate(2, 1, 0, 0), candidate(3, 2, 0, 0), candidate(4, 3, 0, 0)},
+				{candidate(1, 1, 0, 0), candidate(2, 1, 0, 0), candidate(3, 2, 0, 0), candidate(4, 3, 0, 0)},
+			},
+			expected: []int{0, 2, 3, 0},
+		},
+		{
+			name: "One provider",
+			rounds: [][]SelectionCandidate{
+				{candidate(1, 1, 0, 0), candidate(2, 1, 0, 0)},
+				{candidate(1, 1, 0, 0), candidate(2, 1, 0, 0)},
+			},
+			expected: []int{0, 0},
+		},
+		{
+			name: "Skips the provider without free sims",
+			rounds: [][]SelectionCandidate{
+				{candidate(1, 1, 0, 0), candidate(3, 2, 0, 0), candidate(4, 3, 0, 0)},
+				{candidate(1, 1, 0, 0), candidate(4, 3, 0, 0)},
+				{candidate(1, 1, 0, 0), candidate(4, 3, 0, 0)},
+			},
+			expected: []int{0, 1, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy := NewRoundRobinSelection()
+			selected := make([]int, 0, len(tt.rounds))
+			for _, candidates := range tt.rounds {
+				selected = append(selected, strategy.Select(testServiceID, candidates))
+			}
+			assert.Equal(t, tt.expected, selected)
+		})
+	}
+}
+
+func TestRandomSelection(t *testing.T) {
+	candidates := []SelectionCandidate{candidate(1, 1, 0, 0), candidate(2, 1, 0, 0), candidate(3, 2, 0, 0)}
+
+	strategy := RandomSelection{Rand: rand.New(rand.NewPCG(1, 2))}
+	seen := make(map[int]bool)
+	for range 100 {
+		seen[strategy.Select(testServiceID, candidates)] = true
+	}
+	assert.Len(t, seen, len(candidates))
+}
+
+// usedRecords and activationRecords stand in for the repositories, see usedBySim and activationsBySim.
+type (
+	usedRecords       []core.Used
+	activationRecords []core.Activation
+)
+
+func (r usedRecords) BySimID(_ context.Context, simId int) (core.List[*core.Used], error) {
+	list := make(core.List[*core.Used])
+	for i := range r {
+		if r[i].SimID() == simId {
+			list[r[i].Id()] = &r[i]
+		}
+	}
+	return list, nil
+}
+
+func (r activationRecords) BySimID(_ context.Context, simId int) (core.List[*core.Activation], error) {
+	list := make(core.List[*core.Activation])
+	for i := range r {
+		if r[i].SimID() == simId {
+			list[r[i].Id()] = &r[i]
+		}
+	}
+	return list, nil
+}
+
+func TestSelectionCandidates(t *testing.T) {
+	tests := []struct {
+		name        string
+		candidates  []SelectionCandidate
+		used        usedRecords
+		activations activationRecords
+		expected    []SelectionCandidate
+	}{
+		{
+			name:       "No sims",
+			candidates: nil,
+			expected:   []SelectionCandidate{},
+		},
+		{
+			name:       "Sim without history",
+			candidates: []SelectionCandidate{candidate(1, 1, 0, 0)},
+			expected:   []SelectionCandidate{candidate(1, 1, 0, 0)},
+		},
+		{
+			name:       "Last use of any service",
+			candidates: []SelectionCandidate{candidate(1, 1, 0, 0), candidate(2, 1, 0, 0)},
+			used: usedRecords{
+				core.NewUsed(1, 1, 1, false, "").WithUsedAt(200),
+				core.NewUsed(2, 1, 2, false, "").WithUsedAt(300),
+				core.NewUsed(3, 2, 1, false, "").WithUsedAt(100),
+			},
+			expected: []SelectionCandidate{candidate(1, 1, 300, 0), candidate(2, 1, 100, 0)},
+		},
+		{
+			name:       "Extension keeps the start of the current activation",
+			candidates: []SelectionCandidate{candidate(1, 1, 0, 0), candidate(2, 1, 0, 0)},
+			activations: activationRecords{
+				core.NewActivation(1, 1, core.ActivationKindActivate, 10, 0, 1000),
+				core.NewActivation(2, 1, core.ActivationKindExtend, 500, 1000, 2000),
+				core.NewActivation(3, 2, core.ActivationKindActivate, 5, 0, 1000),
+				core.NewActivation(4, 2, core.ActivationKindActivate, 50, 1000, 2000),
+			},
+			expected: []SelectionCandidate{candidate(1, 1, 0, 10), candidate(2, 1, 0, 50)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sims := make([]*core.Sim, 0, len(tt.candidates))
+			for _, c := range tt.candidates {
+				sims = append(sims, c.Sim)
+			}
+
+			candidates, err := selectionCandidates(context.Background(), sims, tt.used, tt.activations)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, candidates)
+		})
+	}
+}
+
+func TestNewSelectionStrategies(t *testing.T) {
+	strategies, err := NewSelectionStrategies(SelectLeastRecentlyUsed, map[string]string{"Telegram": SelectRandom})
+	require.NoError(t, err)
+
+	assert.IsType(t, LeastRecentlyUsedSelection{}, strategies.For("whatsapp"))
+	assert.IsType(t, RandomSelection{}, strategies.For("telegram"))
+
+	_, err = NewSelectionStrategies("fastest", nil)
+	require.Error(t, err)
+
+	_, err = NewSelectionStrategies(SelectFirst, map[string]string{"telegram": "fastest"})
+	require.Error(t, err)
+}
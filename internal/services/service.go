@@ -2,8 +2,11 @@ package services
 
 import (
 	"context"
+	"errors"
 	"simactive/internal/core"
 	repository "simactive/internal/infrastructure"
+	"simactive/internal/infrastructure/repoerrors"
+	"time"
 )
 
 type ServiceService struct {
@@ -17,12 +20,218 @@ func NewServiceService(repo *repository.Repository) *ServiceService {
 	return ss
 }
 
+// Add adds the service with its metadata.
+//
+// Possibly errors: *repository.AlreadyExistsError if another service has the name,
+// core.ErrServiceParentNotFound, core.ErrNestedServiceGroup if the parent of the service is not valid.
 func (ss *ServiceService) Add(ctx context.Context, s *core.Service) (int, error) {
-	return ss.repository.ServiceRepository.Add(ctx, s.Name())
+	if err := ss.nameIsFree(ctx, s.Name(), 0); err != nil {
+		return 0, err
+	}
+	if err := ss.validateParent(ctx, 0, s.ParentID()); err != nil {
+		return 0, err
+	}
+
+	var id int
+	err := ss.repository.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		id, err = ss.repository.ServiceRepository.Add(ctx, s)
+		if err != nil {
+			return err
+		}
+
+		added := *s
+		added.SetID(id)
+		return audit(ctx, ss.repository, "AddService", core.AuditEntityService, id, nil, core.ServiceAuditValue(&added))
+	})
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
 }
+
+// Remove marks the service with the given id as deleted, it is kept with its used records until it is purged.
+//
+// Possibly errors: repository.ErrNotFound, repository.ErrInUse if the service has child services.
 func (ss *ServiceService) Remove(ctx context.Context, id int) error {
-	return ss.repository.ServiceRepository.Remove(ctx, id)
+	service, err := ss.repository.ServiceRepository.ByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	children, err := ss.repository.ServiceRepository.ByGroup(ctx, id)
+	if err != nil {
+		return err
+	}
+	for childId := range *children {
+		if childId != id {
+			return repoerrors.ErrInUse
+		}
+	}
+
+	return ss.repository.InTx(ctx, func(ctx context.Context) error {
+		if err := ss.repository.ServiceRepository.Remove(ctx, id); err != nil {
+			return err
+		}
+		return audit(ctx, ss.repository, "DeleteService", core.AuditEntityService, id, core.ServiceAuditValue(service), nil)
+	})
+}
+
+// Restore restores the deleted service with the given id.
+// A service whose parent is deleted is restored as a top level service.
+//
+// Returns the restored service.
+// Possibly errors: repository.ErrNotFound if there is no deleted service with the id,
+// *repository.AlreadyExistsError if another service got the name of the deleted service.
+func (ss *ServiceService) Restore(ctx context.Context, id int) (*core.Service, error) {
+	var restored *core.Service
+	err := ss.repository.InTx(ctx, func(ctx context.Context) error {
+		service, err := ss.repository.ServiceRepository.Restore(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := ss.nameIsFree(ctx, service.Name(), id); err != nil {
+			return err
+		}
+
+		if service.ParentID() != 0 {
+			_, err := ss.repository.ServiceRepository.ByID(ctx, service.ParentID())
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				// update a copy, so the in-memory service is not changed if the transaction is rolled back
+				updated := *service
+				noParent := 0
+				core.ServiceUpdate{ParentID: &noParent}.Apply(&updated)
+				if err := ss.repository.ServiceRepository.Update(ctx, &updated); err != nil {
+					return err
+				}
+				service = &updated
+			} else if err != nil {
+				return err
+			}
+		}
+
+		restored = service
+		return audit(ctx, ss.repository, "RestoreService", core.AuditEntityService, id, nil, core.ServiceAuditValue(service))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// Purge deletes the services deleted before the given time with their used records for good.
+// The children of a purged service become top level services.
+//
+// Returns the number of purged services.
+func (ss *ServiceService) Purge(ctx context.Context, deletedBefore time.Time) (int, error) {
+	var purged int
+	err := ss.repository.InTx(ctx, func(ctx context.Context) error {
+		ids, err := ss.repository.ServiceRepository.Purge(ctx, deletedBefore.Unix())
+		if err != nil {
+			return err
+		}
+		purged = len(ids)
+		return ss.repository.UsedRepository.EvictServices(ctx, ids)
+	})
+	return purged, err
 }
 func (ss *ServiceService) GetServiceList(ctx context.Context) (*core.List[*core.Service], error) {
 	return ss.repository.ServiceRepository.GetList(ctx)
 }
+
+// ByID retrieves the service with the given id.
+// Possibly errors: repository.ErrNotFound if the service does not exist.
+func (ss *ServiceService) ByID(ctx context.Context, id int) (*core.Service, error) {
+	return ss.repository.ServiceRepository.ByID(ctx, id)
+}
+
+// Update changes the fields of the service with the given id that are set in the update.
+// A disabled service keeps its used records, but the sims are not used for it anymore.
+//
+// Returns the updated service.
+// Possibly errors: repository.ErrNotFound if the service does not exist,
+// *repository.AlreadyExistsError if the service is renamed to the name of another service,
+// core.ErrServiceParentNotFound, core.ErrNestedServiceGroup if the new parent of the service is not valid.
+func (ss *ServiceService) Update(ctx context.Context, id int, update core.ServiceUpdate) (*core.Service, error) {
+	service, err := ss.repository.ServiceRepository.ByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Name != nil {
+		if err := ss.nameIsFree(ctx, *update.Name, id); err != nil {
+			return nil, err
+		}
+	}
+	if update.ParentID != nil {
+		if err := ss.validateParent(ctx, id, *update.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
+	// the service is updated on a copy, so readers of the cached service never see a half applied update
+	updated := *service
+	update.Apply(&updated)
+
+	err = ss.repository.InTx(ctx, func(ctx context.Context) error {
+		if err := ss.repository.ServiceRepository.Update(ctx, &updated); err != nil {
+			return err
+		}
+		return audit(ctx, ss.repository, "UpdateService", core.AuditEntityService, id, core.ServiceAuditValue(service), core.ServiceAuditValue(&updated))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// nameIsFree returns *repository.AlreadyExistsError if a service other than the one with the id has the name.
+func (ss *ServiceService) nameIsFree(ctx context.Context, name string, id int) error {
+	list, err := ss.repository.ServiceRepository.GetList(ctx)
+	if err != nil || list == nil {
+		return err
+	}
+
+	for _, s := range *list {
+		if s.Name() == name && s.Id() != id {
+			return &repoerrors.AlreadyExistsError{ID: s.Id()}
+		}
+	}
+	return nil
+}
+
+// validateParent checks that the service with the id can be a child of the parent, groups have one level.
+// The id is 0 for a new service, the parentId is 0 for a top level service.
+func (ss *ServiceService) validateParent(ctx context.Context, id, parentId int) error {
+	if parentId == 0 {
+		return nil
+	}
+	if parentId == id {
+		return core.ErrNestedServiceGroup
+	}
+
+	parent, err := ss.repository.ServiceRepository.ByID(ctx, parentId)
+	if errors.Is(err, repoerrors.ErrNotFound) {
+		return core.ErrServiceParentNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if parent.ParentID() != 0 {
+		return core.ErrNestedServiceGroup
+	}
+
+	if id == 0 {
+		return nil
+	}
+	children, err := ss.repository.ServiceRepository.ByGroup(ctx, id)
+	if err != nil {
+		return err
+	}
+	for childId := range *children {
+		if childId != id {
+			return core.ErrNestedServiceGroup
+		}
+	}
+	return nil
+}
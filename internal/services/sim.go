@@ -6,63 +6,551 @@ import (
 	"simactive/internal/core"
 	repository "simactive/internal/infrastructure"
 	"simactive/internal/infrastructure/repoerrors"
+	"simactive/internal/lib/phone"
+	"time"
 )
 
 type SimService struct {
 	repository *repository.Repository
+
+	// defaultActivationPeriod is used when neither the caller nor the sim provider sets an activation period
+	defaultActivationPeriod time.Duration
+	// policies decide which services are free for a sim
+	policies core.UsePolicies
 }
 
-func NewSimService(repository *repository.Repository) *SimService {
+func NewSimService(repository *repository.Repository, defaultActivationPeriod time.Duration, policies core.UsePolicies) *SimService {
 	ss := &SimService{
-		repository: repository,
+		repository:              repository,
+		defaultActivationPeriod: defaultActivationPeriod,
+		policies:                policies,
 	}
 	return ss
 }
+
+// Add adds the sim. A provider that does not exist yet is added too.
+// If the sim has no provider name, the provider is inferred from the country of the number,
+// it must be the only provider of the country. The network of a number is not known from its prefix,
+// so the mnc of the providers does not help to choose between the providers of a country.
+// An active sim without activateUntil gets the default activation period of its provider, if the provider has one.
+//
+// Possibly errors: *repository.AlreadyExistsError if a sim with the same number exists,
+// core.ErrProviderNotInferred if the provider can not be inferred.
 func (ss *SimService) Add(ctx context.Context, s *core.Sim) (int, error) {
+	var id int
+	err := ss.repository.InTx(ctx, func(ctx context.Context) error {
+		var (
+			provider *core.Provider
+			err      error
+		)
+		if s.Provider() == nil || core.ProviderNameKey(s.Provider().Name()) == "" {
+			provider, err = ss.inferProvider(ctx, s.Country())
+		} else {
+			provider, err = ss.resolveProvider(ctx, s.Provider().Name())
+		}
+		if err != nil {
+			return err
+		}
+		s.SetProvider(provider)
+
+		if s.State() == core.SimStateActive && s.ActivateUntil() == 0 && provider.DefaultActivationPeriod() > 0 {
+			s.SetActivateUntil(time.Now().Unix() + provider.DefaultActivationPeriod())
+		}
+
+		id, err = ss.repository.SimRepository.Add(ctx, s.Number(), s.Country(), s.Provider(), s.State(), s.ActivateUntil())
+		if err != nil {
+			return err
+		}
 
-	// retrive provider data
-	// if provider doest not exist, add it
+		added := *s
+		added.SetID(id)
+		return audit(ctx, ss.repository, "AddSim", core.AuditEntitySim, id, nil, core.SimAuditValue(&added))
+	})
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
 
-	provider, err := ss.repository.ProviderRepository.ByName(ctx, s.Provider().Name())
+// UpdateSim changes the fields of the sim with the given id that are set in the update.
+// A provider that does not exist yet is added, the same way as on Add.
+//
+// ctx context.Context, id int, update core.SimUpdate
+// *core.Sim, error. Returns the updated sim.
+// Possibly errors: repository.ErrNotFound, *repository.AlreadyExistsError if another sim has the new number.
+func (ss *SimService) UpdateSim(ctx context.Context, id int, update core.SimUpdate) (*core.Sim, error) {
+	sim, err := ss.repository.SimRepository.ByID(ctx, id)
 	if err != nil {
-		if !errors.Is(err, repoerrors.ErrNotFound) {
-			return 0, err
+		return nil, err
+	}
+
+	updated := *sim
+	if update.Number != nil && *update.Number != sim.Number() {
+		other, err := ss.repository.SimRepository.ByNumber(ctx, *update.Number)
+		if err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, err
+		}
+		if other != nil && other.Id() != id {
+			return nil, &repoerrors.AlreadyExistsError{ID: other.Id()}
+		}
+		updated.SetNumber(*update.Number)
+		if update.Country != nil {
+			updated.SetCountry(*update.Country)
+		}
+	}
+
+	if update.ActivateUntil != nil {
+		updated.SetActivateUntil(*update.ActivateUntil)
+	}
+
+	err = ss.repository.InTx(ctx, func(ctx context.Context) error {
+		// a provider added for the sim is rolled back with the update
+		if update.ProviderName != nil {
+			provider, err := ss.resolveProvider(ctx, *update.ProviderName)
+			if err != nil {
+				return err
+			}
+			updated.SetProvider(provider)
+		}
+
+		if err := ss.repository.SimRepository.Update(ctx, &updated); err != nil {
+			return err
 		}
+		return audit(ctx, ss.repository, "UpdateSim", core.AuditEntitySim, id, core.SimAuditValue(sim), core.SimAuditValue(&updated))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// resolveProvider retrieves the provider with the given name or alias, the provider is added if it does not exist.
+// Names and aliases are matched by core.ProviderNameKey.
+func (ss *SimService) resolveProvider(ctx context.Context, name string) (*core.Provider, error) {
+	provider, err := ss.repository.ProviderRepository.ByName(ctx, name)
+	if errors.Is(err, repoerrors.ErrNotFound) {
+		provider, err = ss.repository.ProviderRepository.ByAlias(ctx, name)
+	}
+	if err == nil {
+		p := *provider
+		return &p, nil
+	}
+	if !errors.Is(err, repoerrors.ErrNotFound) {
+		return nil, err
+	}
 
-		id, err := ss.repository.ProviderRepository.Add(ctx, s.Provider().Name())
+	p := core.NewProvider(0, name)
+	id, err := ss.repository.ProviderRepository.Add(ctx, &p)
+	if errors.Is(err, repoerrors.ErrAlreadyExists) {
+		// a concurrent request added the provider first
+		provider, err = ss.repository.ProviderRepository.ByName(ctx, name)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		p := *provider
+		return &p, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p.SetId(id)
+	return &p, nil
+}
+
+// inferProvider returns the only provider of the country, the country is known from the number prefix.
+// A provider added with an mcc and without a country gets the country of the mcc.
+//
+// Possibly errors: core.ErrProviderNotInferred if the country has no provider or more than one.
+func (ss *SimService) inferProvider(ctx context.Context, country string) (*core.Provider, error) {
+	if country == "" {
+		return nil, core.ErrProviderNotInferred
+	}
+
+	providers, err := ss.repository.ProviderRepository.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *core.Provider
+	for _, p := range *providers {
+		if p.Country() != country {
+			continue
+		}
+		if found != nil {
+			return nil, core.ErrProviderNotInferred
 		}
-		s.Provider().SetId(id)
-	} else {
-		s.Provider().SetId(provider.Id())
+		found = p
+	}
+	if found == nil {
+		return nil, core.ErrProviderNotInferred
 	}
 
-	return ss.repository.SimRepository.Add(ctx, s.Number(), s.Provider(), s.IsActivated(), s.ActivateUntil(), s.IsBlocked())
+	p := *found
+	return &p, nil
 }
+
+// Remove marks the sim with the given id as deleted, it is kept with its used records until it is purged.
+//
+// Possibly errors: repository.ErrNotFound if there is no not deleted sim with the id.
 func (ss *SimService) Remove(ctx context.Context, id int) error {
-	return ss.repository.SimRepository.Remove(ctx, id)
+	sim, err := ss.repository.SimRepository.ByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return ss.repository.InTx(ctx, func(ctx context.Context) error {
+		if err := ss.repository.SimRepository.Remove(ctx, id); err != nil {
+			return err
+		}
+		return audit(ctx, ss.repository, "DeleteSim", core.AuditEntitySim, id, core.SimAuditValue(sim), nil)
+	})
+}
+
+// Restore restores the deleted sim with the given id.
+//
+// Returns the restored sim.
+// Possibly errors: repository.ErrNotFound if there is no deleted sim with the id,
+// *repository.AlreadyExistsError if another sim got the number of the deleted sim.
+func (ss *SimService) Restore(ctx context.Context, id int) (*core.Sim, error) {
+	var restored *core.Sim
+	err := ss.repository.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		restored, err = ss.repository.SimRepository.Restore(ctx, id)
+		if err != nil {
+			return err
+		}
+		return audit(ctx, ss.repository, "RestoreSim", core.AuditEntitySim, id, nil, core.SimAuditValue(restored))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// Purge deletes the sims deleted before the given time with their activations and used records for good.
+//
+// Returns the number of purged sims.
+func (ss *SimService) Purge(ctx context.Context, deletedBefore time.Time) (int, error) {
+	var purged int
+	err := ss.repository.InTx(ctx, func(ctx context.Context) error {
+		ids, err := ss.repository.SimRepository.Purge(ctx, deletedBefore.Unix())
+		if err != nil {
+			return err
+		}
+		purged = len(ids)
+		if err := ss.repository.UsedRepository.EvictSims(ctx, ids); err != nil {
+			return err
+		}
+		return ss.repository.ActivationRepository.EvictSims(ctx, ids)
+	})
+	return purged, err
+}
+
+// BackfillCountries sets the country of the sims stored before the country was, see sql/6_sim_e164_number.
+// A number that can not be parsed keeps the empty country, which is read as unknown, see core.Service.SupportsCountry.
+//
+// Returns the number of updated sims.
+func (ss *SimService) BackfillCountries(ctx context.Context) (int, error) {
+	sims, err := ss.repository.SimRepository.GetList(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var updated int
+	for _, sim := range *sims {
+		if sim.Country() != "" {
+			continue
+		}
+		n, err := phone.Parse(sim.Number())
+		if err != nil {
+			continue
+		}
+
+		withCountry := *sim
+		withCountry.SetCountry(n.Country)
+		if err := ss.repository.SimRepository.Update(ctx, &withCountry); err != nil {
+			return updated, err
+		}
+		updated++
+	}
+	return updated, nil
+}
+
+// GetSim retrieves the sim with the given id.
+//
+// Possibly errors: repository.ErrNotFound if sim with given id does not exist.
+func (ss *SimService) GetSim(ctx context.Context, id int) (*core.Sim, error) {
+	return ss.repository.SimRepository.ByID(ctx, id)
+}
+
+// GetSimByNumber retrieves the sim with the given phone number.
+//
+// Possibly errors: repository.ErrNotFound if sim with given number does not exist.
+func (ss *SimService) GetSimByNumber(ctx context.Context, number string) (*core.Sim, error) {
+	return ss.repository.SimRepository.ByNumber(ctx, number)
 }
 func (ss *SimService) GetSimList(ctx context.Context) (*core.List[*core.Sim], error) {
 	return ss.repository.SimRepository.GetList(ctx)
 }
-func (ss *SimService) ActivateSim(ctx context.Context, id int) error {
+
+// ListSims retrieves a page of the sims that pass the query filter, the page size is the query limit.
+// The returned cursor points at the last sim of the page, it is nil if there are no more sims.
+//
+// ctx context.Context, q core.SimQuery
+// []*core.Sim, *core.SimCursor, error
+func (ss *SimService) ListSims(ctx context.Context, q core.SimQuery) ([]*core.Sim, *core.SimCursor, error) {
+	pageSize := q.Limit
+	if pageSize > 0 {
+		// one more sim tells if there is a next page
+		q.Limit = pageSize + 1
+	}
+
+	sims, err := ss.repository.SimRepository.List(ctx, q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if pageSize == 0 || len(sims) <= pageSize {
+		return sims, nil, nil
+	}
+
+	sims = sims[:pageSize]
+	cursor := core.CursorOf(sims[pageSize-1])
+	return sims, &cursor, nil
+}
+
+// ActivateSim activates the sim with the given id or extends its current activation.
+// The sim is activated until the given unix timestamp if until is not 0, otherwise for the given period.
+// If period is 0 too, the default period of the sim provider is used and then the service default period.
+// An activation of a sim that is still active extends it from its current activateUntil, not from now.
+// Every activation is recorded in the activation history.
+//
+// ctx context.Context, id int, until int64, period time.Duration, reason string
+// int64, error. Returns the timestamp the sim is activated until.
+// Possibly errors: repository.ErrNotFound, core.ErrIllegalTransition if the sim is blocked or retired.
+func (ss *SimService) ActivateSim(ctx context.Context, id int, until int64, period time.Duration, reason string) (int64, error) {
 	sim, err := ss.repository.SimRepository.ByID(ctx, id)
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	now := time.Now()
+	kind := core.ActivationKindActivate
+	from := now
+	if sim.IsActivated() && sim.ActivateUntil() > now.Unix() {
+		kind = core.ActivationKindExtend
+		from = time.Unix(sim.ActivateUntil(), 0)
+	}
+
+	if until == 0 {
+		if period == 0 {
+			period, err = ss.activationPeriod(ctx, sim)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		// no period at all means the sim is activated without an end
+		if period != 0 {
+			until = from.Add(period).Unix()
+		}
+	}
+
+	updated := *sim
+	if err := updated.Activate(until, reason); err != nil {
+		return 0, err
+	}
+
+	err = ss.repository.InTx(ctx, func(ctx context.Context) error {
+		if err := ss.repository.SimRepository.Update(ctx, &updated); err != nil {
+			return err
+		}
+		if _, err := ss.repository.ActivationRepository.Add(ctx, id, kind, now.Unix(), sim.ActivateUntil(), until); err != nil {
+			return err
+		}
+		return audit(ctx, ss.repository, "ActivateSim", core.AuditEntitySim, id, core.SimAuditValue(sim), core.SimAuditValue(&updated))
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return until, nil
+}
+
+// activationPeriod returns the default activation period of the sim provider,
+// or the service default period if the provider has none.
+func (ss *SimService) activationPeriod(ctx context.Context, sim *core.Sim) (time.Duration, error) {
+	provider, err := ss.repository.ProviderRepository.ByID(ctx, sim.Provider().Id())
+	if err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+		return 0, err
+	}
+
+	if provider != nil && provider.DefaultActivationPeriod() > 0 {
+		return time.Duration(provider.DefaultActivationPeriod()) * time.Second, nil
 	}
-	sim.SetActivated(true)
-	return ss.repository.SimRepository.Update(ctx, sim)
+	return ss.defaultActivationPeriod, nil
 }
-func (ss *SimService) BlockSim(ctx context.Context, id int) error {
+
+// GetActivationHistory retrieves the activation history of the sim with the given id.
+//
+// ctx context.Context, id int
+// core.List[*core.Activation], error. Possibly errors: repository.ErrNotFound if sim with given id does not exist.
+func (ss *SimService) GetActivationHistory(ctx context.Context, id int) (core.List[*core.Activation], error) {
+	if _, err := ss.repository.SimRepository.ByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return ss.repository.ActivationRepository.BySimID(ctx, id)
+}
+
+// ExpireSims deactivates every activated sim whose activation period has ended at now.
+// Sims with activateUntil equal to 0 have no activation period and never expire.
+//
+// ctx context.Context, now time.Time
+// []*core.Sim, error. Returns the deactivated sims, also if an error occurred on a later sim.
+func (ss *SimService) ExpireSims(ctx context.Context, now time.Time) ([]*core.Sim, error) {
+	list, err := ss.repository.SimRepository.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var expired []*core.Sim
+	for _, sim := range *list {
+		if !sim.IsActivated() || sim.ActivateUntil() == 0 || sim.ActivateUntil() > now.Unix() {
+			continue
+		}
+
+		updated := *sim
+		if err := updated.Expire("activation period ended"); err != nil {
+			return expired, err
+		}
+		err := ss.repository.InTx(ctx, func(ctx context.Context) error {
+			if err := ss.repository.SimRepository.Update(ctx, &updated); err != nil {
+				return err
+			}
+			return audit(ctx, ss.repository, "ExpireSim", core.AuditEntitySim, sim.Id(), core.SimAuditValue(sim), core.SimAuditValue(&updated))
+		})
+		if err != nil {
+			return expired, err
+		}
+		expired = append(expired, &updated)
+	}
+
+	return expired, nil
+}
+
+// BlockSim blocks the sim with the given id. Blocking an already blocked sim changes nothing.
+//
+// Possibly errors: repository.ErrNotFound, core.ErrIllegalTransition if the sim is retired.
+func (ss *SimService) BlockSim(ctx context.Context, id int, reason string) (*core.Sim, error) {
+	return ss.transition(ctx, id, "BlockSim", func(s *core.Sim) error {
+		return s.Block(reason)
+	})
+}
+
+// UnblockSim unblocks the sim with the given id.
+// The sim gets active if its activation period has not ended yet, otherwise it gets expired.
+//
+// Possibly errors: repository.ErrNotFound, core.ErrIllegalTransition if the sim is not blocked.
+func (ss *SimService) UnblockSim(ctx context.Context, id int, reason string) (*core.Sim, error) {
+	return ss.transition(ctx, id, "UnblockSim", func(s *core.Sim) error {
+		return s.Unblock(time.Now().Unix(), reason)
+	})
+}
+
+// DeactivateSim deactivates the active sim with the given id before its activation period ends.
+//
+// Possibly errors: repository.ErrNotFound, core.ErrIllegalTransition if the sim is not active.
+func (ss *SimService) DeactivateSim(ctx context.Context, id int, reason string) (*core.Sim, error) {
+	return ss.transition(ctx, id, "DeactivateSim", func(s *core.Sim) error {
+		return s.Expire(reason)
+	})
+}
+
+// RetireSim takes the sim with the given id out of service for good.
+//
+// Possibly errors: repository.ErrNotFound, core.ErrIllegalTransition if the sim is already retired.
+func (ss *SimService) RetireSim(ctx context.Context, id int, reason string) (*core.Sim, error) {
+	return ss.transition(ctx, id, "RetireSim", func(s *core.Sim) error {
+		return s.Retire(reason)
+	})
+}
+
+// transition applies the state transition to a copy of the sim and stores the copy,
+// the transition is audited as the action. The cached sim stays untouched if the transition is illegal,
+// a transition that changes nothing is neither stored nor audited.
+func (ss *SimService) transition(ctx context.Context, id int, action string, apply func(s *core.Sim) error) (*core.Sim, error) {
 	sim, err := ss.repository.SimRepository.ByID(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	updated := *sim
+	if err := apply(&updated); err != nil {
+		return nil, err
 	}
-	sim.SetBlocked(true)
-	return ss.repository.SimRepository.Update(ctx, sim)
+	if updated == *sim {
+		return sim, nil
+	}
+
+	err = ss.repository.InTx(ctx, func(ctx context.Context) error {
+		if err := ss.repository.SimRepository.Update(ctx, &updated); err != nil {
+			return err
+		}
+		return audit(ctx, ss.repository, action, core.AuditEntitySim, id, core.SimAuditValue(sim), core.SimAuditValue(&updated))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
 }
 
+// GetFreeServiceList retrieves the enabled services that accept the country of the sim with the given number
+// and the use policies allow the sim to be used for now, the services of a group are checked as one unit.
+// Services the sim is still used for or blocked on are never free, see core.UsePolicy.
+//
+// ctx context.Context, number string
+// core.List[*core.Service], error. Possibly errors: repository.ErrNotFound if sim with given number does not exist.
+func (ss *SimService) GetFreeServiceList(ctx context.Context, number string) (core.List[*core.Service], error) {
+	sim, err := ss.repository.SimRepository.ByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := ss.repository.ServiceRepository.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usedList, err := ss.repository.UsedRepository.BySimID(ctx, sim.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	free := make(core.List[*core.Service])
+	for id, service := range *services {
+		if len(core.ServiceEligibility(service, sim)) != 0 {
+			continue
+		}
+		// the services of a group are one unit, a use of a linked service is a use of the service
+		linked := core.ServiceGroupOf(*services, service).Linked(id)
+		if ss.policies.For(service.Name()).CheckGroup(id, linked, usedList, now) == nil {
+			free[id] = service
+		}
+	}
+	return free, nil
+}
+
+// GetUsedServiceList retrieves the used records of the sim with the given id.
+//
+// ctx context.Context, id int
+// core.List[*core.Used], error. Possibly errors: repository.ErrNotFound if sim with given id does not exist.
 func (ss *SimService) GetUsedServiceList(ctx context.Context, id int) (core.List[*core.Used], error) {
-	panic("")
+	if _, err := ss.repository.SimRepository.ByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return ss.repository.UsedRepository.BySimID(ctx, id)
 }
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"simactive/internal/core"
+	"simactive/internal/infrastructure/repoerrors"
+)
+
+// errImportRolledBack rolls back the import transaction if a sim of an all-or-nothing import fails.
+var errImportRolledBack = errors.New("import rolled back")
+
+// ImportSims adds the sims one by one the same way as Add, providers that do not exist yet are added too.
+// A duplicate number or a provider that can not be inferred does not stop the import,
+// it is reported in the result of the sim.
+//
+// If allOrNothing is true, the sims are added inside one sql transaction
+// that is rolled back if any of the sims is a duplicate. Committed reports whether the sims are stored.
+//
+// ctx context.Context, sims []*core.Sim, allOrNothing bool
+// []core.SimImportResult in the order of the sims, committed bool, error
+func (ss *SimService) ImportSims(ctx context.Context, sims []*core.Sim, allOrNothing bool) ([]core.SimImportResult, bool, error) {
+	results := make([]core.SimImportResult, len(sims))
+
+	importAll := func(ctx context.Context) (failed bool, err error) {
+		for i, sim := range sims {
+			id, err := ss.Add(ctx, sim)
+			if err != nil {
+				if !errors.Is(err, repoerrors.ErrAlreadyExists) && !errors.Is(err, core.ErrProviderNotInferred) {
+					return failed, err
+				}
+
+				var existsErr *repoerrors.AlreadyExistsError
+				if errors.As(err, &existsErr) {
+					id = existsErr.ID
+				}
+				failed = true
+			}
+			results[i] = core.SimImportResult{ID: id, Err: err}
+		}
+		return failed, nil
+	}
+
+	if !allOrNothing {
+		if _, err := importAll(ctx); err != nil {
+			return nil, false, err
+		}
+		return results, true, nil
+	}
+
+	err := ss.repository.InTx(ctx, func(ctx context.Context) error {
+		failed, err := importAll(ctx)
+		if err != nil {
+			return err
+		}
+		if failed {
+			return errImportRolledBack
+		}
+		return nil
+	})
+	if err == nil {
+		return results, true, nil
+	}
+	if !errors.Is(err, errImportRolledBack) {
+		return nil, false, err
+	}
+
+	// ids of the rolled back sims do not exist anymore, also for duplicates of them
+	rolledBack := make(map[int]struct{})
+	for i, r := range results {
+		if r.Err == nil {
+			rolledBack[r.ID] = struct{}{}
+			results[i].ID = 0
+		}
+	}
+	for i, r := range results {
+		if _, ok := rolledBack[r.ID]; ok {
+			results[i].ID = 0
+		}
+	}
+	return results, false, nil
+}
@@ -2,24 +2,46 @@ package services
 
 import (
 	"context"
+	"errors"
 	"simactive/internal/core"
 	repository "simactive/internal/infrastructure"
+	"simactive/internal/infrastructure/repoerrors"
+	"slices"
+	"sync"
+	"time"
 )
 
+// DefaultLeaseTTL is the lease duration used when the caller does not specify one.
+const DefaultLeaseTTL = 5 * time.Minute
+
 // UsedService is a service for handling operations related to used resources.
 type UsedService struct {
 	repository *repository.Repository
+	policies   core.UsePolicies
+	strategies SelectionStrategies
+	// propagation tells whether a block on a service blocks the sim on the other services of its group
+	propagation core.BlockPropagation
+
+	// mu serializes sim allocation, so two callers never receive the same sim for a service.
+	mu     sync.Mutex
+	leases *leaseStore
 }
 
-func NewUsedService(repo *repository.Repository) *UsedService {
+func NewUsedService(repo *repository.Repository, policies core.UsePolicies, strategies SelectionStrategies, propagation core.BlockPropagation) *UsedService {
 	ss := &UsedService{
-		repository: repo,
+		repository:  repo,
+		policies:    policies,
+		strategies:  strategies,
+		propagation: propagation,
+		leases:      newLeaseStore(),
 	}
 	return ss
 }
 
 // UseSimForService is a method to mark a sim as used for a specific service.
 // It creates a new entry in the 'used' table in the database.
+// The eligibility of the sim for the service is checked first, see CheckEligibility,
+// so a sim leased by AcquireSim is only used through ConfirmLease.
 //
 // Parameters:
 //   - ctx: The context.Context object for the request.
@@ -28,17 +50,475 @@ func NewUsedService(repo *repository.Repository) *UsedService {
 //
 // Returns:
 //   - error: An error if the operation fails. nil if the operation is successful.
+//     *core.NotEligibleError with the reasons if the sim can not be used for the service.
 func (us *UsedService) UseSimForService(
 	ctx context.Context,
 	simId int,
 	serviceId int,
 ) error {
+	us.mu.Lock()
+	defer us.mu.Unlock()
+
+	now := time.Now().Unix()
+	eligibility, err := us.eligibility(ctx, simId, serviceId, now, "")
+	if err != nil {
+		return err
+	}
+	if !eligibility.Eligible() {
+		return &core.NotEligibleError{Eligibility: eligibility}
+	}
+
 	// Create a new used object with the provided IDs.
-	used := core.Used{}.WithSimID(simId).WithServiceID(serviceId)
+	used := core.Used{}.WithSimID(simId).WithServiceID(serviceId).WithUsedAt(now)
+
+	// Save the used object to the 'used' table in the database together with its audit entry.
+	return us.repository.InTx(ctx, func(ctx context.Context) error {
+		id, err := us.repository.UsedRepository.Add(ctx, used.SimID(), used.ServiceID(), used.IsBlocked(), used.BlockedInfo(), used.BlockedAt(), used.UsedAt())
+		if err != nil {
+			return err
+		}
+		used.SetId(id)
+		return audit(ctx, us.repository, "UseSimForService", core.AuditEntityUsed, id, nil, core.UsedAuditValue(&used))
+	})
+}
+
+// AcquireSim reserves an activated, unblocked sim of a country the service accepts,
+// that the use policy of the service allows to be used for it.
+// When several sims are free, the selection strategy of the service picks one of them.
+// The sim stays reserved until the lease is confirmed, released or expired.
+//
+// Parameters:
+//   - ctx: The context.Context object for the request.
+//   - serviceId: The ID of the service the sim is acquired for.
+//   - ttl: The lease duration, DefaultLeaseTTL is used if ttl is 0.
+//
+// Returns:
+//   - core.Lease: The lease of the acquired sim.
+//   - *core.Sim: The acquired sim.
+//   - error: repository.ErrNotFound if the service does not exist, core.ErrServiceDisabled if the service is disabled,
+//     core.ErrNoFreeSim if no sim is available.
+func (us *UsedService) AcquireSim(ctx context.Context, serviceId int, ttl time.Duration) (core.Lease, *core.Sim, error) {
+	if ttl == 0 {
+		ttl = DefaultLeaseTTL
+	}
+
+	service, err := us.repository.ServiceRepository.ByID(ctx, serviceId)
+	if err != nil {
+		return core.Lease{}, nil, err
+	}
+	if !service.Enabled() {
+		return core.Lease{}, nil, core.ErrServiceDisabled
+	}
+	group, err := us.serviceGroup(ctx, service)
+	if err != nil {
+		return core.Lease{}, nil, err
+	}
+
+	now := time.Now()
+
+	// only the active sims of the countries the service accepts are read, in id order,
+	// so allocation does not depend on the order of the rows
+	sims, err := us.repository.SimRepository.List(ctx, core.SimQuery{
+		Filter: core.SimFilter{States: []core.SimState{core.SimStateActive}, Countries: service.Countries()},
+	})
+	if err != nil {
+		return core.Lease{}, nil, err
+	}
+
+	free := make([]*core.Sim, 0, len(sims))
+	for _, sim := range sims {
+		// the expiry worker may not have expired the sim yet
+		if len(core.SimEligibility(sim, now.Unix())) != 0 || len(core.ServiceEligibility(service, sim)) != 0 {
+			continue
+		}
+
+		records, err := us.repository.UsedRepository.BySimID(ctx, sim.Id())
+		if err != nil {
+			return core.Lease{}, nil, err
+		}
+		if err := us.checkPolicy(records, service, group, now.Unix()); err != nil {
+			if isPolicyError(err) {
+				continue
+			}
+			return core.Lease{}, nil, err
+		}
+		free = append(free, sim)
+	}
+
+	candidates, err := selectionCandidates(ctx, free, us.repository.UsedRepository, us.repository.ActivationRepository)
+	if err != nil {
+		return core.Lease{}, nil, err
+	}
+
+	// only the reservation is serialized, ConfirmLease checks the eligibility of the sim again
+	us.mu.Lock()
+	defer us.mu.Unlock()
+
+	us.leases.sweep(now.Unix())
+	// a sim leased for a service of the group is leased for the whole group
+	candidates = slices.DeleteFunc(candidates, func(c SelectionCandidate) bool {
+		return us.leases.isLeased(c.Sim.Id(), group.ServiceIDs, now.Unix(), "")
+	})
+	if len(candidates) == 0 {
+		return core.Lease{}, nil, core.ErrNoFreeSim
+	}
+	sim := candidates[us.strategies.For(service.Name()).Select(serviceId, candidates)].Sim
+
+	lease, err := us.leases.add(sim.Id(), serviceId, now.Add(ttl).Unix())
+	if err != nil {
+		return core.Lease{}, nil, err
+	}
+	return lease, sim, nil
+}
+
+// ConfirmLease marks the leased sim as used for the leased service and ends the lease.
+//
+// Returns the ID of the created used record.
+// Possibly errors: core.ErrLeaseNotFound if the lease does not exist or is expired,
+// *core.NotEligibleError if the sim can not be used for the service anymore, e.g. it was used or blocked in the meantime.
+func (us *UsedService) ConfirmLease(ctx context.Context, leaseId string) (int, error) {
+	us.mu.Lock()
+	defer us.mu.Unlock()
+
+	now := time.Now().Unix()
+	lease, err := us.leases.get(leaseId, now)
+	if err != nil {
+		return 0, err
+	}
+
+	// the confirmed lease itself does not make the sim leased
+	eligibility, err := us.eligibility(ctx, lease.SimID(), lease.ServiceID(), now, leaseId)
+	if err != nil {
+		return 0, err
+	}
+	if !eligibility.Eligible() {
+		us.leases.remove(leaseId)
+		return 0, &core.NotEligibleError{Eligibility: eligibility}
+	}
+
+	used := core.Used{}.WithSimID(lease.SimID()).WithServiceID(lease.ServiceID()).WithUsedAt(now)
+	err = us.repository.InTx(ctx, func(ctx context.Context) error {
+		id, err := us.repository.UsedRepository.Add(ctx, used.SimID(), used.ServiceID(), false, "", 0, now)
+		if err != nil {
+			return err
+		}
+		used.SetId(id)
+		return audit(ctx, us.repository, "ConfirmLease", core.AuditEntityUsed, id, nil, core.UsedAuditValue(&used))
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	us.leases.remove(leaseId)
+	return used.Id(), nil
+}
+
+// ReleaseLease ends the lease without using the sim, so it can be acquired again.
+//
+// Possibly errors: core.ErrLeaseNotFound if the lease does not exist or is expired.
+func (us *UsedService) ReleaseLease(ctx context.Context, leaseId string) error {
+	us.mu.Lock()
+	defer us.mu.Unlock()
+
+	if _, err := us.leases.get(leaseId, time.Now().Unix()); err != nil {
+		return err
+	}
+
+	us.leases.remove(leaseId)
+	return nil
+}
+
+// BlockUsedService marks the sim as blocked on the service with the reason and the current time.
+// If the sim has no used record for the service yet, a blocked record is created,
+// so the service is not offered for the sim anymore.
+// If the group of the service propagates blocks, the sim is blocked on every service of the group too.
+//
+// Returns the blocked used record of the service.
+// Possibly errors: core.ErrSimNotFound, core.ErrServiceNotFound.
+func (us *UsedService) BlockUsedService(ctx context.Context, simId, serviceId int, reason string) (*core.Used, error) {
+	if _, err := us.repository.SimRepository.ByID(ctx, simId); err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, core.ErrSimNotFound
+		}
+		return nil, err
+	}
+	service, err := us.repository.ServiceRepository.ByID(ctx, serviceId)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, core.ErrServiceNotFound
+		}
+		return nil, err
+	}
+	linked, err := us.propagatedServices(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+
+	us.mu.Lock()
+	defer us.mu.Unlock()
+
+	now := time.Now().Unix()
+	var blocked *core.Used
+	err = us.repository.InTx(ctx, func(ctx context.Context) error {
+		for _, id := range linked {
+			if _, err := us.block(ctx, simId, id, reason, now); err != nil {
+				return err
+			}
+		}
+		var err error
+		blocked, err = us.block(ctx, simId, serviceId, reason, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return blocked, nil
+}
+
+// block blocks the sim on the service, the used record is created if the sim has none for the service.
+// The block of every record is audited.
+func (us *UsedService) block(ctx context.Context, simId, serviceId int, reason string, now int64) (*core.Used, error) {
+	used, err := us.usedRecord(ctx, simId, serviceId)
+	if err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+		return nil, err
+	}
+
+	if used == nil {
+		// the sim is not registered with the service, usedAt stays 0 so the block is not counted by UsePolicy.MaxPerDay
+		blocked := core.Used{}.WithSimID(simId).WithServiceID(serviceId)
+		blocked.Block(reason, now)
 
-	// Save the used object to the 'used' table in the database.
-	_, err := us.repository.UsedRepository.Add(ctx, used.SimID(), used.ServiceID(), used.IsBlocked(), used.BlockedInfo())
+		id, err := us.repository.UsedRepository.Add(ctx, simId, serviceId, blocked.IsBlocked(), blocked.BlockedInfo(), blocked.BlockedAt(), blocked.UsedAt())
+		if err != nil {
+			return nil, err
+		}
+		blocked.SetId(id)
+		if err := audit(ctx, us.repository, "BlockUsedService", core.AuditEntityUsed, id, nil, core.UsedAuditValue(&blocked)); err != nil {
+			return nil, err
+		}
+		return &blocked, nil
+	}
+
+	// the record is updated on a copy, so readers of the cached record never see a half applied block
+	blocked := *used
+	blocked.Block(reason, now)
+	if err := us.repository.UsedRepository.Update(ctx, &blocked); err != nil {
+		return nil, err
+	}
+	if err := audit(ctx, us.repository, "BlockUsedService", core.AuditEntityUsed, used.Id(), core.UsedAuditValue(used), core.UsedAuditValue(&blocked)); err != nil {
+		return nil, err
+	}
+	return &blocked, nil
+}
+
+// UnblockUsedService clears the block of the sim on the service. The used record itself stays,
+// so the service is still not offered for the sim. Unblocking a sim that is not blocked does nothing.
+// If the group of the service propagates blocks, the sim is unblocked on every service of the group too.
+//
+// Returns the unblocked used record of the service.
+// Possibly errors: repository.ErrNotFound if the sim has no used record for the service.
+func (us *UsedService) UnblockUsedService(ctx context.Context, simId, serviceId int) (*core.Used, error) {
+	var linked []int
+	service, err := us.repository.ServiceRepository.ByID(ctx, serviceId)
+	switch {
+	case err == nil:
+		if linked, err = us.propagatedServices(ctx, service); err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, repoerrors.ErrNotFound):
+		return nil, err
+	}
+
+	us.mu.Lock()
+	defer us.mu.Unlock()
+
+	used, err := us.usedRecord(ctx, simId, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var unblocked *core.Used
+	err = us.repository.InTx(ctx, func(ctx context.Context) error {
+		for _, id := range linked {
+			linkedUsed, err := us.usedRecord(ctx, simId, id)
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if _, err := us.unblock(ctx, linkedUsed); err != nil {
+				return err
+			}
+		}
+		var err error
+		unblocked, err = us.unblock(ctx, used)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return unblocked, nil
+}
+
+// unblock clears the block of the used record and audits it, a record that is not blocked is returned as it is.
+func (us *UsedService) unblock(ctx context.Context, used *core.Used) (*core.Used, error) {
+	if !used.IsBlocked() {
+		return used, nil
+	}
+
+	unblocked := *used
+	unblocked.Unblock()
+	if err := us.repository.UsedRepository.Update(ctx, &unblocked); err != nil {
+		return nil, err
+	}
+	if err := audit(ctx, us.repository, "UnblockUsedService", core.AuditEntityUsed, used.Id(), core.UsedAuditValue(used), core.UsedAuditValue(&unblocked)); err != nil {
+		return nil, err
+	}
+	return &unblocked, nil
+}
+
+// ReleaseUsedService marks the sim as released from the service, e.g. after the account of the service was deleted.
+// The sim can be used for the service again after the cooldown of the service use policy.
+// Releasing a sim that is already released does nothing.
+//
+// Returns the released used record.
+// Possibly errors: repository.ErrNotFound if the sim has no used record for the service.
+func (us *UsedService) ReleaseUsedService(ctx context.Context, simId, serviceId int) (*core.Used, error) {
+	us.mu.Lock()
+	defer us.mu.Unlock()
+
+	used, err := us.usedRecord(ctx, simId, serviceId)
+	if err != nil {
+		return nil, err
+	}
+	if used.IsReleased() {
+		return used, nil
+	}
+
+	released := *used
+	released.Release(time.Now().Unix())
+	err = us.repository.InTx(ctx, func(ctx context.Context) error {
+		if err := us.repository.UsedRepository.Update(ctx, &released); err != nil {
+			return err
+		}
+		return audit(ctx, us.repository, "ReleaseUsedService", core.AuditEntityUsed, used.Id(), core.UsedAuditValue(used), core.UsedAuditValue(&released))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &released, nil
+}
+
+// usedRecord returns the latest used record of the sim for the service, repository.ErrNotFound if there is none.
+// A sim that was released and used again has several records for the service.
+func (us *UsedService) usedRecord(ctx context.Context, simId, serviceId int) (*core.Used, error) {
+	list, err := us.repository.UsedRepository.BySimID(ctx, simId)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *core.Used
+	for _, u := range list {
+		if u.ServiceID() == serviceId && (latest == nil || u.Id() > latest.Id()) {
+			latest = u
+		}
+	}
+	if latest == nil {
+		return nil, repoerrors.ErrNotFound
+	}
+	return latest, nil
+}
+
+// CheckEligibility checks whether the sim can be used for the service now, nothing is written.
+// The sim must exist, be activated and not blocked, the service must exist
+// and its use policy must allow the use, see core.UsePolicy.
+// A sim leased for the service or another service of its group is not eligible until the lease ends.
+//
+// Returns the eligibility with the reasons the sim is not eligible for, the error is only set if a repository fails.
+func (us *UsedService) CheckEligibility(ctx context.Context, simId, serviceId int) (core.Eligibility, error) {
+	us.mu.Lock()
+	defer us.mu.Unlock()
+
+	return us.eligibility(ctx, simId, serviceId, time.Now().Unix(), "")
+}
+
+// eligibility checks the sim for the service, a lease of the sim for the service or its group other than the lease
+// with the id confirming makes the sim not eligible.
+func (us *UsedService) eligibility(ctx context.Context, simId, serviceId int, now int64, confirming string) (core.Eligibility, error) {
+	var eligibility core.Eligibility
+
+	sim, err := us.repository.SimRepository.ByID(ctx, simId)
+	if err != nil {
+		if !errors.Is(err, repoerrors.ErrNotFound) {
+			return eligibility, err
+		}
+		sim = nil
+	}
+	eligibility.Reasons = append(eligibility.Reasons, core.SimEligibility(sim, now)...)
+
+	service, err := us.repository.ServiceRepository.ByID(ctx, serviceId)
+	if err != nil {
+		if !errors.Is(err, repoerrors.ErrNotFound) {
+			return eligibility, err
+		}
+		service = nil
+		eligibility.Reasons = append(eligibility.Reasons, core.ReasonServiceNotFound)
+	}
+
+	if sim == nil || service == nil {
+		return eligibility, nil
+	}
+	eligibility.Reasons = append(eligibility.Reasons, core.ServiceEligibility(service, sim)...)
+
+	group, err := us.serviceGroup(ctx, service)
+	if err != nil {
+		return eligibility, err
+	}
+	records, err := us.repository.UsedRepository.BySimID(ctx, simId)
+	if err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+		return eligibility, err
+	}
+	if err := us.checkPolicy(records, service, group, now); !eligibility.AddPolicyError(err) {
+		return eligibility, err
+	}
+
+	// a sim leased for a service of the group is leased for the whole group
+	if us.leases.isLeased(simId, group.ServiceIDs, now, confirming) {
+		eligibility.Reasons = append(eligibility.Reasons, core.ReasonLeased)
+	}
+	return eligibility, nil
+}
+
+// checkPolicy checks the use policy of the service against the used records of the sim,
+// the uses of the other services of the group are uses of the service.
+func (us *UsedService) checkPolicy(records core.List[*core.Used], service *core.Service, group core.ServiceGroup, now int64) error {
+	return us.policies.For(service.Name()).CheckGroup(service.Id(), group.Linked(service.Id()), records, now)
+}
+
+// serviceGroup returns the group of the service, see core.ServiceGroup.
+func (us *UsedService) serviceGroup(ctx context.Context, service *core.Service) (core.ServiceGroup, error) {
+	services, err := us.repository.ServiceRepository.ByGroup(ctx, service.GroupID())
+	if err != nil {
+		return core.ServiceGroup{}, err
+	}
+	return core.ServiceGroupOf(*services, service), nil
+}
+
+// propagatedServices returns the services a block or an unblock of the sim on the service also applies to,
+// the other services of the group if the group propagates blocks.
+func (us *UsedService) propagatedServices(ctx context.Context, service *core.Service) ([]int, error) {
+	group, err := us.serviceGroup(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	if !us.propagation.For(group.Name) {
+		return nil, nil
+	}
+	return group.Linked(service.Id()), nil
+}
 
-	// Return any error that occurred during the operation.
-	return err
+// isPolicyError reports whether the error is a rejection of core.UsePolicy.Check.
+func isPolicyError(err error) bool {
+	return errors.Is(err, core.ErrAlreadyUsed) || errors.Is(err, core.ErrBlockedOnUse) || errors.Is(err, core.ErrUseNotAllowed)
 }
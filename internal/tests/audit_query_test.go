@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"encoding/json"
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQueryAuditLog_HappyPath adds, blocks and deletes a sim as an actor of the admin key,
+// the audit log of the sim has the three mutations of the actor, newest first.
+func TestQueryAuditLog_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	operator := suite.GenerateFakeString(20)
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-actor", operator)
+	actor := "local-admin/" + operator
+
+	simID := addActiveSim(ctx, t, s, suite.GenerateFakePhoneNumber())
+	_, err := s.SimClient.SetSimBlocked(ctx, &pb.SSBRequest{Id: simID, Reason: "spam"})
+	require.NoError(t, err)
+	_, err = s.SimClient.DeleteSim(ctx, &pb.DeleteSimRequest{Id: simID})
+	require.NoError(t, err)
+
+	log, err := s.AuditClient.QueryAuditLog(ctx, &pb.QueryAuditLogRequest{
+		EntityType: pb.AuditEntity_AUDIT_ENTITY_SIM,
+		EntityId:   simID,
+	})
+	require.NoError(t, err)
+	require.Len(t, log.GetEntries(), 3)
+
+	actions := make([]string, 0, len(log.GetEntries()))
+	for _, e := range log.GetEntries() {
+		assert.Equal(t, actor, e.GetActor())
+		assert.Equal(t, pb.AuditEntity_AUDIT_ENTITY_SIM, e.GetEntityType())
+		assert.Equal(t, simID, e.GetEntityId())
+		actions = append(actions, e.GetAction())
+	}
+	assert.Equal(t, []string{"DeleteSim", "BlockSim", "AddSim"}, actions)
+
+	deleted, blocked, added := log.GetEntries()[0], log.GetEntries()[1], log.GetEntries()[2]
+	assert.Empty(t, added.GetBefore())
+	assert.Empty(t, deleted.GetAfter())
+	assert.Equal(t, "/Sim/SetSimBlocked", blocked.GetRpc())
+
+	var before, after map[string]any
+	require.NoError(t, json.Unmarshal([]byte(blocked.GetBefore()), &before))
+	require.NoError(t, json.Unmarshal([]byte(blocked.GetAfter()), &after))
+	assert.Equal(t, "active", before["state"])
+	assert.Equal(t, "blocked", after["state"])
+	assert.Equal(t, "spam", after["state_reason"])
+
+	byActor, err := s.AuditClient.QueryAuditLog(ctx, &pb.QueryAuditLogRequest{Actor: actor, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, byActor.GetEntries(), 1)
+	assert.Equal(t, deleted.GetId(), byActor.GetEntries()[0].GetId())
+}
+
+// TestQueryAuditLog_KeyActor adds a service without the x-actor metadata, the actor is the name of the API key.
+// Then it uses a sim for the service with the operator key.
+func TestQueryAuditLog_KeyActor(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	simID := addActiveSim(ctx, t, s, suite.GenerateFakePhoneNumber())
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	log, err := s.AuditClient.QueryAuditLog(ctx, &pb.QueryAuditLogRequest{
+		EntityType: pb.AuditEntity_AUDIT_ENTITY_SERVICE,
+		EntityId:   serviceResp.GetId(),
+	})
+	require.NoError(t, err)
+	require.Len(t, log.GetEntries(), 1)
+	assert.Equal(t, "AddService", log.GetEntries()[0].GetAction())
+	assert.Equal(t, "local-admin", log.GetEntries()[0].GetActor())
+
+	operator := suite.GenerateFakeString(20)
+	operatorCtx := metadata.AppendToOutgoingContext(ctx, "x-actor", operator)
+	usedClient := pb.NewUsedClient(suite.Dial(t, s.Cfg, suite.OperatorAPIKey))
+	_, err = usedClient.UseSimForService(operatorCtx, &pb.USFSRequest{SimID: simID, ServiceID: serviceResp.GetId()})
+	require.NoError(t, err)
+
+	used, err := s.AuditClient.QueryAuditLog(ctx, &pb.QueryAuditLogRequest{Actor: "local-operator/" + operator})
+	require.NoError(t, err)
+	require.Len(t, used.GetEntries(), 1)
+	assert.Equal(t, "UseSimForService", used.GetEntries()[0].GetAction())
+	assert.Equal(t, pb.AuditEntity_AUDIT_ENTITY_USED_SERVICE, used.GetEntries()[0].GetEntityType())
+
+	var after map[string]any
+	require.NoError(t, json.Unmarshal([]byte(used.GetEntries()[0].GetAfter()), &after))
+	assert.Equal(t, float64(simID), after["sim_id"])
+	assert.Equal(t, float64(serviceResp.GetId()), after["service_id"])
+}
+
+func TestQueryAuditLog_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	tests := []struct {
+		name               string
+		req                *pb.QueryAuditLogRequest
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Invalid entity type",
+			req:                &pb.QueryAuditLogRequest{EntityType: pb.AuditEntity(42)},
+			expectedErr:        "Invalid entity type",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Entity id without entity type",
+			req:                &pb.QueryAuditLogRequest{EntityId: 1},
+			expectedErr:        "Entity type is required to filter by entity id",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "From after to",
+			req:                &pb.QueryAuditLogRequest{From: 200, To: 100},
+			expectedErr:        "from must not be after to",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Too big limit",
+			req:                &pb.QueryAuditLogRequest{Limit: 1001},
+			expectedErr:        "Invalid limit",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.AuditClient.QueryAuditLog(ctx, tt.req)
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
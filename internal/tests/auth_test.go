@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"context"
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuth_HappyPath calls the server with the keys of every role and with a bearer token.
+func TestAuth_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	viewer := pb.NewSimClient(suite.Dial(t, s.Cfg, suite.ViewerAPIKey))
+	_, err := viewer.GetSimList(ctx, &pb.Empty{})
+	require.NoError(t, err)
+
+	operator := pb.NewSimClient(suite.Dial(t, s.Cfg, suite.OperatorAPIKey))
+	added, err := operator.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       suite.GenerateFakePhoneNumber(),
+			ProviderName: suite.GenerateFakeString(16),
+		},
+	})
+	require.NoError(t, err)
+
+	got, err := viewer.GetSim(ctx, &pb.GetSimRequest{Id: added.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, added.GetId(), got.GetSim().GetID())
+
+	bearer := pb.NewSimClient(suite.Dial(t, s.Cfg, ""))
+	bearerCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+suite.AdminAPIKey)
+	_, err = bearer.DeleteSim(bearerCtx, &pb.DeleteSimRequest{Id: added.GetId()})
+	require.NoError(t, err)
+}
+
+func TestAuth_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	noKey := pb.NewSimClient(suite.Dial(t, s.Cfg, ""))
+	invalidKey := pb.NewSimClient(suite.Dial(t, s.Cfg, "not-a-key"))
+	viewer := pb.NewSimClient(suite.Dial(t, s.Cfg, suite.ViewerAPIKey))
+	operator := pb.NewSimClient(suite.Dial(t, s.Cfg, suite.OperatorAPIKey))
+	operatorAudit := pb.NewAuditClient(suite.Dial(t, s.Cfg, suite.OperatorAPIKey))
+
+	tests := []struct {
+		name               string
+		call               func(ctx context.Context) error
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "Without key",
+			call: func(ctx context.Context) error {
+				_, err := noKey.GetSimList(ctx, &pb.Empty{})
+				return err
+			},
+			expectedErr:        "API key is required",
+			expectedStatusCode: codes.Unauthenticated,
+		},
+		{
+			name: "Invalid key",
+			call: func(ctx context.Context) error {
+				_, err := invalidKey.GetSimList(ctx, &pb.Empty{})
+				return err
+			},
+			expectedErr:        "Invalid API key",
+			expectedStatusCode: codes.Unauthenticated,
+		},
+		{
+			name: "Invalid bearer token",
+			call: func(ctx context.Context) error {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer not-a-key")
+				_, err := noKey.GetSimList(ctx, &pb.Empty{})
+				return err
+			},
+			expectedErr:        "Invalid API key",
+			expectedStatusCode: codes.Unauthenticated,
+		},
+		{
+			name: "Viewer adds sim",
+			call: func(ctx context.Context) error {
+				_, err := viewer.AddSim(ctx, &pb.AddSimRequest{
+					SimData: &pb.AddSimData{Number: suite.GenerateFakePhoneNumber(), ProviderName: suite.GenerateFakeString(16)},
+				})
+				return err
+			},
+			expectedErr:        "Role viewer can not call /Sim/AddSim, role operator is required",
+			expectedStatusCode: codes.PermissionDenied,
+		},
+		{
+			name: "Operator deletes sim",
+			call: func(ctx context.Context) error {
+				_, err := operator.DeleteSim(ctx, &pb.DeleteSimRequest{Id: 1})
+				return err
+			},
+			expectedErr:        "role admin is required",
+			expectedStatusCode: codes.PermissionDenied,
+		},
+		{
+			name: "Operator queries audit log",
+			call: func(ctx context.Context) error {
+				_, err := operatorAudit.QueryAuditLog(ctx, &pb.QueryAuditLogRequest{})
+				return err
+			},
+			expectedErr:        "role admin is required",
+			expectedStatusCode: codes.PermissionDenied,
+		},
+		{
+			name: "Viewer imports sims",
+			call: func(ctx context.Context) error {
+				stream, err := viewer.ImportSims(ctx)
+				if err != nil {
+					return err
+				}
+				_, err = stream.CloseAndRecv()
+				return err
+			},
+			expectedErr:        "role operator is required",
+			expectedStatusCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call(ctx)
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
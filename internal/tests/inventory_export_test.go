@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"simactive/internal/tests/suite"
+	"strconv"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExportInventory_HappyPath exports the sims of a new provider as csv and json lines.
+func TestExportInventory_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	providerName := suite.GenerateFakeString(16)
+	ids := make([]int32, 0, 3)
+	for range 3 {
+		resp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+			SimData: &pb.AddSimData{
+				Number:       suite.GenerateFakePhoneNumber(),
+				ProviderName: providerName,
+			},
+		})
+		require.NoError(t, err)
+		ids = append(ids, resp.GetId())
+	}
+
+	filter := &pb.SimFilter{ProviderName: providerName}
+
+	data := exportInventory(ctx, t, s, &pb.ExportInventoryRequest{
+		Entity:    pb.InventoryEntity_INVENTORY_ENTITY_SIMS,
+		Format:    pb.ExportFormat_EXPORT_FORMAT_CSV,
+		SimFilter: filter,
+	})
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, len(ids)+1)
+	assert.Equal(t, []string{
+		"id", "number", "country", "provider_id", "provider_name",
+		"state", "state_reason", "is_activated", "activate_until", "is_blocked",
+	}, records[0])
+	for i, id := range ids {
+		assert.Equal(t, strconv.Itoa(int(id)), records[i+1][0])
+		assert.Equal(t, providerName, records[i+1][4])
+	}
+
+	data = exportInventory(ctx, t, s, &pb.ExportInventoryRequest{
+		Entity:    pb.InventoryEntity_INVENTORY_ENTITY_SIMS,
+		Format:    pb.ExportFormat_EXPORT_FORMAT_JSONL,
+		SimFilter: filter,
+	})
+	var lines []map[string]any
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.Len(t, lines, len(ids))
+	for i, id := range ids {
+		assert.Equal(t, float64(id), lines[i]["id"])
+		assert.Equal(t, "RU", lines[i]["country"])
+	}
+}
+
+func TestExportInventory_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	tests := []struct {
+		name               string
+		req                *pb.ExportInventoryRequest
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Export without entity",
+			req:                &pb.ExportInventoryRequest{},
+			expectedErr:        "Invalid entity",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "Export with negative service id",
+			req: &pb.ExportInventoryRequest{
+				Entity:    pb.InventoryEntity_INVENTORY_ENTITY_USAGE,
+				ServiceId: -1,
+			},
+			expectedErr:        "Invalid service id",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "Export with invalid sim filter",
+			req: &pb.ExportInventoryRequest{
+				Entity:    pb.InventoryEntity_INVENTORY_ENTITY_SIMS,
+				SimFilter: &pb.SimFilter{NumberPrefix: "+7x"},
+			},
+			expectedErr:        "Invalid number prefix",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := s.InventoryClient.ExportInventory(ctx, tt.req)
+			require.NoError(t, err)
+
+			_, err = stream.Recv()
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+// exportInventory joins the chunks of an export stream.
+func exportInventory(ctx context.Context, t *testing.T, s *suite.Suite, req *pb.ExportInventoryRequest) []byte {
+	t.Helper()
+
+	stream, err := s.InventoryClient.ExportInventory(ctx, req)
+	require.NoError(t, err)
+
+	var data bytes.Buffer
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return data.Bytes()
+		}
+		require.NoError(t, err)
+		data.Write(chunk.GetData())
+	}
+}
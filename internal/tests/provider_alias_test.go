@@ -0,0 +1,170 @@
+package tests
+
+import (
+	"context"
+	"simactive/internal/tests/suite"
+	"strings"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProviderAlias_HappyPath adds sims with a differently written provider name and with an alias,
+// both get the canonical provider.
+func TestProviderAlias_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	name := strings.ToLower(suite.GenerateFakeString(8))
+	provider, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{Name: name})
+	require.NoError(t, err)
+	providerID := provider.GetProvider().GetId()
+
+	assert.Equal(t, providerID, addSimProviderID(ctx, t, s, strings.ToUpper(name[:4])+"-"+name[4:]))
+
+	alias := suite.GenerateFakeString(12)
+	aliases, err := s.ProviderClient.AddProviderAlias(ctx, &pb.ProviderAliasRequest{ProviderID: providerID, Alias: alias})
+	require.NoError(t, err)
+	assert.Equal(t, []string{alias}, aliases.GetAliases())
+
+	assert.Equal(t, providerID, addSimProviderID(ctx, t, s, strings.ToLower(alias)))
+
+	aliases, err = s.ProviderClient.RemoveProviderAlias(ctx, &pb.ProviderAliasRequest{ProviderID: providerID, Alias: alias})
+	require.NoError(t, err)
+	assert.Empty(t, aliases.GetAliases())
+}
+
+// TestMergeProviders moves the sim of a duplicate provider onto the canonical one,
+// the name of the duplicate resolves to the canonical provider afterwards.
+func TestMergeProviders(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	target, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{Name: suite.GenerateFakeString(16)})
+	require.NoError(t, err)
+
+	duplicateName := suite.GenerateFakeString(16)
+	duplicateID := addSimProviderID(ctx, t, s, duplicateName)
+
+	merged, err := s.ProviderClient.MergeProviders(ctx, &pb.MergeProvidersRequest{
+		TargetID:  target.GetProvider().GetId(),
+		SourceIDs: []int32{duplicateID},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), merged.GetMovedSims())
+	assert.Contains(t, merged.GetAliases(), duplicateName)
+
+	list, err := s.ProviderClient.GetProviderList(ctx, &pb.Empty{})
+	require.NoError(t, err)
+	for _, p := range list.GetProviders() {
+		assert.NotEqual(t, duplicateID, p.GetId())
+	}
+
+	assert.Equal(t, target.GetProvider().GetId(), addSimProviderID(ctx, t, s, duplicateName))
+}
+
+func TestProviderAlias_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	existing, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{Name: suite.GenerateFakeString(16)})
+	require.NoError(t, err)
+	existingID := existing.GetProvider().GetId()
+
+	tests := []struct {
+		name               string
+		call               func() error
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "Add provider with a differently written existing name",
+			call: func() error {
+				_, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{Name: strings.ToUpper(existing.GetProvider().GetName())})
+				return err
+			},
+			expectedErr:        "already exists",
+			expectedStatusCode: codes.AlreadyExists,
+		},
+		{
+			name: "Add alias that is the name of a provider",
+			call: func() error {
+				_, err := s.ProviderClient.AddProviderAlias(ctx, &pb.ProviderAliasRequest{
+					ProviderID: existingID,
+					Alias:      existing.GetProvider().GetName(),
+				})
+				return err
+			},
+			expectedErr:        "is already a name or an alias",
+			expectedStatusCode: codes.AlreadyExists,
+		},
+		{
+			name: "Add alias without letters",
+			call: func() error {
+				_, err := s.ProviderClient.AddProviderAlias(ctx, &pb.ProviderAliasRequest{ProviderID: existingID, Alias: "--"})
+				return err
+			},
+			expectedErr:        "provider alias must have letters or digits",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "Remove alias the provider does not have",
+			call: func() error {
+				_, err := s.ProviderClient.RemoveProviderAlias(ctx, &pb.ProviderAliasRequest{
+					ProviderID: existingID,
+					Alias:      suite.GenerateFakeString(16),
+				})
+				return err
+			},
+			expectedErr:        "not found",
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "Merge provider into itself",
+			call: func() error {
+				_, err := s.ProviderClient.MergeProviders(ctx, &pb.MergeProvidersRequest{TargetID: existingID, SourceIDs: []int32{existingID}})
+				return err
+			},
+			expectedErr:        "The target provider can not be a source",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "Merge not existing provider",
+			call: func() error {
+				_, err := s.ProviderClient.MergeProviders(ctx, &pb.MergeProvidersRequest{TargetID: existingID, SourceIDs: []int32{999999999}})
+				return err
+			},
+			expectedErr:        "provider with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+// addSimProviderID adds a sim with the provider name and returns the id of the provider the sim got.
+func addSimProviderID(ctx context.Context, t *testing.T, s *suite.Suite, providerName string) int32 {
+	t.Helper()
+
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       suite.GenerateFakePhoneNumber(),
+			ProviderName: providerName,
+		},
+	})
+	require.NoError(t, err)
+
+	sim, err := s.SimClient.GetSim(ctx, &pb.GetSimRequest{Id: simResp.GetId()})
+	require.NoError(t, err)
+	return sim.GetSim().GetProvider().GetId()
+}
@@ -0,0 +1,183 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProviderManage_HappyPath adds a provider, renames it and deletes it.
+func TestProviderManage_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	added, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{Name: suite.GenerateFakeString(16)})
+	require.NoError(t, err)
+	require.Greater(t, added.GetProvider().GetId(), int32(0))
+
+	name := suite.GenerateFakeString(16)
+	renamed, err := s.ProviderClient.RenameProvider(ctx, &pb.RenameProviderRequest{ID: added.GetProvider().GetId(), Name: name})
+	require.NoError(t, err)
+	assert.Equal(t, added.GetProvider().GetId(), renamed.GetProvider().GetId())
+	assert.Equal(t, name, renamed.GetProvider().GetName())
+
+	deleted, err := s.ProviderClient.DeleteProvider(ctx, &pb.DeleteProviderRequest{ID: added.GetProvider().GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, added.GetProvider().GetId(), deleted.GetId())
+	assert.Zero(t, deleted.GetReassignedSims())
+
+	list, err := s.ProviderClient.GetProviderList(ctx, &pb.Empty{})
+	require.NoError(t, err)
+	for _, p := range list.GetProviders() {
+		assert.NotEqual(t, added.GetProvider().GetId(), p.GetId())
+	}
+}
+
+// TestDeleteProvider_Reassign deletes a provider with a sim, the sim is moved to another provider.
+func TestDeleteProvider_Reassign(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	providerName := suite.GenerateFakeString(16)
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       suite.GenerateFakePhoneNumber(),
+			ProviderName: providerName,
+		},
+	})
+	require.NoError(t, err)
+
+	sim, err := s.SimClient.GetSim(ctx, &pb.GetSimRequest{Id: simResp.GetId()})
+	require.NoError(t, err)
+	providerID := sim.GetSim().GetProvider().GetId()
+
+	// a provider with sims is not deleted without a reassign target
+	_, err = s.ProviderClient.DeleteProvider(ctx, &pb.DeleteProviderRequest{ID: providerID})
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	target, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{Name: suite.GenerateFakeString(16)})
+	require.NoError(t, err)
+
+	deleted, err := s.ProviderClient.DeleteProvider(ctx, &pb.DeleteProviderRequest{
+		ID:           providerID,
+		ReassignToID: target.GetProvider().GetId(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), deleted.GetReassignedSims())
+
+	sim, err = s.SimClient.GetSim(ctx, &pb.GetSimRequest{Id: simResp.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, target.GetProvider().GetId(), sim.GetSim().GetProvider().GetId())
+	assert.Equal(t, target.GetProvider().GetName(), sim.GetSim().GetProvider().GetName())
+}
+
+func TestProviderManage_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	existing, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{Name: suite.GenerateFakeString(16)})
+	require.NoError(t, err)
+
+	other, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{Name: suite.GenerateFakeString(16)})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		call               func() error
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "Add provider with empty name",
+			call: func() error {
+				_, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{Name: ""})
+				return err
+			},
+			expectedErr:        "provider name cannot be empty",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "Add provider with too long name",
+			call: func() error {
+				_, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{Name: suite.GenerateFakeString(17)})
+				return err
+			},
+			expectedErr:        "provider name cannot be longer than 16 characters",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "Add existing provider",
+			call: func() error {
+				_, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{Name: existing.GetProvider().GetName()})
+				return err
+			},
+			expectedErr:        "already exists",
+			expectedStatusCode: codes.AlreadyExists,
+		},
+		{
+			name: "Rename provider to the name of another provider",
+			call: func() error {
+				_, err := s.ProviderClient.RenameProvider(ctx, &pb.RenameProviderRequest{
+					ID:   other.GetProvider().GetId(),
+					Name: existing.GetProvider().GetName(),
+				})
+				return err
+			},
+			expectedErr:        "already exists",
+			expectedStatusCode: codes.AlreadyExists,
+		},
+		{
+			name: "Rename not existing provider",
+			call: func() error {
+				_, err := s.ProviderClient.RenameProvider(ctx, &pb.RenameProviderRequest{ID: 999999999, Name: "name"})
+				return err
+			},
+			expectedErr:        "provider with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "Delete provider with invalid id",
+			call: func() error {
+				_, err := s.ProviderClient.DeleteProvider(ctx, &pb.DeleteProviderRequest{ID: 0})
+				return err
+			},
+			expectedErr:        "Invalid id, id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "Delete not existing provider",
+			call: func() error {
+				_, err := s.ProviderClient.DeleteProvider(ctx, &pb.DeleteProviderRequest{ID: 999999999})
+				return err
+			},
+			expectedErr:        "provider with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "Delete provider with not existing reassign target",
+			call: func() error {
+				_, err := s.ProviderClient.DeleteProvider(ctx, &pb.DeleteProviderRequest{
+					ID:           other.GetProvider().GetId(),
+					ReassignToID: 999999999,
+				})
+				return err
+			},
+			expectedErr:        "to reassign sims to not found",
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"testing"
+	"time"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProviderMetadata_HappyPath adds a provider with metadata, changes its default activation period
+// and adds an activated sim of the provider without an activation end.
+func TestProviderMetadata_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	name := suite.GenerateFakeString(16)
+	added, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{
+		Name: name,
+		Metadata: &pb.ProviderMetadata{
+			Mcc:         "250",
+			Mnc:         "01",
+			MonthlyCost: 30000,
+			Currency:    "rub",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "RU", added.GetProvider().GetCountry())
+	assert.Equal(t, "250", added.GetProvider().GetMcc())
+	assert.Equal(t, "01", added.GetProvider().GetMnc())
+	assert.Equal(t, int64(30000), added.GetProvider().GetMonthlyCost())
+	assert.Equal(t, "RUB", added.GetProvider().GetCurrency())
+
+	period := int64((30 * 24 * time.Hour).Seconds())
+	updated, err := s.ProviderClient.UpdateProvider(ctx, &pb.UpdateProviderRequest{
+		Id:         added.GetProvider().GetId(),
+		Metadata:   &pb.ProviderMetadata{DefaultActivationPeriod: period},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"default_activation_period"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, period, updated.GetProvider().GetDefaultActivationPeriod())
+	// fields out of the mask are kept
+	assert.Equal(t, "250", updated.GetProvider().GetMcc())
+
+	// the stored currency is used for the monthly cost
+	updated, err = s.ProviderClient.UpdateProvider(ctx, &pb.UpdateProviderRequest{
+		Id:         added.GetProvider().GetId(),
+		Metadata:   &pb.ProviderMetadata{MonthlyCost: 35000},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"monthly_cost"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(35000), updated.GetProvider().GetMonthlyCost())
+	assert.Equal(t, "RUB", updated.GetProvider().GetCurrency())
+
+	before := time.Now().Unix()
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       suite.GenerateFakePhoneNumber(),
+			ProviderName: name,
+			IsActivated:  true,
+		},
+	})
+	require.NoError(t, err)
+
+	sim, err := s.SimClient.GetSim(ctx, &pb.GetSimRequest{Id: simResp.GetId()})
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, sim.GetSim().GetActivateUntil(), before+period)
+	assert.LessOrEqual(t, sim.GetSim().GetActivateUntil(), time.Now().Unix()+period)
+}
+
+// TestAddSim_ProviderNotInferred adds a sim without a provider name in a country with two providers.
+func TestAddSim_ProviderNotInferred(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	for range 2 {
+		_, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{
+			Name:     suite.GenerateFakeString(16),
+			Metadata: &pb.ProviderMetadata{Mcc: "250"},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{Number: suite.GenerateFakePhoneNumber()},
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), "The provider can not be inferred from the number, RU must have exactly one provider")
+}
+
+func TestProviderMetadata_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	tests := []struct {
+		name               string
+		metadata           *pb.ProviderMetadata
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Unknown mcc",
+			metadata:           &pb.ProviderMetadata{Mcc: "999"},
+			expectedErr:        "unknown mobile country code",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Mcc of another country",
+			metadata:           &pb.ProviderMetadata{Country: "DE", Mcc: "250"},
+			expectedErr:        "Mcc 250 belongs to RU, not to DE",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Invalid mnc",
+			metadata:           &pb.ProviderMetadata{Mcc: "250", Mnc: "1"},
+			expectedErr:        "mobile network code must have 2 or 3 digits",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Invalid country",
+			metadata:           &pb.ProviderMetadata{Country: "RUS"},
+			expectedErr:        "country must be an ISO 3166-1 alpha-2 code",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Negative default activation period",
+			metadata:           &pb.ProviderMetadata{DefaultActivationPeriod: -1},
+			expectedErr:        "period must not be negative",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Monthly cost without currency",
+			metadata:           &pb.ProviderMetadata{MonthlyCost: 100},
+			expectedErr:        "Currency is required with monthly cost",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{
+				Name:     suite.GenerateFakeString(16),
+				Metadata: tt.metadata,
+			})
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func TestUpdateProvider_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	added, err := s.ProviderClient.AddProvider(ctx, &pb.AddProviderRequest{
+		Name:     suite.GenerateFakeString(16),
+		Metadata: &pb.ProviderMetadata{Country: "DE"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		metadata           *pb.ProviderMetadata
+		paths              []string
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Mcc of another country than the stored one",
+			metadata:           &pb.ProviderMetadata{Mcc: "250"},
+			paths:              []string{"mcc"},
+			expectedErr:        "Mcc 250 belongs to RU, not to DE",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Monthly cost without stored currency",
+			metadata:           &pb.ProviderMetadata{MonthlyCost: 100},
+			paths:              []string{"monthly_cost"},
+			expectedErr:        "Currency is required with monthly cost",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.ProviderClient.UpdateProvider(ctx, &pb.UpdateProviderRequest{
+				Id:         added.GetProvider().GetId(),
+				Metadata:   tt.metadata,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: tt.paths},
+			})
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package tests
+
+import (
+	"context"
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestServiceGroup_HappyPath uses a sim for the top level service of a group,
+// the child service is not free for the sim anymore.
+func TestServiceGroup_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	parentID, childID := addServiceGroup(ctx, t, s)
+
+	number := suite.GenerateFakePhoneNumber()
+	simID := addActiveSim(ctx, t, s, number)
+
+	free, err := s.SimClient.GetFreeServices(ctx, &pb.GetFreeServRequest{Number: number})
+	require.NoError(t, err)
+	assert.Contains(t, free.GetFreeServiceIds(), parentID)
+	assert.Contains(t, free.GetFreeServiceIds(), childID)
+
+	_, err = s.UsedClient.UseSimForService(ctx, &pb.USFSRequest{SimID: simID, ServiceID: parentID})
+	require.NoError(t, err)
+
+	free, err = s.SimClient.GetFreeServices(ctx, &pb.GetFreeServRequest{Number: number})
+	require.NoError(t, err)
+	assert.NotContains(t, free.GetFreeServiceIds(), childID)
+
+	eligibility, err := s.UsedClient.CheckEligibility(ctx, &pb.EligibilityRequest{SimID: simID, ServiceID: childID})
+	require.NoError(t, err)
+	assert.Equal(t, []pb.EligibilityReason{pb.EligibilityReason_ELIGIBILITY_REASON_ALREADY_USED}, eligibility.GetReasons())
+}
+
+// TestServiceGroup_BlockPropagation blocks a sim on a child service, the sim is blocked on the whole group.
+func TestServiceGroup_BlockPropagation(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	parentID, childID := addServiceGroup(ctx, t, s)
+	simID := addActiveSim(ctx, t, s, suite.GenerateFakePhoneNumber())
+
+	_, err := s.UsedClient.BlockUsedService(ctx, &pb.BlockUsedServiceRequest{SimID: simID, ServiceID: childID, Reason: "banned"})
+	require.NoError(t, err)
+
+	used, err := s.SimClient.GetUsedServices(ctx, &pb.GetUsedServRequest{SimId: simID})
+	require.NoError(t, err)
+	blocked := make(map[int32]bool)
+	for _, u := range used.GetUsedServices() {
+		blocked[u.GetServiceId()] = u.GetIsBlocked()
+	}
+	assert.True(t, blocked[parentID])
+	assert.True(t, blocked[childID])
+
+	eligibility, err := s.UsedClient.CheckEligibility(ctx, &pb.EligibilityRequest{SimID: simID, ServiceID: parentID})
+	require.NoError(t, err)
+	assert.Equal(t, []pb.EligibilityReason{pb.EligibilityReason_ELIGIBILITY_REASON_BLOCKED_ON_SERVICE}, eligibility.GetReasons())
+
+	_, err = s.UsedClient.UnblockUsedService(ctx, &pb.UnblockUsedServiceRequest{SimID: simID, ServiceID: childID})
+	require.NoError(t, err)
+
+	eligibility, err = s.UsedClient.CheckEligibility(ctx, &pb.EligibilityRequest{SimID: simID, ServiceID: parentID})
+	require.NoError(t, err)
+	assert.NotContains(t, eligibility.GetReasons(), pb.EligibilityReason_ELIGIBILITY_REASON_BLOCKED_ON_SERVICE)
+}
+
+func TestServiceGroup_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	parentID, childID := addServiceGroup(ctx, t, s)
+
+	tests := []struct {
+		name               string
+		call               func() error
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "Add service with not existing parent",
+			call: func() error {
+				_, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{
+					Name:     suite.GenerateFakeString(30),
+					Metadata: &pb.ServiceMetadata{ParentId: 999999999},
+				})
+				return err
+			},
+			expectedErr:        "parent service with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "Add service with a child service as parent",
+			call: func() error {
+				_, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{
+					Name:     suite.GenerateFakeString(30),
+					Metadata: &pb.ServiceMetadata{ParentId: childID},
+				})
+				return err
+			},
+			expectedErr:        "service groups have one level",
+			expectedStatusCode: codes.FailedPrecondition,
+		},
+		{
+			name: "Make a service with children a child",
+			call: func() error {
+				other, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+				require.NoError(t, err)
+
+				_, err = s.ServiceClient.UpdateService(ctx, &pb.UpdateServiceRequest{
+					Id:         parentID,
+					Metadata:   &pb.ServiceMetadata{ParentId: other.GetId()},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"parent_id"}},
+				})
+				return err
+			},
+			expectedErr:        "service groups have one level",
+			expectedStatusCode: codes.FailedPrecondition,
+		},
+		{
+			name: "Make a service its own parent",
+			call: func() error {
+				_, err := s.ServiceClient.UpdateService(ctx, &pb.UpdateServiceRequest{
+					Id:         childID,
+					Metadata:   &pb.ServiceMetadata{ParentId: childID},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"parent_id"}},
+				})
+				return err
+			},
+			expectedErr:        "service groups have one level",
+			expectedStatusCode: codes.FailedPrecondition,
+		},
+		{
+			name: "Delete service with children",
+			call: func() error {
+				_, err := s.ServiceClient.DeleteService(ctx, &pb.DeleteServiceRequest{ID: parentID})
+				return err
+			},
+			expectedErr:        "has child services",
+			expectedStatusCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+// addServiceGroup adds a top level service with a child service and returns their ids.
+func addServiceGroup(ctx context.Context, t *testing.T, s *suite.Suite) (int32, int32) {
+	t.Helper()
+
+	parent, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	child, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{
+		Name:     suite.GenerateFakeString(30),
+		Metadata: &pb.ServiceMetadata{ParentId: parent.GetId()},
+	})
+	require.NoError(t, err)
+
+	return parent.GetId(), child.GetId()
+}
+
+// addActiveSim adds an activated sim with the number and returns its id.
+func addActiveSim(ctx context.Context, t *testing.T, s *suite.Suite, number string) int32 {
+	t.Helper()
+
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:        number,
+			ProviderName:  suite.GenerateFakeString(16),
+			IsActivated:   true,
+			ActivateUntil: suite.GenerateFakeDateUnix(),
+		},
+	})
+	require.NoError(t, err)
+	return simResp.GetId()
+}
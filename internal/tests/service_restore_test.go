@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"context"
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRestoreService_HappyPath deletes a service, it is not listed until it is restored.
+func TestRestoreService_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	name := suite.GenerateFakeString(30)
+	added, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: name})
+	require.NoError(t, err)
+
+	_, err = s.ServiceClient.DeleteService(ctx, &pb.DeleteServiceRequest{ID: added.GetId()})
+	require.NoError(t, err)
+	assert.NotContains(t, listedServiceIDs(ctx, t, s), added.GetId())
+
+	restored, err := s.ServiceClient.RestoreService(ctx, &pb.RestoreServiceRequest{Id: added.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, added.GetId(), restored.GetService().GetId())
+	assert.Equal(t, name, restored.GetService().GetName())
+	assert.Contains(t, listedServiceIDs(ctx, t, s), added.GetId())
+}
+
+// TestRestoreService_DeletedParent restores a child service of a deleted group as a top level service.
+func TestRestoreService_DeletedParent(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	parentID, childID := addServiceGroup(ctx, t, s)
+
+	_, err := s.ServiceClient.DeleteService(ctx, &pb.DeleteServiceRequest{ID: childID})
+	require.NoError(t, err)
+	_, err = s.ServiceClient.DeleteService(ctx, &pb.DeleteServiceRequest{ID: parentID})
+	require.NoError(t, err)
+
+	restored, err := s.ServiceClient.RestoreService(ctx, &pb.RestoreServiceRequest{Id: childID})
+	require.NoError(t, err)
+	assert.Zero(t, restored.GetService().GetParentId())
+}
+
+func TestRestoreService_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	name := suite.GenerateFakeString(30)
+	deleted, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: name})
+	require.NoError(t, err)
+	_, err = s.ServiceClient.DeleteService(ctx, &pb.DeleteServiceRequest{ID: deleted.GetId()})
+	require.NoError(t, err)
+	// the name of the deleted service is free
+	_, err = s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: name})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		req                *pb.RestoreServiceRequest
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Invalid id",
+			req:                &pb.RestoreServiceRequest{Id: 0},
+			expectedErr:        "Invalid id, id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Not existing service",
+			req:                &pb.RestoreServiceRequest{Id: 999999999},
+			expectedErr:        "deleted service with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name:               "Name taken by another service",
+			req:                &pb.RestoreServiceRequest{Id: deleted.GetId()},
+			expectedErr:        "is taken by another service",
+			expectedStatusCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.ServiceClient.RestoreService(ctx, tt.req)
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+// listedServiceIDs returns the ids of the listed services.
+func listedServiceIDs(ctx context.Context, t *testing.T, s *suite.Suite) []int32 {
+	t.Helper()
+
+	list, err := s.ServiceClient.GetServiceList(ctx, &pb.Empty{})
+	require.NoError(t, err)
+
+	ids := make([]int32, 0, len(list.GetServices()))
+	for _, service := range list.GetServices() {
+		ids = append(ids, service.GetId())
+	}
+	return ids
+}
@@ -0,0 +1,199 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUpdateService_HappyPath adds a service with metadata, renames it and disables it,
+// an active sim can not be used for the disabled service.
+func TestUpdateService_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	added, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{
+		Name: suite.GenerateFakeString(30),
+		Metadata: &pb.ServiceMetadata{
+			Url:              "https://example.com",
+			Category:         "Messenger",
+			VerificationType: pb.VerificationType_VERIFICATION_TYPE_BOTH,
+			Countries:        []string{"ru", "kz"},
+		},
+	})
+	require.NoError(t, err)
+
+	name := suite.GenerateFakeString(30)
+	updated, err := s.ServiceClient.UpdateService(ctx, &pb.UpdateServiceRequest{
+		Id:         added.GetId(),
+		Name:       name,
+		Metadata:   &pb.ServiceMetadata{},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "enabled"}},
+	})
+	require.NoError(t, err)
+
+	service := updated.GetService()
+	assert.Equal(t, added.GetId(), service.GetId())
+	assert.Equal(t, name, service.GetName())
+	assert.False(t, service.GetEnabled())
+	// fields out of the mask are kept
+	assert.Equal(t, "https://example.com", service.GetUrl())
+	assert.Equal(t, "messenger", service.GetCategory())
+	assert.Equal(t, pb.VerificationType_VERIFICATION_TYPE_BOTH, service.GetVerificationType())
+	assert.Equal(t, []string{"KZ", "RU"}, service.GetCountries())
+
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:        suite.GenerateFakePhoneNumber(),
+			ProviderName:  suite.GenerateFakeString(16),
+			IsActivated:   true,
+			ActivateUntil: suite.GenerateFakeDateUnix(),
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = s.UsedClient.UseSimForService(ctx, &pb.USFSRequest{SimID: simResp.GetId(), ServiceID: added.GetId()})
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Contains(t, err.Error(), "service_disabled")
+
+	_, err = s.UsedClient.AcquireSim(ctx, &pb.AcquireSimRequest{ServiceID: added.GetId()})
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// TestUpdateService_CountryNotSupported checks a sim of a country the service does not accept.
+func TestUpdateService_CountryNotSupported(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	added, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{
+		Name:     suite.GenerateFakeString(30),
+		Metadata: &pb.ServiceMetadata{Countries: []string{"DE"}},
+	})
+	require.NoError(t, err)
+
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:        suite.GenerateFakePhoneNumber(),
+			ProviderName:  suite.GenerateFakeString(16),
+			IsActivated:   true,
+			ActivateUntil: suite.GenerateFakeDateUnix(),
+		},
+	})
+	require.NoError(t, err)
+
+	eligibility, err := s.UsedClient.CheckEligibility(ctx, &pb.EligibilityRequest{SimID: simResp.GetId(), ServiceID: added.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, []pb.EligibilityReason{pb.EligibilityReason_ELIGIBILITY_REASON_COUNTRY_NOT_SUPPORTED}, eligibility.GetReasons())
+}
+
+func TestUpdateService_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	existing, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	existingName := suite.GenerateFakeString(30)
+	_, err = s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: existingName})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		req                *pb.UpdateServiceRequest
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Invalid id",
+			req:                &pb.UpdateServiceRequest{Id: 0, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"enabled"}}},
+			expectedErr:        "Invalid id, id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Without update mask",
+			req:                &pb.UpdateServiceRequest{Id: existing.GetId()},
+			expectedErr:        "Update mask is required",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Unknown update mask path",
+			req:                &pb.UpdateServiceRequest{Id: existing.GetId(), UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"id"}}},
+			expectedErr:        "Invalid update mask",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "Empty name",
+			req: &pb.UpdateServiceRequest{
+				Id:         existing.GetId(),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			},
+			expectedErr:        "service name cannot be empty",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "Name of another service",
+			req: &pb.UpdateServiceRequest{
+				Id:         existing.GetId(),
+				Name:       existingName,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			},
+			expectedErr:        "already exists",
+			expectedStatusCode: codes.AlreadyExists,
+		},
+		{
+			name: "Invalid url",
+			req: &pb.UpdateServiceRequest{
+				Id:         existing.GetId(),
+				Metadata:   &pb.ServiceMetadata{Url: "example.com"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"url"}},
+			},
+			expectedErr:        "url must be an absolute http or https url",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "Invalid country",
+			req: &pb.UpdateServiceRequest{
+				Id:         existing.GetId(),
+				Metadata:   &pb.ServiceMetadata{Countries: []string{"RUS"}},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"countries"}},
+			},
+			expectedErr:        "country must be an ISO 3166-1 alpha-2 code",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "Invalid verification type",
+			req: &pb.UpdateServiceRequest{
+				Id:         existing.GetId(),
+				Metadata:   &pb.ServiceMetadata{VerificationType: pb.VerificationType(42)},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"verification_type"}},
+			},
+			expectedErr:        "Invalid verification type",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "Not existing service",
+			req: &pb.UpdateServiceRequest{
+				Id:         999999999,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"enabled"}},
+			},
+			expectedErr:        "service with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.ServiceClient.UpdateService(ctx, tt.req)
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	"github.com/brianvoe/gofakeit"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -29,9 +28,8 @@ func TestActivateSim_HappyPath(t *testing.T) {
 		0,
 		suite.GenerateFakePhoneNumber(),
 		&fakeProvider,
-		false,
+		core.SimStateNew,
 		suite.GenerateFakeDateUnix(),
-		gofakeit.Bool(),
 	)
 
 	resp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"testing"
+	"time"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestActivationHistory_HappyPath activates a sim for a period, extends it
+// and checks that both records are in the activation history.
+func TestActivationHistory_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       suite.GenerateFakePhoneNumber(),
+			ProviderName: suite.GenerateFakeString(16),
+		},
+	})
+	require.NoError(t, err)
+
+	const day = int64((24 * time.Hour) / time.Second)
+
+	before := time.Now().Unix()
+	activated, err := s.SimClient.ActivateSim(ctx, &pb.ActivateSimRequest{
+		Id:     simResp.GetId(),
+		Period: &pb.ActivateSimRequest_Duration{Duration: day},
+	})
+	require.NoError(t, err)
+	assert.True(t, activated.GetIsActivated())
+	assert.GreaterOrEqual(t, activated.GetActivateUntil(), before+day)
+
+	// activation of an active sim extends it from its current end
+	extended, err := s.SimClient.ActivateSim(ctx, &pb.ActivateSimRequest{
+		Id:     simResp.GetId(),
+		Period: &pb.ActivateSimRequest_Duration{Duration: day},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, activated.GetActivateUntil()+day, extended.GetActivateUntil())
+
+	history, err := s.SimClient.GetActivationHistory(ctx, &pb.GAHRequest{SimId: simResp.GetId()})
+	require.NoError(t, err)
+	require.Len(t, history.GetActivations(), 2)
+
+	assert.Equal(t, pb.ActivationKind_ACTIVATION_KIND_ACTIVATE, history.GetActivations()[0].GetKind())
+	assert.Equal(t, activated.GetActivateUntil(), history.GetActivations()[0].GetActivateUntil())
+	assert.Equal(t, pb.ActivationKind_ACTIVATION_KIND_EXTEND, history.GetActivations()[1].GetKind())
+	assert.Equal(t, activated.GetActivateUntil(), history.GetActivations()[1].GetPreviousUntil())
+	assert.Equal(t, extended.GetActivateUntil(), history.GetActivations()[1].GetActivateUntil())
+}
+
+func TestActivateSimPeriod_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	tests := []struct {
+		name               string
+		req                *pb.ActivateSimRequest
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Activate sim with activate until in the past",
+			req:                &pb.ActivateSimRequest{Id: 1, Period: &pb.ActivateSimRequest_ActivateUntil{ActivateUntil: 1}},
+			expectedErr:        "activate until must be in the future",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Activate sim with negative duration",
+			req:                &pb.ActivateSimRequest{Id: 1, Period: &pb.ActivateSimRequest_Duration{Duration: -1}},
+			expectedErr:        "duration must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.SimClient.ActivateSim(ctx, tt.req)
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+
+	_, err := s.SimClient.GetActivationHistory(ctx, &pb.GAHRequest{SimId: 999999999})
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -71,6 +71,32 @@ func TestAddSim_HappyPath(t *testing.T) {
 	assert.True(t, contains)
 }
 
+// TestAddSim_FormattedNumber checks that a formatted number is stored in the E.164 format with its country.
+func TestAddSim_FormattedNumber(t *testing.T) {
+	ctx, st := suite.NewSuite(t)
+
+	phone := suite.GenerateFakePhoneNumber()
+	formatted := phone[:2] + " (" + phone[2:5] + ") " + phone[5:8] + "-" + phone[8:10] + "-" + phone[10:]
+
+	resp, err := st.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       formatted,
+			ProviderName: suite.GenerateFakeString(16),
+		},
+	})
+	require.NoError(t, err)
+
+	sim, err := st.SimClient.GetSim(ctx, &pb.GetSimRequest{Id: resp.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, phone, sim.GetSim().GetNumber())
+	assert.Equal(t, "RU", sim.GetSim().GetCountry())
+
+	// lookups accept any format of the number too
+	byNumber, err := st.SimClient.GetSimByNumber(ctx, &pb.GetSimByNumberRequest{Number: "00" + phone[1:]})
+	require.NoError(t, err)
+	assert.Equal(t, resp.GetId(), byNumber.GetSim().GetID())
+}
+
 // TestAddSim_DuplicateSim is a test function for adding a duplicate sim.
 //
 // It tests adding a sim with the same phone number and expects an error to be returned.
@@ -97,6 +123,7 @@ func TestAddSim_DuplicateSim(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 	assert.NotEmpty(t, resp.GetId())
+	id := resp.GetId()
 
 	resp, err = ss.SimClient.AddSim(
 		ctx,
@@ -120,6 +147,21 @@ func TestAddSim_DuplicateSim(t *testing.T) {
 	// check response
 	assert.Empty(t, resp.GetId())
 	assert.ErrorContains(t, err, fmt.Sprintf("sim card with number %s already exists", phone))
+	assert.ErrorContains(t, err, fmt.Sprintf("Sim id is `%d`", id))
+
+	// the same number with formatting is a duplicate too
+	_, err = ss.SimClient.AddSim(
+		ctx,
+		&pb.AddSimRequest{
+			SimData: &pb.AddSimData{
+				Number:       phone[:2] + " (" + phone[2:5] + ") " + phone[5:8] + "-" + phone[8:],
+				ProviderName: provider.Name(),
+			},
+		},
+	)
+	require.Error(t, err)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.ErrorContains(t, err, fmt.Sprintf("Sim id is `%d`", id))
 }
 
 // TestAddSim_FailCases tests the failure cases of the AddSim function.
@@ -161,6 +203,20 @@ func TestAddSim_FailCases(t *testing.T) {
 			expectedErr:        "Bad phone number.",
 			expectedStatusCode: codes.InvalidArgument,
 		},
+		{
+			name:               "Add sim with unknown country code",
+			number:             "+999 1234 5678",
+			provider:           provider,
+			expectedErr:        "unknown country calling code",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Add sim with wrong length for the country",
+			number:             "+7 999 888 77",
+			provider:           provider,
+			expectedErr:        "invalid phone number length",
+			expectedStatusCode: codes.InvalidArgument,
+		},
 		{
 			name:               "Add sim with empty provider name",
 			number:             randomNumber,
@@ -25,9 +25,8 @@ func TestDeleteSim_HappyPath(t *testing.T) {
 		0,
 		suite.GenerateFakePhoneNumber(),
 		&fakeProvider,
-		gofakeit.Bool(),
+		core.SimStateFromFlags(gofakeit.Bool(), gofakeit.Bool()),
 		suite.GenerateFakeDateUnix(),
-		gofakeit.Bool(),
 	)
 
 	resp, err := ss.SimClient.AddSim(ctx, &pb.AddSimRequest{
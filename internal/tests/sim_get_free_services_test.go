@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"slices"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetFreeServices_HappyPath checks that a service is free for a new sim
+// and disappears from the free list once the sim is used for it.
+func TestGetFreeServices_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	// add sim
+	// add service
+	// check that service is free for the sim
+	// use sim for service
+	// check that service is not free for the sim anymore
+
+	number := suite.GenerateFakePhoneNumber()
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:        number,
+			ProviderName:  suite.GenerateFakeString(16),
+			IsActivated:   true,
+			ActivateUntil: suite.GenerateFakeDateUnix(),
+		},
+	})
+	require.NoError(t, err)
+
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	free, err := s.SimClient.GetFreeServices(ctx, &pb.GetFreeServRequest{Number: number})
+	require.NoError(t, err)
+	assert.Contains(t, free.GetFreeServiceIds(), serviceResp.GetId())
+	assert.True(t, slices.ContainsFunc(free.GetFreeServices(), func(sd *pb.ServiceData) bool {
+		return sd.GetId() == serviceResp.GetId() && sd.GetName() != ""
+	}))
+
+	_, err = s.UsedClient.UseSimForService(ctx, &pb.USFSRequest{
+		SimID:     simResp.GetId(),
+		ServiceID: serviceResp.GetId(),
+	})
+	require.NoError(t, err)
+
+	free, err = s.SimClient.GetFreeServices(ctx, &pb.GetFreeServRequest{Number: number})
+	require.NoError(t, err)
+	assert.NotContains(t, free.GetFreeServiceIds(), serviceResp.GetId())
+}
+
+func TestGetFreeServices_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	tests := []struct {
+		name               string
+		number             string
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Get free services with invalid number",
+			number:             "invalid",
+			expectedErr:        "Bad phone number.",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Get free services with not existing number",
+			number:             "+70000000000",
+			expectedErr:        "sim card with number +70000000000 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.SimClient.GetFreeServices(ctx, &pb.GetFreeServRequest{Number: tt.number})
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetSim_HappyPath retrieves a new sim by its id and by its number.
+func TestGetSim_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	number := suite.GenerateFakePhoneNumber()
+	providerName := suite.GenerateFakeString(16)
+	resp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       number,
+			ProviderName: providerName,
+		},
+	})
+	require.NoError(t, err)
+
+	byID, err := s.SimClient.GetSim(ctx, &pb.GetSimRequest{Id: resp.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, resp.GetId(), byID.GetSim().GetID())
+	assert.Equal(t, number, byID.GetSim().GetNumber())
+	assert.Equal(t, providerName, byID.GetSim().GetProvider().GetName())
+
+	byNumber, err := s.SimClient.GetSimByNumber(ctx, &pb.GetSimByNumberRequest{Number: number})
+	require.NoError(t, err)
+	assert.Equal(t, resp.GetId(), byNumber.GetSim().GetID())
+	assert.Equal(t, number, byNumber.GetSim().GetNumber())
+}
+
+func TestGetSim_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	tests := []struct {
+		name               string
+		id                 int32
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Get sim with invalid id",
+			id:                 0,
+			expectedErr:        "Invalid id, id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Get not existing sim",
+			id:                 999999999,
+			expectedErr:        "sim card with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.SimClient.GetSim(ctx, &pb.GetSimRequest{Id: tt.id})
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func TestGetSimByNumber_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	tests := []struct {
+		name               string
+		number             string
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Get sim with invalid number",
+			number:             "invalid",
+			expectedErr:        "Bad phone number.",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Get sim with not existing number",
+			number:             "+70000000000",
+			expectedErr:        "sim card with number +70000000000 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.SimClient.GetSimByNumber(ctx, &pb.GetSimByNumberRequest{Number: tt.number})
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
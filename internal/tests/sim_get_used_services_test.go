@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetUsedServices_HappyPath checks that a used service is returned together with its name.
+func TestGetUsedServices_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	// add sim
+	// add service
+	// use sim for service
+	// check that used services contain the service with its name
+
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:        suite.GenerateFakePhoneNumber(),
+			ProviderName:  suite.GenerateFakeString(16),
+			IsActivated:   true,
+			ActivateUntil: suite.GenerateFakeDateUnix(),
+		},
+	})
+	require.NoError(t, err)
+
+	serviceName := suite.GenerateFakeString(30)
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: serviceName})
+	require.NoError(t, err)
+
+	used, err := s.SimClient.GetUsedServices(ctx, &pb.GetUsedServRequest{SimId: simResp.GetId()})
+	require.NoError(t, err)
+	assert.Empty(t, used.GetUsedServices())
+
+	_, err = s.UsedClient.UseSimForService(ctx, &pb.USFSRequest{
+		SimID:     simResp.GetId(),
+		ServiceID: serviceResp.GetId(),
+	})
+	require.NoError(t, err)
+
+	used, err = s.SimClient.GetUsedServices(ctx, &pb.GetUsedServRequest{SimId: simResp.GetId()})
+	require.NoError(t, err)
+	require.Len(t, used.GetUsedServices(), 1)
+	assert.Equal(t, serviceResp.GetId(), used.GetUsedServices()[0].GetServiceId())
+	assert.Equal(t, serviceName, used.GetUsedServices()[0].GetServiceName())
+}
+
+func TestGetUsedServices_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	tests := []struct {
+		name               string
+		id                 int
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Get used services with invalid id",
+			id:                 0,
+			expectedErr:        "Invalid sim id, sim id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Get used services with not existing id",
+			id:                 999999999,
+			expectedErr:        "sim card with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.SimClient.GetUsedServices(ctx, &pb.GetUsedServRequest{SimId: int32(tt.id)})
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
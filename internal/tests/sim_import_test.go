@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"fmt"
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestImportSims_HappyPath imports sims sent as messages and checks that
+// created, duplicate and invalid rows are reported.
+func TestImportSims_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	providerName := suite.GenerateFakeString(16)
+	first := suite.GenerateFakePhoneNumber()
+	second := suite.GenerateFakePhoneNumber()
+
+	stream, err := s.SimClient.ImportSims(ctx)
+	require.NoError(t, err)
+	for _, number := range []string{first, second, first, "invalid"} {
+		err := stream.Send(&pb.ImportSimsRequest{
+			Payload: &pb.ImportSimsRequest_Sim{Sim: &pb.AddSimData{
+				Number:       number,
+				ProviderName: providerName,
+			}},
+		})
+		require.NoError(t, err)
+	}
+	resp, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+
+	assert.True(t, resp.GetCommitted())
+	assert.Equal(t, int32(2), resp.GetCreated())
+	assert.Equal(t, int32(1), resp.GetDuplicates())
+	assert.Equal(t, int32(1), resp.GetInvalid())
+
+	results := resp.GetResults()
+	require.Len(t, results, 4)
+	assert.Equal(t, pb.ImportRowStatus_IMPORT_ROW_STATUS_CREATED, results[0].GetStatus())
+	assert.Equal(t, pb.ImportRowStatus_IMPORT_ROW_STATUS_CREATED, results[1].GetStatus())
+	assert.Equal(t, pb.ImportRowStatus_IMPORT_ROW_STATUS_DUPLICATE, results[2].GetStatus())
+	assert.Equal(t, results[0].GetSimId(), results[2].GetSimId())
+	assert.Equal(t, pb.ImportRowStatus_IMPORT_ROW_STATUS_INVALID, results[3].GetStatus())
+	assert.Contains(t, results[3].GetError(), "Bad phone number.")
+
+	sim, err := s.SimClient.GetSim(ctx, &pb.GetSimRequest{Id: results[1].GetSimId()})
+	require.NoError(t, err)
+	assert.Equal(t, second, sim.GetSim().GetNumber())
+	assert.Equal(t, providerName, sim.GetSim().GetProvider().GetName())
+}
+
+// TestImportSims_CSV imports a csv file sent in two chunks.
+func TestImportSims_CSV(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	providerName := suite.GenerateFakeString(16)
+	first := suite.GenerateFakePhoneNumber()
+	second := suite.GenerateFakePhoneNumber()
+	csv := fmt.Sprintf("number,provider_name,is_activated,activate_until,is_blocked\n"+
+		"%s,%s\n"+
+		"%s,%s,true,1700000000,false\n"+
+		"%s,%s,maybe\n", first, providerName, second, providerName, suite.GenerateFakePhoneNumber(), providerName)
+
+	stream, err := s.SimClient.ImportSims(ctx)
+	require.NoError(t, err)
+	half := len(csv) / 2
+	for _, chunk := range []string{csv[:half], csv[half:]} {
+		err := stream.Send(&pb.ImportSimsRequest{
+			Payload: &pb.ImportSimsRequest_CsvChunk{CsvChunk: []byte(chunk)},
+		})
+		require.NoError(t, err)
+	}
+	resp, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+
+	assert.True(t, resp.GetCommitted())
+	assert.Equal(t, int32(2), resp.GetCreated())
+	assert.Equal(t, int32(1), resp.GetInvalid())
+
+	results := resp.GetResults()
+	require.Len(t, results, 3)
+	assert.Equal(t, pb.ImportRowStatus_IMPORT_ROW_STATUS_INVALID, results[2].GetStatus())
+	assert.Contains(t, results[2].GetError(), "Bad is_activated value")
+
+	sim, err := s.SimClient.GetSimByNumber(ctx, &pb.GetSimByNumberRequest{Number: second})
+	require.NoError(t, err)
+	assert.Equal(t, results[1].GetSimId(), sim.GetSim().GetID())
+	assert.True(t, sim.GetSim().GetIsActivated())
+	assert.Equal(t, int64(1700000000), sim.GetSim().GetActivateUntil())
+}
+
+// TestImportSims_AllOrNothing checks that no sim is stored if one row of the import is a duplicate.
+func TestImportSims_AllOrNothing(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	providerName := suite.GenerateFakeString(16)
+	existing := suite.GenerateFakePhoneNumber()
+	_, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       existing,
+			ProviderName: providerName,
+		},
+	})
+	require.NoError(t, err)
+
+	number := suite.GenerateFakePhoneNumber()
+	stream, err := s.SimClient.ImportSims(ctx)
+	require.NoError(t, err)
+	for _, n := range []string{number, existing} {
+		err := stream.Send(&pb.ImportSimsRequest{
+			AllOrNothing: true,
+			Payload: &pb.ImportSimsRequest_Sim{Sim: &pb.AddSimData{
+				Number:       n,
+				ProviderName: providerName,
+			}},
+		})
+		require.NoError(t, err)
+	}
+	resp, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+
+	assert.False(t, resp.GetCommitted())
+	assert.Equal(t, int32(0), resp.GetCreated())
+	assert.Equal(t, int32(1), resp.GetDuplicates())
+	require.Len(t, resp.GetResults(), 2)
+	assert.Equal(t, pb.ImportRowStatus_IMPORT_ROW_STATUS_ROLLED_BACK, resp.GetResults()[0].GetStatus())
+	assert.Equal(t, int32(0), resp.GetResults()[0].GetSimId())
+	assert.Equal(t, pb.ImportRowStatus_IMPORT_ROW_STATUS_DUPLICATE, resp.GetResults()[1].GetStatus())
+
+	_, err = s.SimClient.GetSimByNumber(ctx, &pb.GetSimByNumberRequest{Number: number})
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
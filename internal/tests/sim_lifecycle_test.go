@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSimLifecycle_HappyPath walks a sim through all lifecycle states.
+func TestSimLifecycle_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	resp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       suite.GenerateFakePhoneNumber(),
+			ProviderName: suite.GenerateFakeString(16),
+		},
+	})
+	require.NoError(t, err)
+	id := resp.GetId()
+
+	_, err = s.SimClient.ActivateSim(ctx, &pb.ActivateSimRequest{
+		Id:     id,
+		Period: &pb.ActivateSimRequest_Duration{Duration: 3600},
+		Reason: "paid for an hour",
+	})
+	require.NoError(t, err)
+
+	_, err = s.SimClient.SetSimBlocked(ctx, &pb.SSBRequest{Id: id, Reason: "lost"})
+	require.NoError(t, err)
+
+	// a blocked sim has to be unblocked before it can be activated again
+	_, err = s.SimClient.ActivateSim(ctx, &pb.ActivateSimRequest{Id: id})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	unblocked, err := s.SimClient.UnblockSim(ctx, &pb.SimTransitionRequest{Id: id, Reason: "found"})
+	require.NoError(t, err)
+	assert.Equal(t, pb.SimState_SIM_STATE_ACTIVE, unblocked.GetSim().GetState())
+	assert.Equal(t, "found", unblocked.GetSim().GetStateReason())
+
+	deactivated, err := s.SimClient.DeactivateSim(ctx, &pb.SimTransitionRequest{Id: id, Reason: "not needed"})
+	require.NoError(t, err)
+	assert.Equal(t, pb.SimState_SIM_STATE_EXPIRED, deactivated.GetSim().GetState())
+	assert.False(t, deactivated.GetSim().GetIsActivated())
+
+	retired, err := s.SimClient.RetireSim(ctx, &pb.SimTransitionRequest{Id: id, Reason: "broken"})
+	require.NoError(t, err)
+	assert.Equal(t, pb.SimState_SIM_STATE_RETIRED, retired.GetSim().GetState())
+
+	// a retired sim never changes its state again
+	_, err = s.SimClient.ActivateSim(ctx, &pb.ActivateSimRequest{Id: id})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestSimLifecycle_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	resp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       suite.GenerateFakePhoneNumber(),
+			ProviderName: suite.GenerateFakeString(16),
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		call               func(req *pb.SimTransitionRequest) error
+		id                 int32
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "Unblock sim with invalid id",
+			call: func(req *pb.SimTransitionRequest) error {
+				_, err := s.SimClient.UnblockSim(ctx, req)
+				return err
+			},
+			id:                 0,
+			expectedErr:        "Invalid id, id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "Retire not existing sim",
+			call: func(req *pb.SimTransitionRequest) error {
+				_, err := s.SimClient.RetireSim(ctx, req)
+				return err
+			},
+			id:                 999999999,
+			expectedErr:        "sim card with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "Unblock not blocked sim",
+			call: func(req *pb.SimTransitionRequest) error {
+				_, err := s.SimClient.UnblockSim(ctx, req)
+				return err
+			},
+			id:                 resp.GetId(),
+			expectedErr:        "only a blocked sim can be unblocked",
+			expectedStatusCode: codes.FailedPrecondition,
+		},
+		{
+			name: "Deactivate new sim",
+			call: func(req *pb.SimTransitionRequest) error {
+				_, err := s.SimClient.DeactivateSim(ctx, req)
+				return err
+			},
+			id:                 resp.GetId(),
+			expectedErr:        "only an active sim can be deactivated",
+			expectedStatusCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call(&pb.SimTransitionRequest{Id: tt.id})
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"slices"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestListSims_HappyPath pages through the sims of a new provider sorted by number
+// and checks that every sim is returned once and in order.
+func TestListSims_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	const simCount = 5
+
+	providerName := suite.GenerateFakeString(16)
+	numbers := make([]string, 0, simCount)
+	for range simCount {
+		number := suite.GenerateFakePhoneNumber()
+		_, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+			SimData: &pb.AddSimData{
+				Number:       number,
+				ProviderName: providerName,
+			},
+		})
+		require.NoError(t, err)
+		numbers = append(numbers, number)
+	}
+	slices.Sort(numbers)
+
+	var (
+		listed []string
+		token  string
+		pages  int
+	)
+	for {
+		resp, err := s.SimClient.ListSims(ctx, &pb.ListSimsRequest{
+			Filter:    &pb.SimFilter{ProviderName: providerName},
+			SortBy:    pb.SimSortKey_SIM_SORT_KEY_NUMBER,
+			PageSize:  2,
+			PageToken: token,
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(resp.GetSims()), 2)
+		pages++
+
+		for _, sim := range resp.GetSims() {
+			assert.Equal(t, providerName, sim.GetProvider().GetName())
+			listed = append(listed, sim.GetNumber())
+		}
+
+		token = resp.GetNextPageToken()
+		if token == "" {
+			break
+		}
+	}
+
+	assert.Equal(t, 3, pages)
+	assert.Equal(t, numbers, listed)
+
+	// descending order by id with a single page
+	resp, err := s.SimClient.ListSims(ctx, &pb.ListSimsRequest{
+		Filter:     &pb.SimFilter{ProviderName: providerName, States: []pb.SimState{pb.SimState_SIM_STATE_NEW}},
+		Descending: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetSims(), simCount)
+	assert.Empty(t, resp.GetNextPageToken())
+	assert.True(t, slices.IsSortedFunc(resp.GetSims(), func(a, b *pb.SimData) int {
+		return int(b.GetID() - a.GetID())
+	}))
+}
+
+func TestListSims_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	// two sims make sure that the first page of one sim has a next page token
+	for range 2 {
+		_, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+			SimData: &pb.AddSimData{
+				Number:       suite.GenerateFakePhoneNumber(),
+				ProviderName: suite.GenerateFakeString(16),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := s.SimClient.ListSims(ctx, &pb.ListSimsRequest{PageSize: 1})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.GetNextPageToken())
+
+	tests := []struct {
+		name               string
+		req                *pb.ListSimsRequest
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "List sims with negative page size",
+			req:                &pb.ListSimsRequest{PageSize: -1},
+			expectedErr:        "Invalid page size",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "List sims with invalid page token",
+			req:                &pb.ListSimsRequest{PageToken: "invalid"},
+			expectedErr:        "Invalid page token",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "List sims with page token of another sort",
+			req:                &pb.ListSimsRequest{SortBy: pb.SimSortKey_SIM_SORT_KEY_NUMBER, PageToken: resp.GetNextPageToken()},
+			expectedErr:        "Invalid page token",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "List sims with invalid number prefix",
+			req:                &pb.ListSimsRequest{Filter: &pb.SimFilter{NumberPrefix: "+7"}},
+			expectedErr:        "Invalid number prefix",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "List sims with unspecified state",
+			req:                &pb.ListSimsRequest{Filter: &pb.SimFilter{States: []pb.SimState{pb.SimState_SIM_STATE_UNSPECIFIED}}},
+			expectedErr:        "Invalid sim state",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.SimClient.ListSims(ctx, tt.req)
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRestoreSim_HappyPath deletes a used sim, the sim is not found until it is restored with its used services.
+func TestRestoreSim_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	number := suite.GenerateFakePhoneNumber()
+	simID := addActiveSim(ctx, t, s, number)
+
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+	_, err = s.UsedClient.UseSimForService(ctx, &pb.USFSRequest{SimID: simID, ServiceID: serviceResp.GetId()})
+	require.NoError(t, err)
+
+	_, err = s.SimClient.DeleteSim(ctx, &pb.DeleteSimRequest{Id: simID})
+	require.NoError(t, err)
+
+	_, err = s.SimClient.GetSim(ctx, &pb.GetSimRequest{Id: simID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.SimClient.GetSimByNumber(ctx, &pb.GetSimByNumberRequest{Number: number})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	restored, err := s.SimClient.RestoreSim(ctx, &pb.RestoreSimRequest{Id: simID})
+	require.NoError(t, err)
+	assert.Equal(t, simID, restored.GetSim().GetID())
+
+	got, err := s.SimClient.GetSim(ctx, &pb.GetSimRequest{Id: simID})
+	require.NoError(t, err)
+	assert.Equal(t, restored.GetSim().GetNumber(), got.GetSim().GetNumber())
+
+	used, err := s.SimClient.GetUsedServices(ctx, &pb.GetUsedServRequest{SimId: simID})
+	require.NoError(t, err)
+	require.Len(t, used.GetUsedServices(), 1)
+	assert.Equal(t, serviceResp.GetId(), used.GetUsedServices()[0].GetServiceId())
+}
+
+// TestRestoreSim_NumberReused adds a new sim with the number of a deleted sim,
+// the deleted sim can not be restored while the new sim has its number.
+func TestRestoreSim_NumberReused(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	number := suite.GenerateFakePhoneNumber()
+	deletedID := addActiveSim(ctx, t, s, number)
+
+	_, err := s.SimClient.DeleteSim(ctx, &pb.DeleteSimRequest{Id: deletedID})
+	require.NoError(t, err)
+
+	newID := addActiveSim(ctx, t, s, number)
+	assert.NotEqual(t, deletedID, newID)
+
+	_, err = s.SimClient.RestoreSim(ctx, &pb.RestoreSimRequest{Id: deletedID})
+	require.Error(t, err)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestRestoreSim_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	activeID := addActiveSim(ctx, t, s, suite.GenerateFakePhoneNumber())
+
+	tests := []struct {
+		name               string
+		req                *pb.RestoreSimRequest
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Invalid id",
+			req:                &pb.RestoreSimRequest{Id: 0},
+			expectedErr:        "Invalid id, id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Not existing sim",
+			req:                &pb.RestoreSimRequest{Id: 999999999},
+			expectedErr:        "deleted sim card with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name:               "Not deleted sim",
+			req:                &pb.RestoreSimRequest{Id: activeID},
+			expectedErr:        "not found",
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.SimClient.RestoreSim(ctx, tt.req)
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
@@ -29,9 +29,8 @@ func TestSetBlockedSim_HappyPath(t *testing.T) {
 		0,
 		suite.GenerateFakePhoneNumber(),
 		&fakeProvider,
-		gofakeit.Bool(),
+		core.SimStateFromFlags(gofakeit.Bool(), false),
 		suite.GenerateFakeDateUnix(),
-		false,
 	)
 
 	resp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
@@ -56,6 +55,12 @@ func TestSetBlockedSim_HappyPath(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// blocking an already blocked sim changes nothing
+	_, err = s.SimClient.SetSimBlocked(ctx, &pb.SSBRequest{
+		Id: int32(sim.Id()),
+	})
+	require.NoError(t, err)
+
 	// get sim list
 	// find sim by id
 	// check that sim is blocked
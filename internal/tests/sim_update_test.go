@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUpdateSim_HappyPath changes the number and provider of a sim and checks
+// that fields outside of the update mask stay untouched.
+func TestUpdateSim_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	activateUntil := suite.GenerateFakeDateUnix()
+	resp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:        suite.GenerateFakePhoneNumber(),
+			ProviderName:  suite.GenerateFakeString(16),
+			IsActivated:   true,
+			ActivateUntil: activateUntil,
+		},
+	})
+	require.NoError(t, err)
+
+	number := suite.GenerateFakePhoneNumber()
+	providerName := suite.GenerateFakeString(16)
+	updated, err := s.SimClient.UpdateSim(ctx, &pb.UpdateSimRequest{
+		Id: resp.GetId(),
+		Sim: &pb.UpdateSimData{
+			Number:        number,
+			ProviderName:  providerName,
+			ActivateUntil: 1,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"number", "provider_name"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resp.GetId(), updated.GetSim().GetID())
+	assert.Equal(t, number, updated.GetSim().GetNumber())
+	assert.Equal(t, providerName, updated.GetSim().GetProvider().GetName())
+	assert.Equal(t, activateUntil, updated.GetSim().GetActivateUntil())
+	assert.True(t, updated.GetSim().GetIsActivated())
+
+	list, err := s.SimClient.GetSimList(ctx, &pb.Empty{})
+	require.NoError(t, err)
+	var found bool
+	for _, sim := range list.GetSimList() {
+		if sim.GetID() == resp.GetId() {
+			found = true
+			assert.Equal(t, number, sim.GetNumber())
+			assert.Equal(t, providerName, sim.GetProvider().GetName())
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestUpdateSim_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	existing := suite.GenerateFakePhoneNumber()
+	_, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       existing,
+			ProviderName: suite.GenerateFakeString(16),
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       suite.GenerateFakePhoneNumber(),
+			ProviderName: suite.GenerateFakeString(16),
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		id                 int32
+		sim                *pb.UpdateSimData
+		paths              []string
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Update sim with invalid id",
+			id:                 0,
+			paths:              []string{"number"},
+			expectedErr:        "Invalid id, id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Update sim without update mask",
+			id:                 resp.GetId(),
+			sim:                &pb.UpdateSimData{Number: suite.GenerateFakePhoneNumber()},
+			expectedErr:        "Update mask is required",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Update sim with unknown path",
+			id:                 resp.GetId(),
+			paths:              []string{"state"},
+			expectedErr:        "Invalid update mask",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Update sim with bad number",
+			id:                 resp.GetId(),
+			sim:                &pb.UpdateSimData{Number: "123"},
+			paths:              []string{"number"},
+			expectedErr:        "Bad phone number.",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Update sim with empty provider name",
+			id:                 resp.GetId(),
+			sim:                &pb.UpdateSimData{},
+			paths:              []string{"provider_name"},
+			expectedErr:        "Provider name is required.",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Update not existing sim",
+			id:                 999999999,
+			sim:                &pb.UpdateSimData{ActivateUntil: suite.GenerateFakeDateUnix()},
+			paths:              []string{"activate_until"},
+			expectedErr:        "sim card with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name:               "Update sim with number of another sim",
+			id:                 resp.GetId(),
+			sim:                &pb.UpdateSimData{Number: existing},
+			paths:              []string{"number"},
+			expectedErr:        "already exists",
+			expectedStatusCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.SimClient.UpdateSim(ctx, &pb.UpdateSimRequest{
+				Id:         tt.id,
+				Sim:        tt.sim,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: tt.paths},
+			})
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
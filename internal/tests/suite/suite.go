@@ -14,19 +14,30 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 type Suite struct {
 	*testing.T
-	Cfg           config.Config
-	SimClient     SimHelper.SimClient
-	ServiceClient SimHelper.ServiceClient
-	UsedClient    SimHelper.UsedClient
+	Cfg             config.Config
+	SimClient       SimHelper.SimClient
+	ServiceClient   SimHelper.ServiceClient
+	UsedClient      SimHelper.UsedClient
+	InventoryClient SimHelper.InventoryClient
+	ProviderClient  SimHelper.ProviderClient
+	AuditClient     SimHelper.AuditClient
 }
 
 const (
 	grpcHost   = "127.0.0.1"
-	configPath = "../../config/app/local.yaml"
+	configPath = "../../config/app/test.yaml"
+)
+
+// API keys of config/app/test.yaml, the suite clients use the admin key.
+const (
+	AdminAPIKey    = "local-admin-key"
+	OperatorAPIKey = "local-operator-key"
+	ViewerAPIKey   = "local-viewer-key"
 )
 
 func NewSuite(t *testing.T) (context.Context, *Suite) {
@@ -42,34 +53,55 @@ func NewSuite(t *testing.T) (context.Context, *Suite) {
 		cancelCtx()
 	})
 
-	cc, err := grpc.DialContext(
-		context.Background(),
-		grpcArrdress(*cfg),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	cc := Dial(t, *cfg, AdminAPIKey)
 
-	if err != nil {
-		t.Fatalf("grpc server connection failer: %v", err)
+	return ctx, &Suite{
+		T:               t,
+		Cfg:             *cfg,
+		SimClient:       SimHelper.NewSimClient(cc),
+		ServiceClient:   SimHelper.NewServiceClient(cc),
+		UsedClient:      SimHelper.NewUsedClient(cc),
+		InventoryClient: SimHelper.NewInventoryClient(cc),
+		ProviderClient:  SimHelper.NewProviderClient(cc),
+		AuditClient:     SimHelper.NewAuditClient(cc),
 	}
+}
 
-	return ctx, &Suite{
-		T:             t,
-		Cfg:           *cfg,
-		SimClient:     SimHelper.NewSimClient(cc),
-		ServiceClient: SimHelper.NewServiceClient(cc),
-		UsedClient:    SimHelper.NewUsedClient(cc),
+// Dial connects to the gRPC server, every request of the connection sends the API key.
+// No key is sent if the key is empty.
+func Dial(t *testing.T, cfg config.Config, key string) *grpc.ClientConn {
+	t.Helper()
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if key != "" {
+		opts = append(opts,
+			grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+				return invoker(metadata.AppendToOutgoingContext(ctx, "x-api-key", key), method, req, reply, cc, opts...)
+			}),
+			grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+				return streamer(metadata.AppendToOutgoingContext(ctx, "x-api-key", key), desc, cc, method, opts...)
+			}),
+		)
 	}
+
+	cc, err := grpc.DialContext(context.Background(), grpcArrdress(cfg), opts...)
+	if err != nil {
+		t.Fatalf("grpc server connection failer: %v", err)
+	}
+	t.Cleanup(func() { cc.Close() })
+	return cc
 }
+
 func grpcArrdress(cfg config.Config) string {
 	return net.JoinHostPort(grpcHost, strconv.Itoa(cfg.GRPC.Port))
 }
 
+// GenerateFakePhoneNumber generates a russian mobile number in the E.164 format, e.g. +79998887766.
 func GenerateFakePhoneNumber() string {
-	countryCode := gofakeit.Number(1, 999)
-	operatorCode := gofakeit.Number(100, 999)
-	phoneNumber := gofakeit.Number(1000, 9999)
+	operatorCode := gofakeit.Number(900, 999)
+	phoneNumber := gofakeit.Number(1000000, 9999999)
 
-	return fmt.Sprintf("%d%d%d", countryCode, operatorCode, phoneNumber)
+	return fmt.Sprintf("+7%d%d", operatorCode, phoneNumber)
 }
 
 func GenerateFakeDateUnix() int64 {
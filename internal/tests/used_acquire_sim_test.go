@@ -0,0 +1,178 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAcquireSim_HappyPath acquires a sim for a new service, confirms the lease
+// and checks that the sim is used for the service afterwards.
+func TestAcquireSim_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	_, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:        suite.GenerateFakePhoneNumber(),
+			ProviderName:  suite.GenerateFakeString(16),
+			IsActivated:   true,
+			ActivateUntil: suite.GenerateFakeDateUnix(),
+		},
+	})
+	require.NoError(t, err)
+
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	acquired, err := s.UsedClient.AcquireSim(ctx, &pb.AcquireSimRequest{ServiceID: serviceResp.GetId()})
+	require.NoError(t, err)
+	require.NotEmpty(t, acquired.GetLeaseID())
+	require.NotNil(t, acquired.GetSim())
+	assert.True(t, acquired.GetSim().GetIsActivated())
+	assert.False(t, acquired.GetSim().GetIsBlocked())
+
+	// the leased sim must not be handed out twice
+	second, err := s.UsedClient.AcquireSim(ctx, &pb.AcquireSimRequest{ServiceID: serviceResp.GetId()})
+	if err == nil {
+		assert.NotEqual(t, acquired.GetSim().GetID(), second.GetSim().GetID())
+		_, err = s.UsedClient.ReleaseLease(ctx, &pb.LeaseRequest{LeaseID: second.GetLeaseID()})
+		require.NoError(t, err)
+	} else {
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	}
+
+	confirmed, err := s.UsedClient.ConfirmLease(ctx, &pb.LeaseRequest{LeaseID: acquired.GetLeaseID()})
+	require.NoError(t, err)
+	assert.Greater(t, int(confirmed.GetUsedID()), 0)
+
+	used, err := s.SimClient.GetUsedServices(ctx, &pb.GetUsedServRequest{SimId: acquired.GetSim().GetID()})
+	require.NoError(t, err)
+	assert.Contains(t, serviceIDs(used.GetUsedServices()), serviceResp.GetId())
+
+	// a confirmed lease is gone
+	_, err = s.UsedClient.ConfirmLease(ctx, &pb.LeaseRequest{LeaseID: acquired.GetLeaseID()})
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestReleaseLease_HappyPath checks that a released sim can be acquired again.
+func TestReleaseLease_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	_, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:        suite.GenerateFakePhoneNumber(),
+			ProviderName:  suite.GenerateFakeString(16),
+			IsActivated:   true,
+			ActivateUntil: suite.GenerateFakeDateUnix(),
+		},
+	})
+	require.NoError(t, err)
+
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	acquired, err := s.UsedClient.AcquireSim(ctx, &pb.AcquireSimRequest{ServiceID: serviceResp.GetId(), LeaseTTL: 60})
+	require.NoError(t, err)
+
+	released, err := s.UsedClient.ReleaseLease(ctx, &pb.LeaseRequest{LeaseID: acquired.GetLeaseID()})
+	require.NoError(t, err)
+	assert.True(t, released.GetIsReleased())
+
+	_, err = s.UsedClient.ReleaseLease(ctx, &pb.LeaseRequest{LeaseID: acquired.GetLeaseID()})
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestAcquireSim_LeasedSimNotUsedDirectly checks that a leased sim can only be used for the service
+// through the confirmation of its lease.
+func TestAcquireSim_LeasedSimNotUsedDirectly(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	_, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:        suite.GenerateFakePhoneNumber(),
+			ProviderName:  suite.GenerateFakeString(16),
+			IsActivated:   true,
+			ActivateUntil: suite.GenerateFakeDateUnix(),
+		},
+	})
+	require.NoError(t, err)
+
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	acquired, err := s.UsedClient.AcquireSim(ctx, &pb.AcquireSimRequest{ServiceID: serviceResp.GetId(), LeaseTTL: 60})
+	require.NoError(t, err)
+
+	req := &pb.EligibilityRequest{SimID: acquired.GetSim().GetID(), ServiceID: serviceResp.GetId()}
+	eligibility, err := s.UsedClient.CheckEligibility(ctx, req)
+	require.NoError(t, err)
+	assert.False(t, eligibility.GetEligible())
+	assert.Contains(t, eligibility.GetReasons(), pb.EligibilityReason_ELIGIBILITY_REASON_LEASED)
+
+	_, err = s.UsedClient.UseSimForService(ctx, &pb.USFSRequest{SimID: acquired.GetSim().GetID(), ServiceID: serviceResp.GetId()})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "leased")
+
+	// the lease itself does not keep its confirmation from using the sim
+	_, err = s.UsedClient.ConfirmLease(ctx, &pb.LeaseRequest{LeaseID: acquired.GetLeaseID()})
+	require.NoError(t, err)
+}
+
+func TestAcquireSim_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	tests := []struct {
+		name               string
+		serviceID          int32
+		leaseTTL           int64
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Acquire sim with invalid service id",
+			serviceID:          0,
+			expectedErr:        "Invalid service id, service id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Acquire sim with negative lease ttl",
+			serviceID:          1,
+			leaseTTL:           -1,
+			expectedErr:        "Invalid lease ttl",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Acquire sim for not existing service",
+			serviceID:          999999999,
+			expectedErr:        "service with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.UsedClient.AcquireSim(ctx, &pb.AcquireSimRequest{ServiceID: tt.serviceID, LeaseTTL: tt.leaseTTL})
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func serviceIDs(used []*pb.UsedService) []int32 {
+	ids := make([]int32, 0, len(used))
+	for _, u := range used {
+		ids = append(ids, u.GetServiceId())
+	}
+	return ids
+}
@@ -0,0 +1,168 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"strings"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBlockUsedService_HappyPath blocks a new sim on a service it was never used for,
+// checks that the service is not free for the sim anymore and unblocks it again.
+func TestBlockUsedService_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	number := suite.GenerateFakePhoneNumber()
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       number,
+			ProviderName: suite.GenerateFakeString(16),
+		},
+	})
+	require.NoError(t, err)
+
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	blocked, err := s.UsedClient.BlockUsedService(ctx, &pb.BlockUsedServiceRequest{
+		SimID:     simResp.GetId(),
+		ServiceID: serviceResp.GetId(),
+		Reason:    "number is banned",
+	})
+	require.NoError(t, err)
+	assert.True(t, blocked.GetUsedService().GetIsBlocked())
+	assert.Equal(t, "number is banned", blocked.GetUsedService().GetBlockedInfo())
+	assert.Greater(t, blocked.GetUsedService().GetBlockedAt(), int64(0))
+
+	used, err := s.SimClient.GetUsedServices(ctx, &pb.GetUsedServRequest{SimId: simResp.GetId()})
+	require.NoError(t, err)
+	require.Len(t, used.GetUsedServices(), 1)
+	assert.True(t, used.GetUsedServices()[0].GetIsBlocked())
+	assert.Equal(t, blocked.GetUsedService().GetBlockedAt(), used.GetUsedServices()[0].GetBlockedAt())
+
+	free, err := s.SimClient.GetFreeServices(ctx, &pb.GetFreeServRequest{Number: number})
+	require.NoError(t, err)
+	assert.NotContains(t, free.GetFreeServiceIds(), serviceResp.GetId())
+
+	unblocked, err := s.UsedClient.UnblockUsedService(ctx, &pb.UnblockUsedServiceRequest{
+		SimID:     simResp.GetId(),
+		ServiceID: serviceResp.GetId(),
+	})
+	require.NoError(t, err)
+	assert.False(t, unblocked.GetUsedService().GetIsBlocked())
+	assert.Empty(t, unblocked.GetUsedService().GetBlockedInfo())
+	assert.Equal(t, int64(0), unblocked.GetUsedService().GetBlockedAt())
+
+	used, err = s.SimClient.GetUsedServices(ctx, &pb.GetUsedServRequest{SimId: simResp.GetId()})
+	require.NoError(t, err)
+	require.Len(t, used.GetUsedServices(), 1)
+	assert.False(t, used.GetUsedServices()[0].GetIsBlocked())
+}
+
+// TestBlockUsedService_NotCountedPerDay blocks a sim on a service it was never used for
+// and uses it for a service that allows one registration a day, the block is not a registration.
+func TestBlockUsedService_NotCountedPerDay(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:        suite.GenerateFakePhoneNumber(),
+			ProviderName:  suite.GenerateFakeString(16),
+			IsActivated:   true,
+			ActivateUntil: suite.GenerateFakeDateUnix(),
+		},
+	})
+	require.NoError(t, err)
+
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	blocked, err := s.UsedClient.BlockUsedService(ctx, &pb.BlockUsedServiceRequest{
+		SimID:     simResp.GetId(),
+		ServiceID: serviceResp.GetId(),
+		Reason:    "number is banned",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), blocked.GetUsedService().GetUsedAt())
+
+	serviceID := serviceIDByName(ctx, t, s, maxPerDayServiceName)
+	_, err = s.UsedClient.UseSimForService(ctx, &pb.USFSRequest{SimID: simResp.GetId(), ServiceID: serviceID})
+	require.NoError(t, err)
+}
+
+func TestBlockUsedService_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       suite.GenerateFakePhoneNumber(),
+			ProviderName: suite.GenerateFakeString(16),
+		},
+	})
+	require.NoError(t, err)
+
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		req                *pb.BlockUsedServiceRequest
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Block with invalid sim id",
+			req:                &pb.BlockUsedServiceRequest{SimID: 0, ServiceID: serviceResp.GetId()},
+			expectedErr:        "Invalid sim id, sim id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Block with invalid service id",
+			req:                &pb.BlockUsedServiceRequest{SimID: simResp.GetId(), ServiceID: 0},
+			expectedErr:        "Invalid service id, service id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Block with too long reason",
+			req:                &pb.BlockUsedServiceRequest{SimID: simResp.GetId(), ServiceID: serviceResp.GetId(), Reason: strings.Repeat("a", 65)},
+			expectedErr:        "Reason is too long",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Block not existing sim",
+			req:                &pb.BlockUsedServiceRequest{SimID: 999999999, ServiceID: serviceResp.GetId()},
+			expectedErr:        "sim card with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name:               "Block on not existing service",
+			req:                &pb.BlockUsedServiceRequest{SimID: simResp.GetId(), ServiceID: 999999999},
+			expectedErr:        "service with id 999999999 not found",
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.UsedClient.BlockUsedService(ctx, tt.req)
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+
+	// unblock of a service the sim was never used for
+	_, err = s.UsedClient.UnblockUsedService(ctx, &pb.UnblockUsedServiceRequest{
+		SimID:     simResp.GetId(),
+		ServiceID: serviceResp.GetId(),
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,168 @@
+package tests
+
+import (
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckEligibility_HappyPath checks an active sim before and after it is used for a service.
+func TestCheckEligibility_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:        suite.GenerateFakePhoneNumber(),
+			ProviderName:  suite.GenerateFakeString(16),
+			IsActivated:   true,
+			ActivateUntil: suite.GenerateFakeDateUnix(),
+		},
+	})
+	require.NoError(t, err)
+
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	req := &pb.EligibilityRequest{SimID: simResp.GetId(), ServiceID: serviceResp.GetId()}
+	eligibility, err := s.UsedClient.CheckEligibility(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, eligibility.GetEligible())
+	assert.Empty(t, eligibility.GetReasons())
+
+	_, err = s.UsedClient.UseSimForService(ctx, &pb.USFSRequest{SimID: simResp.GetId(), ServiceID: serviceResp.GetId()})
+	require.NoError(t, err)
+
+	eligibility, err = s.UsedClient.CheckEligibility(ctx, req)
+	require.NoError(t, err)
+	assert.False(t, eligibility.GetEligible())
+	assert.Equal(t, []pb.EligibilityReason{pb.EligibilityReason_ELIGIBILITY_REASON_ALREADY_USED}, eligibility.GetReasons())
+}
+
+// TestCheckEligibility_Reasons checks the reasons of sims and services that are not eligible.
+func TestCheckEligibility_Reasons(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	newSim, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       suite.GenerateFakePhoneNumber(),
+			ProviderName: suite.GenerateFakeString(16),
+		},
+	})
+	require.NoError(t, err)
+
+	blockedSim, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       suite.GenerateFakePhoneNumber(),
+			ProviderName: suite.GenerateFakeString(16),
+			IsBlocked:    true,
+		},
+	})
+	require.NoError(t, err)
+
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		req             *pb.EligibilityRequest
+		expectedReasons []pb.EligibilityReason
+	}{
+		{
+			name:            "Not activated sim",
+			req:             &pb.EligibilityRequest{SimID: newSim.GetId(), ServiceID: serviceResp.GetId()},
+			expectedReasons: []pb.EligibilityReason{pb.EligibilityReason_ELIGIBILITY_REASON_SIM_NOT_ACTIVATED},
+		},
+		{
+			name:            "Blocked sim",
+			req:             &pb.EligibilityRequest{SimID: blockedSim.GetId(), ServiceID: serviceResp.GetId()},
+			expectedReasons: []pb.EligibilityReason{pb.EligibilityReason_ELIGIBILITY_REASON_SIM_BLOCKED},
+		},
+		{
+			name: "Not existing sim and service",
+			req:  &pb.EligibilityRequest{SimID: 999999999, ServiceID: 999999999},
+			expectedReasons: []pb.EligibilityReason{
+				pb.EligibilityReason_ELIGIBILITY_REASON_SIM_NOT_FOUND,
+				pb.EligibilityReason_ELIGIBILITY_REASON_SERVICE_NOT_FOUND,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eligibility, err := s.UsedClient.CheckEligibility(ctx, tt.req)
+			require.NoError(t, err)
+			assert.False(t, eligibility.GetEligible())
+			assert.Equal(t, tt.expectedReasons, eligibility.GetReasons())
+		})
+	}
+}
+
+func TestUseSimForService_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	blockedSim, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:       suite.GenerateFakePhoneNumber(),
+			ProviderName: suite.GenerateFakeString(16),
+			IsBlocked:    true,
+		},
+	})
+	require.NoError(t, err)
+
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		req                *pb.USFSRequest
+		expectedErr        string
+		expectedStatusCode codes.Code
+		expectedReason     pb.EligibilityReason
+	}{
+		{
+			name:               "Use sim with invalid sim id",
+			req:                &pb.USFSRequest{SimID: 0, ServiceID: serviceResp.GetId()},
+			expectedErr:        "Invalid sim id, sim id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Use not existing sim",
+			req:                &pb.USFSRequest{SimID: 999999999, ServiceID: serviceResp.GetId()},
+			expectedErr:        "sim_not_found",
+			expectedStatusCode: codes.NotFound,
+			expectedReason:     pb.EligibilityReason_ELIGIBILITY_REASON_SIM_NOT_FOUND,
+		},
+		{
+			name:               "Use blocked sim",
+			req:                &pb.USFSRequest{SimID: blockedSim.GetId(), ServiceID: serviceResp.GetId()},
+			expectedErr:        "sim_blocked",
+			expectedStatusCode: codes.FailedPrecondition,
+			expectedReason:     pb.EligibilityReason_ELIGIBILITY_REASON_SIM_BLOCKED,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.UsedClient.UseSimForService(ctx, tt.req)
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+
+			if tt.expectedReason == pb.EligibilityReason_ELIGIBILITY_REASON_UNSPECIFIED {
+				return
+			}
+			details := status.Convert(err).Details()
+			require.Len(t, details, 1)
+			eligibility, ok := details[0].(*pb.EligibilityResponse)
+			require.True(t, ok)
+			assert.Contains(t, eligibility.GetReasons(), tt.expectedReason)
+		})
+	}
+}
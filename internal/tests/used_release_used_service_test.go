@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"context"
+	"simactive/internal/tests/suite"
+	"testing"
+
+	pb "simactive/api/generated/github.com/fixedNick/SimHelper"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cooldownServiceName and maxPerDayServiceName are the services with a cooldown
+// and with a daily limit of one registration in the use policy of config/app/test.yaml.
+const (
+	cooldownServiceName  = "cooldown-test"
+	maxPerDayServiceName = "max-per-day-test"
+)
+
+// TestReleaseUsedService_HappyPath uses a sim for a service without a cooldown,
+// releases it and uses it for the service again.
+func TestReleaseUsedService_HappyPath(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:        suite.GenerateFakePhoneNumber(),
+			ProviderName:  suite.GenerateFakeString(16),
+			IsActivated:   true,
+			ActivateUntil: suite.GenerateFakeDateUnix(),
+		},
+	})
+	require.NoError(t, err)
+
+	serviceResp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: suite.GenerateFakeString(30)})
+	require.NoError(t, err)
+
+	use := &pb.USFSRequest{SimID: simResp.GetId(), ServiceID: serviceResp.GetId()}
+	_, err = s.UsedClient.UseSimForService(ctx, use)
+	require.NoError(t, err)
+
+	// the sim is still used for the service
+	_, err = s.UsedClient.UseSimForService(ctx, use)
+	require.Error(t, err)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	released, err := s.UsedClient.ReleaseUsedService(ctx, &pb.ReleaseUsedServiceRequest{
+		SimID:     simResp.GetId(),
+		ServiceID: serviceResp.GetId(),
+	})
+	require.NoError(t, err)
+	assert.Greater(t, released.GetUsedService().GetUsedAt(), int64(0))
+	assert.GreaterOrEqual(t, released.GetUsedService().GetReleasedAt(), released.GetUsedService().GetUsedAt())
+
+	_, err = s.UsedClient.UseSimForService(ctx, use)
+	require.NoError(t, err)
+
+	used, err := s.SimClient.GetUsedServices(ctx, &pb.GetUsedServRequest{SimId: simResp.GetId()})
+	require.NoError(t, err)
+	assert.Len(t, used.GetUsedServices(), 2)
+}
+
+// TestUseSimForService_Cooldown checks that a released sim is not used for a service with a cooldown again.
+func TestUseSimForService_Cooldown(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	number := suite.GenerateFakePhoneNumber()
+	simResp, err := s.SimClient.AddSim(ctx, &pb.AddSimRequest{
+		SimData: &pb.AddSimData{
+			Number:        number,
+			ProviderName:  suite.GenerateFakeString(16),
+			IsActivated:   true,
+			ActivateUntil: suite.GenerateFakeDateUnix(),
+		},
+	})
+	require.NoError(t, err)
+
+	serviceID := serviceIDByName(ctx, t, s, cooldownServiceName)
+
+	use := &pb.USFSRequest{SimID: simResp.GetId(), ServiceID: serviceID}
+	_, err = s.UsedClient.UseSimForService(ctx, use)
+	require.NoError(t, err)
+
+	_, err = s.UsedClient.ReleaseUsedService(ctx, &pb.ReleaseUsedServiceRequest{
+		SimID:     simResp.GetId(),
+		ServiceID: serviceID,
+	})
+	require.NoError(t, err)
+
+	_, err = s.UsedClient.UseSimForService(ctx, use)
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Contains(t, err.Error(), "again at")
+
+	free, err := s.SimClient.GetFreeServices(ctx, &pb.GetFreeServRequest{Number: number})
+	require.NoError(t, err)
+	assert.NotContains(t, free.GetFreeServiceIds(), serviceID)
+}
+
+func TestReleaseUsedService_FailCases(t *testing.T) {
+	ctx, s := suite.NewSuite(t)
+
+	tests := []struct {
+		name               string
+		req                *pb.ReleaseUsedServiceRequest
+		expectedErr        string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "Release with invalid sim id",
+			req:                &pb.ReleaseUsedServiceRequest{SimID: 0, ServiceID: 1},
+			expectedErr:        "Invalid sim id, sim id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Release with invalid service id",
+			req:                &pb.ReleaseUsedServiceRequest{SimID: 1, ServiceID: 0},
+			expectedErr:        "Invalid service id, service id must be greater than 0",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "Release not used sim",
+			req:                &pb.ReleaseUsedServiceRequest{SimID: 999999999, ServiceID: 999999999},
+			expectedErr:        "sim card with id 999999999 is not used for service with id 999999999",
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.UsedClient.ReleaseUsedService(ctx, tt.req)
+			require.Error(t, err)
+			require.Equal(t, tt.expectedStatusCode, status.Code(err))
+			require.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+// serviceIDByName returns the id of the service with the name, the service is added if it does not exist.
+func serviceIDByName(ctx context.Context, t *testing.T, s *suite.Suite, name string) int32 {
+	t.Helper()
+
+	resp, err := s.ServiceClient.AddService(ctx, &pb.AddServiceRequest{Name: name})
+	if err == nil {
+		return resp.GetId()
+	}
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	list, err := s.ServiceClient.GetServiceList(ctx, &pb.Empty{})
+	require.NoError(t, err)
+	for _, service := range list.GetServices() {
+		if service.GetName() == name {
+			return service.GetId()
+		}
+	}
+	t.Fatalf("service %s not found", name)
+	return 0
+}
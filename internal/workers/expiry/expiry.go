@@ -0,0 +1,97 @@
+package expiry
+
+import (
+	"context"
+	"log/slog"
+	"simactive/internal/core"
+	"simactive/internal/lib/logger/sl"
+	"time"
+)
+
+// actor is the audit actor of the expirations.
+const actor = "expiry-worker"
+
+// SimExpirer deactivates the sims whose activation period has ended.
+type SimExpirer interface {
+	ExpireSims(ctx context.Context, now time.Time) ([]*core.Sim, error)
+}
+
+// Worker periodically deactivates sims past their activate_until timestamp.
+type Worker struct {
+	logger   *slog.Logger
+	expirer  SimExpirer
+	interval time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New creates a new expiry Worker that checks sims every interval.
+func New(logger *slog.Logger, expirer SimExpirer, interval time.Duration) *Worker {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Worker{
+		logger:   logger,
+		expirer:  expirer,
+		interval: interval,
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+}
+
+// Run checks the sims every interval until Stop is called.
+// It blocks, so it is supposed to be run in a separate goroutine.
+func (w *Worker) Run() {
+	const op = "expiry.Worker.Run"
+
+	defer close(w.done)
+	ctx := w.ctx
+
+	w.logger.Info("Activation expiry worker started", slog.String("op", op), slog.Duration("interval", w.interval))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if ctx.Err() == nil {
+			w.expire(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Activation expiry worker stopped", slog.String("op", op))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop stops the worker and waits until the running check is finished.
+// Run must have been started before Stop is called.
+func (w *Worker) Stop() {
+	w.cancel()
+	<-w.done
+}
+
+// expire runs a single check and logs every deactivated sim.
+func (w *Worker) expire(ctx context.Context) {
+	const op = "expiry.Worker.expire"
+
+	// the expirations are audited as made by the worker
+	ctx = core.WithCaller(ctx, core.Caller{Actor: actor})
+	expired, err := w.expirer.ExpireSims(ctx, time.Now())
+	if err != nil {
+		w.logger.Error("Failed to expire sims", slog.String("op", op), sl.Err(err))
+	}
+
+	for _, sim := range expired {
+		w.logger.Info(
+			"Sim activation expired",
+			slog.String("op", op),
+			slog.Int("sim id", sim.Id()),
+			slog.String("number", sim.Number()),
+			slog.Int64("activateUntil", sim.ActivateUntil()),
+		)
+	}
+}
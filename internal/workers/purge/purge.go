@@ -0,0 +1,104 @@
+package purge
+
+import (
+	"context"
+	"log/slog"
+	"simactive/internal/lib/logger/sl"
+	"time"
+)
+
+// Purger deletes the records deleted before the given time for good.
+type Purger interface {
+	Purge(ctx context.Context, deletedBefore time.Time) (int, error)
+}
+
+// Worker periodically purges the records deleted longer than the retention period ago.
+type Worker struct {
+	logger    *slog.Logger
+	purgers   map[string]Purger
+	interval  time.Duration
+	retention time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New creates a new purge Worker that runs the purgers every interval.
+// The purgers are named by the records they purge, the name is used in the logs.
+func New(logger *slog.Logger, interval, retention time.Duration, purgers map[string]Purger) *Worker {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Worker{
+		logger:    logger,
+		purgers:   purgers,
+		interval:  interval,
+		retention: retention,
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+}
+
+// Run purges the deleted records every interval until Stop is called.
+// It blocks, so it is supposed to be run in a separate goroutine.
+func (w *Worker) Run() {
+	const op = "purge.Worker.Run"
+
+	defer close(w.done)
+	ctx := w.ctx
+
+	w.logger.Info(
+		"Purge worker started",
+		slog.String("op", op),
+		slog.Duration("interval", w.interval),
+		slog.Duration("retention", w.retention),
+	)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if ctx.Err() == nil {
+			w.purge(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Purge worker stopped", slog.String("op", op))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop stops the worker and waits until the running purge is finished.
+// Run must have been started before Stop is called.
+func (w *Worker) Stop() {
+	w.cancel()
+	<-w.done
+}
+
+// purge runs every purger once, a failed purger does not stop the others.
+func (w *Worker) purge(ctx context.Context) {
+	const op = "purge.Worker.purge"
+
+	deletedBefore := time.Now().Add(-w.retention)
+	for name, purger := range w.purgers {
+		purged, err := purger.Purge(ctx, deletedBefore)
+		if err != nil {
+			w.logger.Error("Failed to purge deleted records", slog.String("op", op), slog.String("records", name), sl.Err(err))
+			continue
+		}
+		if purged == 0 {
+			continue
+		}
+
+		w.logger.Info(
+			"Deleted records purged",
+			slog.String("op", op),
+			slog.String("records", name),
+			slog.Int("count", purged),
+			slog.Time("deleted before", deletedBefore),
+		)
+	}
+}
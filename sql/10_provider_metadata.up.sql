@@ -0,0 +1,11 @@
+-------------- PROVIDER TABLE ----------------
+
+-- country is the ISO 3166-1 alpha-2 code, mcc and mnc are the mobile country and network codes,
+-- monthly_cost is the monthly tariff of a sim in minor units of the ISO 4217 currency.
+-- Empty values and 0 mean unknown.
+ALTER TABLE provider
+    ADD COLUMN country CHAR(2) NOT NULL DEFAULT '',
+    ADD COLUMN mcc CHAR(3) NOT NULL DEFAULT '',
+    ADD COLUMN mnc VARCHAR(3) NOT NULL DEFAULT '',
+    ADD COLUMN monthly_cost BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT '';
@@ -0,0 +1,30 @@
+-------------- PROVIDER TABLE ----------------
+
+-- name_key is the normalized name the providers are matched by, see core.ProviderNameKey
+ALTER TABLE provider ADD COLUMN name_key VARCHAR(16) NOT NULL DEFAULT '';
+UPDATE provider SET name_key = LOWER(REGEXP_REPLACE(name, '[^[:alnum:]]', ''));
+
+-- providers with the same name_key are one provider, their sims are moved to the oldest of them
+-- and the others are deleted, so a name_key belongs to one provider
+CREATE TEMPORARY TABLE provider_merge AS
+    SELECT p.id AS source_id, k.target_id
+    FROM provider p
+    JOIN (SELECT name_key, MIN(id) AS target_id FROM provider GROUP BY name_key) k ON k.name_key = p.name_key
+    WHERE p.id <> k.target_id;
+UPDATE sim JOIN provider_merge m ON sim.provider_id = m.source_id SET sim.provider_id = m.target_id;
+DELETE provider FROM provider JOIN provider_merge m ON provider.id = m.source_id;
+DROP TEMPORARY TABLE provider_merge;
+
+CREATE UNIQUE INDEX idx_provider_name_key ON provider (name_key);
+
+-------------- PROVIDER ALIAS TABLE ----------------
+
+-- alias_key is the normalized alias, an alias resolves to one provider
+CREATE TABLE IF NOT EXISTS provider_alias (
+    alias_key VARCHAR(16) PRIMARY KEY,
+    alias VARCHAR(16) NOT NULL,
+    provider_id INT NOT NULL,
+
+    INDEX idx_provider_alias_provider_id (provider_id),
+    FOREIGN KEY (provider_id) REFERENCES provider(id)
+);
@@ -0,0 +1,11 @@
+-------------- SERVICE TABLE ----------------
+
+-- verification_type is sms, call or both, countries are comma separated ISO 3166-1 alpha-2 codes.
+-- Empty values mean unknown, empty countries mean any country.
+-- A disabled service is kept for its used records, but the sims are not used for it anymore.
+ALTER TABLE service
+    ADD COLUMN url VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN category VARCHAR(32) NOT NULL DEFAULT '',
+    ADD COLUMN verification_type VARCHAR(8) NOT NULL DEFAULT '',
+    ADD COLUMN countries VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN enabled BOOLEAN NOT NULL DEFAULT 1;
@@ -0,0 +1,8 @@
+-------------- SERVICE TABLE ----------------
+
+-- parent_id is the top level service of the group of the service, NULL for a top level service.
+-- The services of a group share an account backend and a sim is used for them as one unit.
+ALTER TABLE service
+    ADD COLUMN parent_id INT NULL,
+    ADD INDEX idx_service_parent_id (parent_id),
+    ADD FOREIGN KEY (parent_id) REFERENCES service(id);
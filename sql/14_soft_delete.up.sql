@@ -0,0 +1,23 @@
+-------------- SIM TABLE ----------------
+
+-- deleted_at is the unix timestamp of the deletion, 0 if the sim is not deleted.
+-- Deleted sims keep their used services and activations until they are purged.
+ALTER TABLE sim
+    ADD COLUMN deleted_at BIGINT NOT NULL DEFAULT 0,
+    ADD INDEX idx_sim_deleted_at (deleted_at);
+
+-- the number of a deleted sim may be taken by a new sim, so only the numbers of not deleted sims are unique.
+-- live_number is NULL for deleted sims and unique indexes allow any number of NULLs,
+-- sims deleted within the same second can not collide.
+ALTER TABLE sim
+    DROP INDEX uq_sim_number,
+    ADD INDEX idx_sim_number (number),
+    ADD COLUMN live_number VARCHAR(16) AS (IF(deleted_at = 0, number, NULL)) STORED,
+    ADD UNIQUE INDEX uq_sim_live_number (live_number);
+
+-------------- SERVICE TABLE ----------------
+
+-- deleted_at is the unix timestamp of the deletion, 0 if the service is not deleted
+ALTER TABLE service
+    ADD COLUMN deleted_at BIGINT NOT NULL DEFAULT 0,
+    ADD INDEX idx_service_deleted_at (deleted_at);
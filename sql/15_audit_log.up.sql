@@ -0,0 +1,21 @@
+-------------- AUDIT LOG TABLE ----------------
+
+-- audit_log is append-only, its rows are never updated or deleted.
+-- rpc is the full gRPC method of the mutation, empty for the mutations of background workers.
+-- entity_id has no foreign key, the entries outlive the purged sims and services.
+-- before_value and after_value are the JSON values of the entity, empty if there is none.
+CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    actor VARCHAR(64) NOT NULL,
+    rpc VARCHAR(128) NOT NULL DEFAULT '',
+    action VARCHAR(64) NOT NULL,
+    entity_type VARCHAR(32) NOT NULL,
+    entity_id INT NOT NULL,
+    before_value TEXT NOT NULL,
+    after_value TEXT NOT NULL,
+    created_at BIGINT NOT NULL,
+
+    INDEX idx_audit_log_entity (entity_type, entity_id),
+    INDEX idx_audit_log_actor (actor),
+    INDEX idx_audit_log_created_at (created_at)
+);
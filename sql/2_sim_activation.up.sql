@@ -0,0 +1,18 @@
+-------------- PROVIDER TABLE ----------------
+
+-- default activation period of the provider sims in seconds, 0 means no default
+ALTER TABLE provider ADD COLUMN default_activation_period BIGINT NOT NULL DEFAULT 0;
+
+-------------- SIM ACTIVATION TABLE ----------------
+
+CREATE TABLE IF NOT EXISTS sim_activation (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    sim_id INT NOT NULL,
+    kind ENUM('activate', 'extend') NOT NULL,
+    activated_at BIGINT NOT NULL,
+    previous_until BIGINT NOT NULL DEFAULT 0,
+    activate_until BIGINT NOT NULL,
+
+    INDEX idx_sim_activation_sim_id (sim_id),
+    FOREIGN KEY (sim_id) REFERENCES sim(id)
+);
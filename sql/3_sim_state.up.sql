@@ -0,0 +1,16 @@
+-------------- SIM TABLE ----------------
+
+-- replace is_activated and is_blocked flags with an explicit lifecycle state
+ALTER TABLE sim
+    ADD COLUMN state ENUM('new', 'active', 'expired', 'blocked', 'retired') NOT NULL DEFAULT 'new',
+    ADD COLUMN state_reason VARCHAR(255) NOT NULL DEFAULT '';
+
+UPDATE sim SET state = CASE
+    WHEN is_blocked = 1 THEN 'blocked'
+    WHEN is_activated = 1 THEN 'active'
+    ELSE 'new'
+END;
+
+ALTER TABLE sim
+    DROP COLUMN is_activated,
+    DROP COLUMN is_blocked;
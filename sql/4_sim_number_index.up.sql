@@ -0,0 +1,4 @@
+-------------- SIM TABLE ----------------
+
+-- sims are looked up by their phone number
+CREATE INDEX idx_sim_number ON sim (number);
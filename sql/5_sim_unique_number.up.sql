@@ -0,0 +1,35 @@
+-------------- SIM TABLE ----------------
+
+-- store numbers without formatting
+UPDATE sim SET number = REGEXP_REPLACE(number, '[ ()+.-]', '');
+
+-- merge sims with the same number into the oldest one before the unique index is created
+CREATE TEMPORARY TABLE sim_duplicate AS
+    SELECT sim.id AS id, keeper.id AS keep_id
+    FROM sim
+    JOIN (SELECT number, MIN(id) AS id FROM sim GROUP BY number) AS keeper
+    ON keeper.number = sim.number AND keeper.id <> sim.id;
+
+UPDATE used_service
+    JOIN sim_duplicate ON sim_duplicate.id = used_service.sim_id
+    SET used_service.sim_id = sim_duplicate.keep_id;
+
+UPDATE sim_activation
+    JOIN sim_duplicate ON sim_duplicate.id = sim_activation.sim_id
+    SET sim_activation.sim_id = sim_duplicate.keep_id;
+
+-- the merged sims are kept with the id of the sim they were merged into,
+-- their state, state reason and provider may differ from it and are reviewed by hand
+CREATE TABLE IF NOT EXISTS sim_merged AS
+    SELECT sim.*, sim_duplicate.keep_id AS merged_into_id
+    FROM sim
+    JOIN sim_duplicate ON sim_duplicate.id = sim.id;
+
+DELETE sim FROM sim
+    JOIN sim_duplicate ON sim_duplicate.id = sim.id;
+
+DROP TEMPORARY TABLE sim_duplicate;
+
+-- one number belongs to one sim
+DROP INDEX idx_sim_number ON sim;
+CREATE UNIQUE INDEX uq_sim_number ON sim (number);
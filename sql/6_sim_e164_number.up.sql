@@ -0,0 +1,10 @@
+-------------- SIM TABLE ----------------
+
+-- numbers are stored in the E.164 format, the + makes them 16 characters long at most
+ALTER TABLE sim
+    MODIFY COLUMN number VARCHAR(16) NOT NULL,
+    ADD COLUMN country CHAR(2) NOT NULL DEFAULT '' AFTER number;
+
+UPDATE sim SET number = CONCAT('+', number) WHERE number NOT LIKE '+%';
+
+-- country of existing sims stays empty (unknown) until their number is updated
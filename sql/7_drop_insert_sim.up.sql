@@ -0,0 +1,5 @@
+-------------- SIM TABLE ----------------
+
+-- sims are inserted with a plain INSERT, the procedure started its own transaction
+-- and committed any transaction the insert was part of
+DROP PROCEDURE IF EXISTS InsertSim;
@@ -0,0 +1,8 @@
+-------------- USED SERVICE TABLE ----------------
+
+-- unix timestamp of the block of the sim on the service, 0 if the sim is not blocked
+ALTER TABLE used_service
+    ADD COLUMN blocked_at BIGINT NOT NULL DEFAULT 0;
+
+-- blocks set before the column existed get the time of the migration
+UPDATE used_service SET blocked_at = UNIX_TIMESTAMP() WHERE is_blocked = 1;
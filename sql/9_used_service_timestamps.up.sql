@@ -0,0 +1,8 @@
+-------------- USED SERVICE TABLE ----------------
+
+-- unix timestamps of the registration of the sim with the service and of its release,
+-- released_at is 0 while the sim is used for the service. Registrations made before
+-- the columns existed keep used_at 0, their time is unknown.
+ALTER TABLE used_service
+    ADD COLUMN used_at BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN released_at BIGINT NOT NULL DEFAULT 0;